@@ -19,6 +19,9 @@ type Config struct {
 	EXPIRED_REFRESH string
 
 	RUN_PORT string
+
+	DEBTS_SERVICE   string
+	COMPANY_SERVICE string
 }
 
 func NewConfig() Config {
@@ -36,6 +39,9 @@ func NewConfig() Config {
 
 	config.RUN_PORT = os.Getenv("RUN_PORT")
 
+	config.DEBTS_SERVICE = os.Getenv("DEBTS_SERVICE")
+	config.COMPANY_SERVICE = os.Getenv("COMPANY_SERVICE")
+
 	config.ACCESS_TOKEN = os.Getenv("ACCESS_TOKEN")
 	config.REFRESH_TOKEN = os.Getenv("REFRESH_TOKEN")
 	config.EXPIRED_ACCESS = os.Getenv("EXPIRED_ACCESS")
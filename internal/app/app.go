@@ -1,16 +1,26 @@
 package app
 
 import (
+	"context"
 	"crm-admin/config"
 	"crm-admin/internal/controller"
 	grpc1 "crm-admin/internal/controller/grpc"
+	"crm-admin/internal/generated/company"
+	"crm-admin/internal/generated/debts"
 	"crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
 	"crm-admin/internal/usecase/repo"
+	"crm-admin/internal/webapi"
 	"crm-admin/pkg/logger"
 	"crm-admin/pkg/postgres"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 )
 
 func Run(cfg config.Config) {
@@ -25,7 +35,36 @@ func Run(cfg config.Config) {
 	statistics := repo.NewStatisticsRepo(db)
 	cashFlowRepo := repo.NewCashFlow(db)
 
-	controller1 := controller.NewController(db, logger1)
+	var debtsClient usecase.DebtsClient = webapi.NewDebtsStub(logger1)
+	if cfg.DEBTS_SERVICE != "" {
+		conn, err := grpc.NewClient(cfg.DEBTS_SERVICE, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal(err)
+		}
+		debtsClient = debts.NewDebtsServiceClient(conn)
+	} else {
+		logger1.Warn("DEBTS_SERVICE is not set, credit sales use the local debts stub")
+	}
+
+	var smsSender usecase.SMSSender = webapi.NewSMSStub(logger1)
+	if cfg.COMPANY_SERVICE != "" {
+		conn, err := grpc.NewClient(cfg.COMPANY_SERVICE, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal(err)
+		}
+		smsSender = company.NewCompanyServiceClient(conn)
+	} else {
+		logger1.Warn("COMPANY_SERVICE is not set, low-stock alerts use the local SMS stub")
+	}
+
+	controller1 := controller.NewController(db, logger1, debtsClient, smsSender)
+
+	// The background workers stop together with the server on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go controller1.StockAlerts.Run(ctx, time.Minute)
+	go controller1.SaleDebts.Run(ctx, time.Minute)
 
 	pr := grpc1.NewProductGrpc(controller1, statistics, cashFlowRepo)
 
@@ -37,6 +76,14 @@ func Run(cfg config.Config) {
 	server := grpc.NewServer()
 	products.RegisterProductsServer(server, pr)
 
+	go func() {
+		<-ctx.Done()
+		log.Printf("shutting down")
+		server.GracefulStop()
+	}()
+
 	log.Printf("server listening at %s", cfg.RUN_PORT)
-	log.Fatal(server.Serve(listen))
+	if err = server.Serve(listen); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -8,24 +8,65 @@ import (
 )
 
 type Controller struct {
-	Product  *usecase.ProductsUseCase
-	Purchase *usecase.PurchaseUseCase
-	Sales    *usecase.SalesUseCase
+	Product   *usecase.ProductsUseCase
+	Purchase  *usecase.PurchaseUseCase
+	Sales     *usecase.SalesUseCase
+	Returns   *usecase.ReturnsUseCase
+	Inventory *usecase.InventoryUseCase
+	Settings  *usecase.SettingsUseCase
+
+	PaymentTypes    *usecase.PaymentTypesUseCase
+	SupplierReturns *usecase.SupplierReturnsUseCase
+	PurchaseOrders  *usecase.PurchaseOrdersUseCase
+	Stocktakes      *usecase.StocktakesUseCase
+
+	StockAdjustments *usecase.StockAdjustmentsUseCase
+	StockAlerts      *usecase.StockAlertsUseCase
+	Labels           *usecase.LabelsUseCase
+	Serials          *usecase.SerialsUseCase
+	SaleDebts        *usecase.SaleDebtsUseCase
 }
 
-func NewController(db *sqlx.DB, log *slog.Logger) *Controller {
+func NewController(db *sqlx.DB, log *slog.Logger, debts usecase.DebtsClient, sms usecase.SMSSender) *Controller {
 
 	productRepo := repo.NewProductRepo(db)
 	purchaseRepo := repo.NewPurchasesRepo(db)
 	salesRepo := repo.NewSalesRepo(db)
 	productQuantityRepo := repo.NewProductQuantity(db)
 	cashFlowRepo := repo.NewCashFlow(db)
+	returnsRepo := repo.NewReturnsRepo(db)
+	settingsRepo := repo.NewCompanySettings(db)
+	paymentTypesRepo := repo.NewPaymentTypesRepo(db)
+	supplierReturnsRepo := repo.NewSupplierReturnsRepo(db)
+	purchaseOrdersRepo := repo.NewPurchaseOrdersRepo(db)
+	stocktakesRepo := repo.NewStocktakesRepo(db)
+	stockAdjustmentsRepo := repo.NewStockAdjustmentsRepo(db)
+	stockAlertsRepo := repo.NewStockAlertsRepo(db)
+	serialsRepo := repo.NewSerialsRepo(db)
+	saleDebtsRepo := repo.NewSaleDebtsRepo(db)
+	uow := repo.NewUnitOfWork(db)
+
+	saleDebts := usecase.NewSaleDebtsUseCase(saleDebtsRepo, debts, log)
 
 	ctr := &Controller{
-		Product:  usecase.NewProductsUseCase(productRepo, log),
-		Purchase: usecase.NewPurchaseUseCase(purchaseRepo, productQuantityRepo, log, cashFlowRepo),
-		Sales:    usecase.NewSalesUseCase(salesRepo, productQuantityRepo, log, cashFlowRepo),
+		Product:   usecase.NewProductsUseCase(productRepo, log),
+		Purchase:  usecase.NewPurchaseUseCase(purchaseRepo, productQuantityRepo, log, cashFlowRepo, uow),
+		Sales:     usecase.NewSalesUseCase(salesRepo, productQuantityRepo, log, cashFlowRepo, returnsRepo, uow, saleDebts),
+		Returns:   usecase.NewReturnsUseCase(returnsRepo, salesRepo, productQuantityRepo, log, cashFlowRepo, uow, saleDebts),
+		Inventory: usecase.NewInventoryUseCase(productQuantityRepo, log),
+		Settings:  usecase.NewSettingsUseCase(settingsRepo, log),
+
+		PaymentTypes:    usecase.NewPaymentTypesUseCase(paymentTypesRepo, log),
+		SupplierReturns: usecase.NewSupplierReturnsUseCase(supplierReturnsRepo, purchaseRepo, log, uow),
+		Stocktakes:      usecase.NewStocktakesUseCase(stocktakesRepo, log, uow),
+
+		StockAdjustments: usecase.NewStockAdjustmentsUseCase(stockAdjustmentsRepo, log, uow),
+		StockAlerts:      usecase.NewStockAlertsUseCase(stockAlertsRepo, sms, log),
+		Labels:           usecase.NewLabelsUseCase(productRepo, log),
+		Serials:          usecase.NewSerialsUseCase(serialsRepo, log),
+		SaleDebts:        saleDebts,
 	}
+	ctr.PurchaseOrders = usecase.NewPurchaseOrdersUseCase(purchaseOrdersRepo, ctr.Purchase, log, uow)
 
 	return ctr
 }
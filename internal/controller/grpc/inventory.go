@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetStockMovements retrieves the stock movement ledger based on filter criteria.
+func (p *ProductsGrpc) GetStockMovements(ctx context.Context, in *pb.StockMovementFilter) (*pb.StockMovementList, error) {
+
+	res, err := p.inventory.GetStockMovements(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve stock movements: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetLowStockProducts retrieves the products that ran out of stock or were oversold.
+func (p *ProductsGrpc) GetLowStockProducts(ctx context.Context, in *pb.LowStockFilter) (*pb.LowStockList, error) {
+
+	res, err := p.inventory.GetLowStockProducts(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve low stock products: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetExpiringStock retrieves the lots that expire within the requested horizon.
+func (p *ProductsGrpc) GetExpiringStock(ctx context.Context, in *pb.ExpiringStockReq) (*pb.ExpiringStockList, error) {
+
+	res, err := p.inventory.GetExpiringStock(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve expiring stock: %v", err)
+	}
+
+	return res, nil
+}
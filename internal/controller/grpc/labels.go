@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RenderLabels returns printable price labels with barcodes for the listed products.
+func (p *ProductsGrpc) RenderLabels(ctx context.Context, in *pb.RenderLabelsReq) (*pb.Labels, error) {
+
+	res, err := p.labels.RenderLabels(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to render labels: %v", err)
+	}
+
+	return res, nil
+}
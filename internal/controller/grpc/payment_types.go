@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePaymentType adds a payment type to the company.
+func (p *ProductsGrpc) CreatePaymentType(ctx context.Context, in *pb.PaymentType) (*pb.PaymentType, error) {
+
+	res, err := p.paymentTypes.CreatePaymentType(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create payment type: %v", err)
+	}
+
+	return res, nil
+}
+
+// UpdatePaymentType changes a payment type.
+func (p *ProductsGrpc) UpdatePaymentType(ctx context.Context, in *pb.PaymentType) (*pb.PaymentType, error) {
+
+	res, err := p.paymentTypes.UpdatePaymentType(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update payment type: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetPaymentType retrieves a payment type by ID or name.
+func (p *ProductsGrpc) GetPaymentType(ctx context.Context, in *pb.PaymentTypeID) (*pb.PaymentType, error) {
+
+	res, err := p.paymentTypes.GetPaymentType(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Failed to get payment type: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetListPaymentTypes lists the payment types of a company.
+func (p *ProductsGrpc) GetListPaymentTypes(ctx context.Context, in *pb.PaymentTypeFilter) (*pb.PaymentTypeList, error) {
+
+	res, err := p.paymentTypes.GetListPaymentTypes(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get payment types: %v", err)
+	}
+
+	return res, nil
+}
+
+// DeletePaymentType deletes a payment type that no document uses.
+func (p *ProductsGrpc) DeletePaymentType(ctx context.Context, in *pb.PaymentTypeID) (*pb.Message, error) {
+
+	res, err := p.paymentTypes.DeletePaymentType(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete payment type: %v", err)
+	}
+
+	return res, nil
+}
@@ -15,6 +15,18 @@ type ProductsGrpc struct {
 	product    *usecase.ProductsUseCase
 	purchase   *usecase.PurchaseUseCase
 	sales      *usecase.SalesUseCase
+	returns    *usecase.ReturnsUseCase
+	inventory  *usecase.InventoryUseCase
+	settings   *usecase.SettingsUseCase
+
+	paymentTypes    *usecase.PaymentTypesUseCase
+	supplierReturns *usecase.SupplierReturnsUseCase
+	purchaseOrders  *usecase.PurchaseOrdersUseCase
+	stocktakes      *usecase.StocktakesUseCase
+
+	stockAdjustments *usecase.StockAdjustmentsUseCase
+	labels           *usecase.LabelsUseCase
+	serials          *usecase.SerialsUseCase
 
 	pb.UnimplementedProductsServer
 }
@@ -25,7 +37,19 @@ func NewProductGrpc(ctrl *controller.Controller, statistics usecase.StatisticsRe
 		statistics: statistics,
 		purchase:   ctrl.Purchase,
 		sales:      ctrl.Sales,
+		returns:    ctrl.Returns,
+		inventory:  ctrl.Inventory,
+		settings:   ctrl.Settings,
 		cashFlow:   cash,
+
+		paymentTypes:    ctrl.PaymentTypes,
+		supplierReturns: ctrl.SupplierReturns,
+		purchaseOrders:  ctrl.PurchaseOrders,
+		stocktakes:      ctrl.Stocktakes,
+
+		stockAdjustments: ctrl.StockAdjustments,
+		labels:           ctrl.Labels,
+		serials:          ctrl.Serials,
 	}
 }
 
@@ -150,6 +174,53 @@ func (p *ProductsGrpc) GetProductList(ctx context.Context, in *pb.ProductFilter)
 	return productList, nil
 }
 
+func (p *ProductsGrpc) GetCatalogStock(ctx context.Context, in *pb.CatalogStockReq) (*pb.CatalogStockList, error) {
+
+	res, err := p.product.GetCatalogStock(in)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve catalog stock: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetProductByBarcode finds the branch product of a scanned barcode or SKU.
+func (p *ProductsGrpc) GetProductByBarcode(ctx context.Context, in *pb.GetProductByBarcodeReq) (*pb.Product, error) {
+
+	res, err := p.product.GetProductByBarcode(in)
+
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Product not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// CreateVariant adds a variant under a parent product of the branch.
+func (p *ProductsGrpc) CreateVariant(ctx context.Context, in *pb.CreateVariantReq) (*pb.Product, error) {
+
+	res, err := p.product.CreateVariant(in)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create variant: %v", err)
+	}
+
+	return res, nil
+}
+
+// SetStockThresholds sets the low-stock threshold and the reorder quantity of a branch product.
+func (p *ProductsGrpc) SetStockThresholds(ctx context.Context, in *pb.StockThresholdsReq) (*pb.Product, error) {
+
+	res, err := p.product.SetStockThresholds(in)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to set stock thresholds: %v", err)
+	}
+
+	return res, nil
+}
+
 func (p *ProductsGrpc) GetProductDashboard(ctx context.Context, in *pb.GetProductsDashboardReq) (*pb.GetProductsDashboardRes, error) {
 
 	res, err := p.product.GetProductDashboard(in)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crm-admin/internal/entity"
 	pb "crm-admin/internal/generated/products"
+	"errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,6 +20,7 @@ func (p *ProductsGrpc) CreatePurchase(ctx context.Context, in *pb.PurchaseReques
 		CompanyID:     in.GetCompanyId(),
 		BranchID:      in.GetBranchId(),
 		PurchaseItems: *mapPbPurchaseItemToEntity(in.GetItems()), // Map items
+		Payments:      mapPbPaymentsToEntity(in.GetPayments()),
 	}
 
 	// Create purchase via usecase
@@ -63,7 +65,7 @@ func (p *ProductsGrpc) UpdatePurchase(ctx context.Context, in *pb.PurchaseUpdate
 	return purchase, nil
 }
 
-// DeletePurchase deletes a purchase.
+// DeletePurchase voids a purchase.
 func (p *ProductsGrpc) DeletePurchase(ctx context.Context, in *pb.PurchaseID) (*pb.Message, error) {
 
 	message, err := p.purchase.DeletePurchase(in)
@@ -74,14 +76,46 @@ func (p *ProductsGrpc) DeletePurchase(ctx context.Context, in *pb.PurchaseID) (*
 	return &pb.Message{Message: message.Message}, nil
 }
 
+// VoidPurchase voids a purchase, keeping its rows for the history.
+func (p *ProductsGrpc) VoidPurchase(ctx context.Context, in *pb.VoidPurchaseReq) (*pb.PurchaseResponse, error) {
+
+	res, err := p.purchase.VoidPurchase(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to void purchase: %v", err)
+	}
+
+	return res, nil
+}
+
+// UpdatePurchaseItems replaces the line items of a purchase, moving stock and cash by the difference.
+func (p *ProductsGrpc) UpdatePurchaseItems(ctx context.Context, in *pb.UpdatePurchaseItemsReq) (*pb.PurchaseResponse, error) {
+
+	res, err := p.purchase.UpdatePurchaseItems(in)
+	if err != nil {
+		var shortage *entity.ShortageError
+		if errors.As(err, &shortage) {
+			return nil, shortageStatus(shortage)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update purchase items: %v", err)
+	}
+
+	return res, nil
+}
+
 // Helper function to map pb PurchaseItemRequest to entity PurchaseItem
 func mapPbPurchaseItemToEntity(items []*pb.PurchaseItem) *[]entity.PurchaseItem {
 	var purchaseItems []entity.PurchaseItem
 	for _, item := range items {
 		purchaseItems = append(purchaseItems, entity.PurchaseItem{
 			ProductID:     item.GetProductId(),
+			CatalogID:     item.GetCatalogId(),
 			Quantity:      int(item.GetQuantity()),
 			PurchasePrice: item.GetPurchasePrice(),
+			Unit:          item.GetUnit(),
+			UnitQuantity:  item.GetUnitQuantity(),
+			LotNumber:     item.GetLotNumber(),
+			ExpiresAt:     item.GetExpiresAt(),
+			Serials:       item.GetSerials(),
 		})
 	}
 	return &purchaseItems // Return a pointer to the slice
@@ -118,3 +152,43 @@ func (p *ProductsGrpc) GetTransferList(ctx context.Context, in *pb.TransferFilte
 
 	return res, nil
 }
+
+func (p *ProductsGrpc) DispatchTransfer(ctx context.Context, in *pb.TransferStatusReq) (*pb.Transfer, error) {
+
+	res, err := p.purchase.DispatchTransfer(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to dispatch transfer: %v", err)
+	}
+
+	return res, nil
+}
+
+func (p *ProductsGrpc) MarkTransferInTransit(ctx context.Context, in *pb.TransferStatusReq) (*pb.Transfer, error) {
+
+	res, err := p.purchase.MarkTransferInTransit(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to mark transfer in transit: %v", err)
+	}
+
+	return res, nil
+}
+
+func (p *ProductsGrpc) ReceiveTransfer(ctx context.Context, in *pb.ReceiveTransferReq) (*pb.Transfer, error) {
+
+	res, err := p.purchase.ReceiveTransfer(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to receive transfer: %v", err)
+	}
+
+	return res, nil
+}
+
+func (p *ProductsGrpc) CancelTransfer(ctx context.Context, in *pb.TransferStatusReq) (*pb.Transfer, error) {
+
+	res, err := p.purchase.CancelTransfer(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to cancel transfer: %v", err)
+	}
+
+	return res, nil
+}
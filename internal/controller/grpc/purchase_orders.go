@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePurchaseOrder creates a draft order to a supplier.
+func (p *ProductsGrpc) CreatePurchaseOrder(ctx context.Context, in *pb.PurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+
+	res, err := p.purchaseOrders.CreatePurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create purchase order: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetPurchaseOrder retrieves a specific purchase order by its ID.
+func (p *ProductsGrpc) GetPurchaseOrder(ctx context.Context, in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+
+	res, err := p.purchaseOrders.GetPurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Purchase order not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetListPurchaseOrders retrieves a list of purchase orders based on filter criteria.
+func (p *ProductsGrpc) GetListPurchaseOrders(ctx context.Context, in *pb.PurchaseOrderFilter) (*pb.PurchaseOrderList, error) {
+
+	res, err := p.purchaseOrders.GetListPurchaseOrders(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve purchase orders list: %v", err)
+	}
+
+	return res, nil
+}
+
+// UpdatePurchaseOrder updates a draft purchase order.
+func (p *ProductsGrpc) UpdatePurchaseOrder(ctx context.Context, in *pb.PurchaseOrderUpdate) (*pb.PurchaseOrder, error) {
+
+	res, err := p.purchaseOrders.UpdatePurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update purchase order: %v", err)
+	}
+
+	return res, nil
+}
+
+// SendPurchaseOrder marks a draft purchase order as sent to the supplier.
+func (p *ProductsGrpc) SendPurchaseOrder(ctx context.Context, in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+
+	res, err := p.purchaseOrders.SendPurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to send purchase order: %v", err)
+	}
+
+	return res, nil
+}
+
+// CancelPurchaseOrder cancels a purchase order that is not fully received.
+func (p *ProductsGrpc) CancelPurchaseOrder(ctx context.Context, in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+
+	res, err := p.purchaseOrders.CancelPurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to cancel purchase order: %v", err)
+	}
+
+	return res, nil
+}
+
+// ReceivePurchaseOrder creates a purchase for the received quantities of an order.
+func (p *ProductsGrpc) ReceivePurchaseOrder(ctx context.Context, in *pb.ReceivePurchaseOrderReq) (*pb.PurchaseResponse, error) {
+
+	res, err := p.purchaseOrders.ReceivePurchaseOrder(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to receive purchase order: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetOutstandingPurchaseOrders reports quantities still expected from suppliers.
+func (p *ProductsGrpc) GetOutstandingPurchaseOrders(ctx context.Context, in *pb.OutstandingOrdersReq) (*pb.OutstandingOrdersList, error) {
+
+	res, err := p.purchaseOrders.GetOutstandingPurchaseOrders(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve outstanding purchase orders: %v", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateReturn returns some or all lines of an existing sale.
+func (p *ProductsGrpc) CreateReturn(ctx context.Context, in *pb.ReturnRequest) (*pb.ReturnResponse, error) {
+
+	res, err := p.returns.CreateReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create return: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetReturn retrieves a specific return by its ID.
+func (p *ProductsGrpc) GetReturn(ctx context.Context, in *pb.ReturnID) (*pb.ReturnResponse, error) {
+
+	res, err := p.returns.GetReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Return not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetListReturns retrieves a list of returns based on filter criteria.
+func (p *ProductsGrpc) GetListReturns(ctx context.Context, in *pb.ReturnFilter) (*pb.ReturnList, error) {
+
+	res, err := p.returns.GetListReturns(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve returns list: %v", err)
+	}
+
+	return res, nil
+}
+
+// UpdateReturn updates an existing return.
+func (p *ProductsGrpc) UpdateReturn(ctx context.Context, in *pb.ReturnUpdate) (*pb.ReturnResponse, error) {
+
+	res, err := p.returns.UpdateReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to update return: %v", err)
+	}
+
+	return res, nil
+}
+
+// DeleteReturn voids a return; kept for old clients.
+func (p *ProductsGrpc) DeleteReturn(ctx context.Context, in *pb.ReturnID) (*pb.Message, error) {
+
+	res, err := p.returns.DeleteReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete return: %v", err)
+	}
+
+	return res, nil
+}
+
+// VoidReturn voids a return, keeping its rows for the history.
+func (p *ProductsGrpc) VoidReturn(ctx context.Context, in *pb.VoidReturnReq) (*pb.ReturnResponse, error) {
+
+	res, err := p.returns.VoidReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to void return: %v", err)
+	}
+
+	return res, nil
+}
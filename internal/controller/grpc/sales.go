@@ -4,7 +4,9 @@ import (
 	"context"
 	"crm-admin/internal/entity"
 	pb "crm-admin/internal/generated/products"
+	"errors"
 	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log"
@@ -17,15 +19,22 @@ func (p *ProductsGrpc) CalculateTotalSales(ctx context.Context, in *pb.SaleReque
 		ClientID:      in.GetClientId(),
 		SoldBy:        in.GetSoldBy(),
 		PaymentMethod: in.GetPaymentMethod(),
+		IsForDebt:     in.GetIsForDebt(),
+		PaidAmount:    in.GetPaidAmount(),
+		Payments:      mapPbPaymentsToEntity(in.GetPayments()),
 	}
 
 	// Map SaleItems from pb to entity
 	var soldProducts []entity.SalesItem
 	for _, item := range in.GetSoldProducts() {
 		soldProducts = append(soldProducts, entity.SalesItem{
-			ProductID: item.GetProductId(),
-			Quantity:  int64(item.GetQuantity()),
-			SalePrice: item.GetSalePrice(),
+			ProductID:    item.GetProductId(),
+			CatalogID:    item.GetCatalogId(),
+			Quantity:     int64(item.GetQuantity()),
+			SalePrice:    item.GetSalePrice(),
+			Unit:         item.GetUnit(),
+			UnitQuantity: item.GetUnitQuantity(),
+			Serials:      item.GetSerials(),
 		})
 	}
 	saleReq.SoldProducts = soldProducts
@@ -49,15 +58,24 @@ func (p *ProductsGrpc) CreateSales(ctx context.Context, in *pb.SaleRequest) (*pb
 		PaymentMethod: in.GetPaymentMethod(),
 		CompanyID:     in.GetCompanyId(),
 		BranchID:      in.GetBranchId(),
+		IsForDebt:     in.GetIsForDebt(),
+		PaidAmount:    in.GetPaidAmount(),
+		ClientName:    in.GetClientName(),
+		ClientPhone:   in.GetClientPhone(),
+		Payments:      mapPbPaymentsToEntity(in.GetPayments()),
 	}
 
 	// Map SaleItems
 	var soldProducts []entity.SalesItem
 	for _, item := range in.GetSoldProducts() {
 		soldProducts = append(soldProducts, entity.SalesItem{
-			ProductID: item.GetProductId(),
-			Quantity:  int64(item.GetQuantity()),
-			SalePrice: item.GetSalePrice(),
+			ProductID:    item.GetProductId(),
+			CatalogID:    item.GetCatalogId(),
+			Quantity:     int64(item.GetQuantity()),
+			SalePrice:    item.GetSalePrice(),
+			Unit:         item.GetUnit(),
+			UnitQuantity: item.GetUnitQuantity(),
+			Serials:      item.GetSerials(),
 		})
 	}
 	saleReq.SoldProducts = soldProducts
@@ -65,6 +83,10 @@ func (p *ProductsGrpc) CreateSales(ctx context.Context, in *pb.SaleRequest) (*pb
 	// Create sale
 	saleResp, err := p.sales.CreateSales(saleReq)
 	if err != nil {
+		var shortage *entity.ShortageError
+		if errors.As(err, &shortage) {
+			return nil, shortageStatus(shortage)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to create sale: %v", err)
 	}
 
@@ -82,6 +104,21 @@ func (p *ProductsGrpc) UpdateSales(ctx context.Context, in *pb.SaleUpdate) (*pb.
 	return saleResp, nil
 }
 
+// UpdateSaleItems replaces the line items of a sale, moving stock and cash by the difference.
+func (p *ProductsGrpc) UpdateSaleItems(ctx context.Context, in *pb.UpdateSaleItemsReq) (*pb.SaleResponse, error) {
+
+	saleResp, err := p.sales.UpdateSaleItems(in)
+	if err != nil {
+		var shortage *entity.ShortageError
+		if errors.As(err, &shortage) {
+			return nil, shortageStatus(shortage)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update sale items: %v", err)
+	}
+
+	return saleResp, nil
+}
+
 // GetSales retrieves a specific sale by its ID.
 func (p *ProductsGrpc) GetSales(ctx context.Context, in *pb.SaleID) (*pb.SaleResponse, error) {
 
@@ -104,7 +141,7 @@ func (p *ProductsGrpc) GetListSales(ctx context.Context, in *pb.SaleFilter) (*pb
 	return salesList, nil
 }
 
-// DeleteSales deletes a sale record.
+// DeleteSales voids a sale record.
 func (p *ProductsGrpc) DeleteSales(ctx context.Context, in *pb.SaleID) (*pb.Message, error) {
 
 	message, err := p.sales.DeleteSales(in)
@@ -115,6 +152,17 @@ func (p *ProductsGrpc) DeleteSales(ctx context.Context, in *pb.SaleID) (*pb.Mess
 	return message, nil
 }
 
+// VoidSale voids a sale, keeping its rows for the history.
+func (p *ProductsGrpc) VoidSale(ctx context.Context, in *pb.VoidSaleReq) (*pb.SaleResponse, error) {
+
+	res, err := p.sales.VoidSale(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to void sale: %v", err)
+	}
+
+	return res, nil
+}
+
 // GetMostSoldProductsByDay retrieves the most sold products by day.
 func (p *ProductsGrpc) GetMostSoldProductsByDay(ctx context.Context, in *pb.MostSoldProductsRequest) (*pb.MostSoldProductsResponse, error) {
 
@@ -166,6 +214,16 @@ func mapSalesTotalToSaleResponse(total *entity.SalesTotal) *pb.SaleResponse {
 			Quantity:   int32(item.Quantity),
 			SalePrice:  item.SalePrice,
 			TotalPrice: item.TotalPrice,
+			Serials:    item.Serials,
+		})
+	}
+
+	var payments []*pb.SplitPayment
+	for _, payment := range total.Payments {
+		payments = append(payments, &pb.SplitPayment{
+			Amount:        payment.Amount,
+			PaymentMethod: payment.PaymentMethod,
+			Currency:      payment.Currency,
 		})
 	}
 
@@ -174,6 +232,42 @@ func mapSalesTotalToSaleResponse(total *entity.SalesTotal) *pb.SaleResponse {
 		SoldBy:         total.SoldBy,
 		TotalSalePrice: total.TotalSalePrice,
 		PaymentMethod:  total.PaymentMethod,
+		IsForDebt:      total.IsForDebt,
+		PaidAmount:     total.PaidAmount,
 		SoldProducts:   soldProducts,
+		Payments:       payments,
+	}
+}
+
+// shortageStatus turns a rejected sale into FailedPrecondition with one violation per short line.
+func shortageStatus(e *entity.ShortageError) error {
+	st := status.New(codes.FailedPrecondition, e.Error())
+
+	violations := make([]*errdetails.PreconditionFailure_Violation, 0, len(e.Lines))
+	for _, l := range e.Lines {
+		violations = append(violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "STOCK",
+			Subject:     l.ProductID,
+			Description: fmt.Sprintf("%s: requested %d, available %d, missing %d", l.Name, l.Requested, l.Available, l.Missing()),
+		})
+	}
+
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// mapPbPaymentsToEntity maps the split payments of a request
+func mapPbPaymentsToEntity(in []*pb.SplitPayment) []entity.Payment {
+	var payments []entity.Payment
+	for _, p := range in {
+		payments = append(payments, entity.Payment{
+			Amount:        p.GetAmount(),
+			PaymentMethod: p.GetPaymentMethod(),
+			Currency:      p.GetCurrency(),
+		})
 	}
+	return payments
 }
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TraceSerial returns the purchase, transfer, sale and return history of a serial number.
+func (p *ProductsGrpc) TraceSerial(ctx context.Context, in *pb.TraceSerialReq) (*pb.SerialTrace, error) {
+
+	res, err := p.serials.TraceSerial(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Serial not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetProductSerials lists serial numbers by product, branch and status.
+func (p *ProductsGrpc) GetProductSerials(ctx context.Context, in *pb.ProductSerialsReq) (*pb.ProductSerialList, error) {
+
+	res, err := p.serials.GetProductSerials(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve product serials: %v", err)
+	}
+
+	return res, nil
+}
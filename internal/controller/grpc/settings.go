@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCompanySettings retrieves the settings of a company.
+func (p *ProductsGrpc) GetCompanySettings(ctx context.Context, in *pb.CompanySettingsReq) (*pb.CompanySettings, error) {
+
+	res, err := p.settings.GetCompanySettings(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get company settings: %v", err)
+	}
+
+	return res, nil
+}
+
+// UpdateCompanySettings changes the settings of a company.
+func (p *ProductsGrpc) UpdateCompanySettings(ctx context.Context, in *pb.CompanySettings) (*pb.CompanySettings, error) {
+
+	res, err := p.settings.UpdateCompanySettings(in)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to update company settings: %v", err)
+	}
+
+	return res, nil
+}
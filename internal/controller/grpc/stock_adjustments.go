@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdjustStock writes off or adds goods for a reason code.
+func (p *ProductsGrpc) AdjustStock(ctx context.Context, in *pb.StockAdjustmentReq) (*pb.StockAdjustment, error) {
+
+	res, err := p.stockAdjustments.AdjustStock(in)
+	if err != nil {
+		var shortage *entity.ShortageError
+		if errors.As(err, &shortage) {
+			return nil, shortageStatus(shortage)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to adjust stock: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetWriteOffReport reports stock adjustments by reason for a period.
+func (p *ProductsGrpc) GetWriteOffReport(ctx context.Context, in *pb.WriteOffReportReq) (*pb.WriteOffReport, error) {
+
+	res, err := p.stockAdjustments.GetWriteOffReport(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve write-off report: %v", err)
+	}
+
+	return res, nil
+}
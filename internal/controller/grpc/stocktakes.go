@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartStocktake opens a physical inventory count in a branch.
+func (p *ProductsGrpc) StartStocktake(ctx context.Context, in *pb.StocktakeRequest) (*pb.Stocktake, error) {
+
+	res, err := p.stocktakes.StartStocktake(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to start stocktake: %v", err)
+	}
+
+	return res, nil
+}
+
+// SubmitStocktakeCounts records a counting pass of an open stocktake.
+func (p *ProductsGrpc) SubmitStocktakeCounts(ctx context.Context, in *pb.SubmitStocktakeCountsReq) (*pb.Stocktake, error) {
+
+	res, err := p.stocktakes.SubmitStocktakeCounts(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to submit stocktake counts: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetStocktake retrieves a specific stocktake by its ID.
+func (p *ProductsGrpc) GetStocktake(ctx context.Context, in *pb.StocktakeID) (*pb.Stocktake, error) {
+
+	res, err := p.stocktakes.GetStocktake(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Stocktake not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetListStocktakes retrieves a list of stocktakes based on filter criteria.
+func (p *ProductsGrpc) GetListStocktakes(ctx context.Context, in *pb.StocktakeFilter) (*pb.StocktakeList, error) {
+
+	res, err := p.stocktakes.GetListStocktakes(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve stocktakes list: %v", err)
+	}
+
+	return res, nil
+}
+
+// CloseStocktake posts the variances of an open stocktake to the stock.
+func (p *ProductsGrpc) CloseStocktake(ctx context.Context, in *pb.CloseStocktakeReq) (*pb.Stocktake, error) {
+
+	res, err := p.stocktakes.CloseStocktake(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to close stocktake: %v", err)
+	}
+
+	return res, nil
+}
+
+// CancelStocktake cancels an open stocktake.
+func (p *ProductsGrpc) CancelStocktake(ctx context.Context, in *pb.StocktakeID) (*pb.Stocktake, error) {
+
+	res, err := p.stocktakes.CancelStocktake(in)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to cancel stocktake: %v", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSupplierReturn returns some or all lines of a purchase to the supplier.
+func (p *ProductsGrpc) CreateSupplierReturn(ctx context.Context, in *pb.SupplierReturnRequest) (*pb.SupplierReturnResponse, error) {
+
+	res, err := p.supplierReturns.CreateSupplierReturn(in)
+	if err != nil {
+		var shortage *entity.ShortageError
+		if errors.As(err, &shortage) {
+			return nil, shortageStatus(shortage)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create supplier return: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetSupplierReturn retrieves a specific supplier return by its ID.
+func (p *ProductsGrpc) GetSupplierReturn(ctx context.Context, in *pb.SupplierReturnID) (*pb.SupplierReturnResponse, error) {
+
+	res, err := p.supplierReturns.GetSupplierReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Supplier return not found: %v", err)
+	}
+
+	return res, nil
+}
+
+// GetListSupplierReturns retrieves a list of supplier returns based on filter criteria.
+func (p *ProductsGrpc) GetListSupplierReturns(ctx context.Context, in *pb.SupplierReturnFilter) (*pb.SupplierReturnList, error) {
+
+	res, err := p.supplierReturns.GetListSupplierReturns(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve supplier returns list: %v", err)
+	}
+
+	return res, nil
+}
+
+// DeleteSupplierReturn voids a supplier return; kept for old clients.
+func (p *ProductsGrpc) DeleteSupplierReturn(ctx context.Context, in *pb.SupplierReturnID) (*pb.Message, error) {
+
+	res, err := p.supplierReturns.DeleteSupplierReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to delete supplier return: %v", err)
+	}
+
+	return res, nil
+}
+
+// VoidSupplierReturn voids a supplier return, keeping its rows for the history.
+func (p *ProductsGrpc) VoidSupplierReturn(ctx context.Context, in *pb.VoidSupplierReturnReq) (*pb.SupplierReturnResponse, error) {
+
+	res, err := p.supplierReturns.VoidSupplierReturn(in)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to void supplier return: %v", err)
+	}
+
+	return res, nil
+}
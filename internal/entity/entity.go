@@ -1,12 +1,254 @@
 package entity
 
+import (
+	"fmt"
+	"strings"
+)
+
 type ProductID struct {
 	ID string `json:"id" db:"id"`
 }
 
 type CountProductReq struct {
-	ID    string `json:"id" db:"id"`
-	Count int    `json:"count" db:"count"`
+	ID       string       `json:"id" db:"id"`
+	Count    int          `json:"count" db:"count"`
+	Movement MovementInfo `json:"movement" db:"-"`
+	// Lot, when set, receives the whole count as a new lot of the product.
+	Lot *StockLot `json:"lot" db:"-"`
+}
+
+// StockLot is a lot received into stock: the supplier lot number, the expiry date (YYYY-MM-DD, empty if it does not expire)
+// and the cost of one base unit.
+type StockLot struct {
+	LotNumber string  `json:"lot_number" db:"lot_number"`
+	ExpiresAt string  `json:"expires_at" db:"expires_at"`
+	Cost      float64 `json:"cost" db:"cost"`
+	Quantity  int64   `json:"quantity" db:"quantity"`
+}
+
+// Reasons written to the inventory_movements ledger
+const (
+	MovementSale        = "sale"
+	MovementPurchase    = "purchase"
+	MovementTransferIn  = "transfer_in"
+	MovementTransferOut = "transfer_out"
+	MovementAdjustment  = "adjustment"
+	MovementReturn      = "return"
+
+	MovementSupplierReturn = "supplier_return"
+	// MovementRevaluation changes the unit cost of the stock a document brought in; the quantity stays.
+	MovementRevaluation = "revaluation"
+)
+
+// Statuses of sales and purchases. Voided documents keep their rows but no longer count anywhere
+const (
+	DocumentCompleted = "completed"
+	DocumentVoided    = "voided"
+)
+
+// States of a purchase order
+const (
+	OrderDraft             = "draft"
+	OrderSent              = "sent"
+	OrderPartiallyReceived = "partially_received"
+	OrderReceived          = "received"
+	OrderCancelled         = "cancelled"
+)
+
+// States of a transfer between branches
+const (
+	TransferDraft             = "draft"
+	TransferDispatched        = "dispatched"
+	TransferInTransit         = "in_transit"
+	TransferReceived          = "received"
+	TransferPartiallyReceived = "partially_received"
+	TransferCancelled         = "cancelled"
+)
+
+// States of a stocktake (physical inventory count) session
+const (
+	StocktakeOpen      = "open"
+	StocktakeClosed    = "closed"
+	StocktakeCancelled = "cancelled"
+)
+
+// Documents that write cash_flow entries
+const (
+	CashSourceSale     = "sale"
+	CashSourcePurchase = "purchase"
+	CashSourceReturn   = "return"
+	CashSourceTransfer = "transfer"
+
+	CashSourceSupplierReturn = "supplier_return"
+	CashSourceStocktake      = "stocktake"
+
+	CashSourceStockAdjustment = "stock_adjustment"
+)
+
+// Reason codes of manual stock adjustments
+const (
+	AdjustmentDamage      = "damage"
+	AdjustmentLoss        = "loss"
+	AdjustmentInternalUse = "internal_use"
+	AdjustmentFound       = "found"
+	AdjustmentCorrection  = "correction"
+)
+
+// How a supplier settles a return: money paid back or a credit kept for later purchases
+const (
+	SupplierRefund = "refund"
+	SupplierCredit = "credit"
+)
+
+// MovementInfo says why a stock change happened, which document caused it and who made it.
+type MovementInfo struct {
+	Reason    string `json:"reason" db:"reason"`
+	SourceID  string `json:"source_id" db:"source_id"`
+	CreatedBy string `json:"created_by" db:"created_by"`
+	// AllowNegative lets the balance drop below zero (sales in the warning stock policy).
+	AllowNegative bool `json:"allow_negative" db:"-"`
+	// LotSourceID is the document whose lots the change goes back to: the sale of a customer return,
+	// the purchase of a supplier return. Empty: the lots of SourceID.
+	LotSourceID string `json:"lot_source_id" db:"-"`
+}
+
+// Negative stock policies of a company
+const (
+	NegativeStockStrict  = "strict"
+	NegativeStockWarning = "warning"
+)
+
+// Costing methods of a company: the moving weighted average of the stock or FIFO cost layers
+const (
+	CostingAverage = "average"
+	CostingFIFO    = "fifo"
+)
+
+// DefaultBarcodePrefix starts the internal EAN-13 codes of a company that has not chosen its own prefix.
+const DefaultBarcodePrefix = "200"
+
+// Statuses of a serial number
+const (
+	SerialInStock    = "in_stock"
+	SerialInTransit  = "in_transit"
+	SerialSold       = "sold"
+	SerialReturned   = "returned" // sent back to the supplier
+	SerialWrittenOff = "written_off"
+	SerialVoided     = "voided" // its purchase was voided
+)
+
+// DefaultBaseUnit is the base unit of a product created without one.
+const DefaultBaseUnit = "pcs"
+
+// GTINCheckDigit computes the last digit of a GTIN code (EAN-8, UPC-A, EAN-13) from the digits before it:
+// they are weighted 3 and 1 from the right and the check digit tops the sum up to a multiple of 10.
+func GTINCheckDigit(body string) byte {
+	sum := 0
+	for i := 0; i < len(body); i++ {
+		d := int(body[len(body)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// StockShortage is a sale line that asks for more than the branch has in stock.
+type StockShortage struct {
+	ProductID string `json:"product_id" db:"product_id"`
+	Name      string `json:"name" db:"name"`
+	Requested int64  `json:"requested" db:"requested"`
+	Available int64  `json:"available" db:"available"`
+}
+
+// Missing is how many units the stock is short for this line.
+func (s StockShortage) Missing() int64 {
+	return s.Requested - s.Available
+}
+
+// ShortageError rejects a sale in the strict stock policy and lists every short line.
+type ShortageError struct {
+	Lines []StockShortage
+}
+
+func (e *ShortageError) Error() string {
+	parts := make([]string, 0, len(e.Lines))
+	for _, l := range e.Lines {
+		parts = append(parts, fmt.Sprintf("%s (%s): requested %d, available %d", l.Name, l.ProductID, l.Requested, l.Available))
+	}
+	return "insufficient stock: " + strings.Join(parts, "; ")
+}
+
+// StockAdjustment corrects the stock of one product by Delta units, up or down.
+type StockAdjustment struct {
+	ProductID string   `json:"product_id" db:"product_id"`
+	Delta     int64    `json:"delta" db:"delta"`
+	Serials   []string `json:"serials" db:"serials"`
+}
+
+// StockAdjustmentRequest is a manual correction of the stock for one reason code.
+type StockAdjustmentRequest struct {
+	Reason      string            `json:"reason" db:"reason"`
+	Description string            `json:"description" db:"description"`
+	CreatedBy   string            `json:"created_by" db:"created_by"`
+	CompanyID   string            `json:"company_id" db:"company_id"`
+	BranchID    string            `json:"branch_id" db:"branch_id"`
+	Items       []StockAdjustment `json:"items" db:"items"`
+}
+
+// States of a low-stock alert
+const (
+	AlertPending = "pending"
+	AlertSent    = "sent"
+	AlertFailed  = "failed"
+	AlertSkipped = "skipped"
+)
+
+// StockAlert is raised when an outgoing movement takes a product down to its min_stock.
+type StockAlert struct {
+	ID          string `json:"id" db:"id"`
+	ProductID   string `json:"product_id" db:"product_id"`
+	ProductName string `json:"product_name" db:"product_name"`
+	BaseUnit    string `json:"base_unit" db:"base_unit"`
+	Balance     int64  `json:"balance" db:"balance"`
+	MinStock    int64  `json:"min_stock" db:"min_stock"`
+	ReorderQty  int64  `json:"reorder_qty" db:"reorder_qty"`
+	Attempts    int    `json:"attempts" db:"attempts"`
+	Phone       string `json:"phone" db:"phone"`
+	BranchID    string `json:"branch_id" db:"branch_id"`
+	CompanyID   string `json:"company_id" db:"company_id"`
+}
+
+// Kinds of a debts service request of a sale
+const (
+	SaleDebtCreate = "create" // registers the client when needed and creates the debt of the unpaid remainder
+	SaleDebtReduce = "reduce" // takes a return or a void off the debt
+)
+
+// States of a debts service request of a sale
+const (
+	SaleDebtPending   = "pending"
+	SaleDebtDone      = "done"
+	SaleDebtFailed    = "failed"
+	SaleDebtCancelled = "cancelled"
+)
+
+// SaleDebt is a debts service request written together with its sale and sent after the commit.
+type SaleDebt struct {
+	ID           string  `json:"id" db:"id"`
+	SaleID       string  `json:"sale_id" db:"sale_id"`
+	Kind         string  `json:"kind" db:"kind"`
+	SourceID     string  `json:"source_id" db:"source_id"`
+	ClientID     string  `json:"client_id" db:"client_id"`
+	ClientName   string  `json:"client_name" db:"client_name"`
+	ClientPhone  string  `json:"client_phone" db:"client_phone"`
+	Amount       float64 `json:"amount" db:"amount"`
+	CurrencyCode string  `json:"currency_code" db:"currency_code"`
+	PayType      string  `json:"pay_type" db:"pay_type"`
+	DebtID       string  `json:"debt_id" db:"debt_id"`
+	Attempts     int     `json:"attempts" db:"attempts"`
+	CompanyID    string  `json:"company_id" db:"company_id"`
 }
 
 type ProductNumber struct {
@@ -23,13 +265,21 @@ type PurchaseRequest struct {
 	PaymentMethod string            `json:"payment_method" db:"payment_method"`
 	BranchID      string            `json:"branch_id" db:"branch_id"`
 	PurchaseItems []PurchaseItemReq `json:"purchase_items" db:"purchase_items"`
+	Payments      []Payment         `json:"payments" db:"payments"`
+
+	PurchaseOrderID string `json:"purchase_order_id" db:"purchase_order_id"`
 }
 
 type PurchaseItemReq struct {
-	ProductID     string  `json:"product_id" db:"product_id"`
-	Quantity      int     `json:"quantity" db:"quantity"`
-	PurchasePrice float64 `json:"purchase_price" db:"purchase_price"`
-	TotalPrice    float64 `json:"total_price" db:"total_price"`
+	ProductID     string   `json:"product_id" db:"product_id"`
+	Quantity      int      `json:"quantity" db:"quantity"`
+	PurchasePrice float64  `json:"purchase_price" db:"purchase_price"`
+	TotalPrice    float64  `json:"total_price" db:"total_price"`
+	Unit          string   `json:"unit" db:"unit"`
+	UnitQuantity  float64  `json:"unit_quantity" db:"unit_quantity"`
+	LotNumber     string   `json:"lot_number" db:"lot_number"`
+	ExpiresAt     string   `json:"expires_at" db:"expires_at"`
+	Serials       []string `json:"serials" db:"serials"`
 }
 
 type Purchase struct {
@@ -40,12 +290,21 @@ type Purchase struct {
 	CompanyID     string         `json:"company_id" db:"company_id"`
 	BranchID      string         `json:"branch_id" db:"branch_id"`
 	PurchaseItems []PurchaseItem `json:"purchase_items" db:"purchase_items"`
+	Payments      []Payment      `json:"payments" db:"payments"`
+
+	PurchaseOrderID string `json:"purchase_order_id" db:"purchase_order_id"`
 }
 
 type PurchaseItem struct {
-	ProductID     string  `json:"product_id" db:"product_id"`
-	Quantity      int     `json:"quantity" db:"quantity"`
-	PurchasePrice float64 `json:"purchase_price" db:"purchase_price"`
+	ProductID     string   `json:"product_id" db:"product_id"`
+	CatalogID     string   `json:"catalog_id" db:"catalog_id"`
+	Quantity      int      `json:"quantity" db:"quantity"`
+	PurchasePrice float64  `json:"purchase_price" db:"purchase_price"`
+	Unit          string   `json:"unit" db:"unit"`
+	UnitQuantity  float64  `json:"unit_quantity" db:"unit_quantity"`
+	LotNumber     string   `json:"lot_number" db:"lot_number"`
+	ExpiresAt     string   `json:"expires_at" db:"expires_at"`
+	Serials       []string `json:"serials" db:"serials"`
 }
 
 type SaleRequest struct {
@@ -54,7 +313,12 @@ type SaleRequest struct {
 	PaymentMethod string      `json:"payment_method" db:"payment_method"`
 	CompanyID     string      `json:"company_id" db:"company_id"`
 	BranchID      string      `json:"branch_id" db:"branch_id"`
+	IsForDebt     bool        `json:"is_for_debt" db:"is_for_debt"`
+	PaidAmount    float64     `json:"paid_amount" db:"paid_amount"`
+	ClientName    string      `json:"client_name" db:"client_name"`
+	ClientPhone   string      `json:"client_phone" db:"client_phone"`
 	SoldProducts  []SalesItem `json:"products" db:"products"`
+	Payments      []Payment   `json:"payments" db:"payments"`
 }
 
 type SalesTotal struct {
@@ -64,16 +328,31 @@ type SalesTotal struct {
 	TotalSalePrice float64     `json:"total_sale_price" db:"total_sale_price"`
 	PaymentMethod  string      `json:"payment_method" db:"payment_method"`
 	BranchID       string      `json:"branch_id" db:"branch_id"`
+	IsForDebt      bool        `json:"is_for_debt" db:"is_for_debt"`
+	PaidAmount     float64     `json:"paid_amount" db:"paid_amount"`
 	SoldProducts   []SalesItem `json:"products" db:"products"`
+	Payments       []Payment   `json:"payments" db:"payments"`
+}
+
+// Payment is one part of a split payment of a sale or purchase.
+type Payment struct {
+	Amount        float64 `json:"amount" db:"amount"`
+	PaymentMethod string  `json:"payment_method" db:"payment_method"`
+	Currency      string  `json:"currency" db:"currency"`
+	CashFlowID    string  `json:"cash_flow_id" db:"cash_flow_id"`
 }
 
 type SalesItem struct {
-	ID         string  `json:"id" db:"id"`
-	SaleID     string  `json:"sale_id" db:"sale_id"`
-	ProductID  string  `json:"product_id" db:"product_id"`
-	Quantity   int64   `json:"quantity" db:"quantity"`
-	SalePrice  float64 `json:"sale_price" db:"sale_price"`
-	TotalPrice float64 `json:"total_price" db:"total_price"`
+	ID           string   `json:"id" db:"id"`
+	SaleID       string   `json:"sale_id" db:"sale_id"`
+	ProductID    string   `json:"product_id" db:"product_id"`
+	CatalogID    string   `json:"catalog_id" db:"catalog_id"`
+	Quantity     int64    `json:"quantity" db:"quantity"`
+	SalePrice    float64  `json:"sale_price" db:"sale_price"`
+	TotalPrice   float64  `json:"total_price" db:"total_price"`
+	Unit         string   `json:"unit" db:"unit"`
+	UnitQuantity float64  `json:"unit_quantity" db:"unit_quantity"`
+	Serials      []string `json:"serials" db:"serials"`
 }
 
 type ProductsDashboardDbRes struct {
@@ -87,3 +366,73 @@ type ManyCurrency struct {
 	Currency string  `json:"currency" db:"currency"`
 	Price    float64 `json:"price" db:"price"`
 }
+
+type ReturnRequest struct {
+	SaleID        string       `json:"sale_id" db:"sale_id"`
+	ClientID      string       `json:"client_id" db:"client_id"`
+	ReturnedBy    string       `json:"returned_by" db:"returned_by"`
+	TotalRefund   float64      `json:"total_refund" db:"total_refund"`
+	DebtReduction float64      `json:"debt_reduction" db:"debt_reduction"` // Part of a credit sale return taken off the debt
+	PaymentMethod string       `json:"payment_method" db:"payment_method"`
+	Reason        string       `json:"reason" db:"reason"`
+	CashFlowID    string       `json:"cash_flow_id" db:"cash_flow_id"`
+	CompanyID     string       `json:"company_id" db:"company_id"`
+	BranchID      string       `json:"branch_id" db:"branch_id"`
+	Items         []ReturnItem `json:"items" db:"items"`
+}
+
+type ReturnItem struct {
+	SaleItemID  string   `json:"sale_item_id" db:"sale_item_id"`
+	ProductID   string   `json:"product_id" db:"product_id"`
+	Quantity    int64    `json:"quantity" db:"quantity"`
+	RefundPrice float64  `json:"refund_price" db:"refund_price"`
+	TotalPrice  float64  `json:"total_price" db:"total_price"`
+	Serials     []string `json:"serials" db:"serials"`
+}
+
+type SupplierReturnRequest struct {
+	PurchaseID    string               `json:"purchase_id" db:"purchase_id"`
+	SupplierID    string               `json:"supplier_id" db:"supplier_id"`
+	ReturnedBy    string               `json:"returned_by" db:"returned_by"`
+	TotalRefund   float64              `json:"total_refund" db:"total_refund"`
+	Settlement    string               `json:"settlement" db:"settlement"`
+	PaymentMethod string               `json:"payment_method" db:"payment_method"`
+	Reason        string               `json:"reason" db:"reason"`
+	CompanyID     string               `json:"company_id" db:"company_id"`
+	BranchID      string               `json:"branch_id" db:"branch_id"`
+	Items         []SupplierReturnItem `json:"items" db:"items"`
+}
+
+type SupplierReturnItem struct {
+	PurchaseItemID string   `json:"purchase_item_id" db:"purchase_item_id"`
+	ProductID      string   `json:"product_id" db:"product_id"`
+	Quantity       int64    `json:"quantity" db:"quantity"`
+	PurchasePrice  float64  `json:"purchase_price" db:"purchase_price"`
+	TotalPrice     float64  `json:"total_price" db:"total_price"`
+	Serials        []string `json:"serials" db:"serials"`
+}
+
+type PurchaseOrderRequest struct {
+	SupplierID  string            `json:"supplier_id" db:"supplier_id"`
+	OrderedBy   string            `json:"ordered_by" db:"ordered_by"`
+	ExpectedAt  string            `json:"expected_at" db:"expected_at"`
+	Description string            `json:"description" db:"description"`
+	TotalCost   float64           `json:"total_cost" db:"total_cost"`
+	CompanyID   string            `json:"company_id" db:"company_id"`
+	BranchID    string            `json:"branch_id" db:"branch_id"`
+	Items       []PurchaseItemReq `json:"items" db:"items"`
+}
+
+// ReceivedOrderItem is a quantity of one purchase order line taken onto the stock.
+type ReceivedOrderItem struct {
+	OrderItemID string `json:"order_item_id" db:"order_item_id"`
+	Quantity    int    `json:"quantity" db:"quantity"`
+}
+
+// ReceivedTransferItem is the quantity of a transfer line that arrived at the target branch.
+type ReceivedTransferItem struct {
+	TransferProductID string   `json:"transfer_product_id" db:"transfer_product_id"`
+	Quantity          int64    `json:"quantity" db:"quantity"`
+	Note              string   `json:"note" db:"note"`
+	Serials           []string `json:"serials" db:"serials"`
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "testing"
+
+func TestGTINCheckDigit(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want byte
+	}{
+		{name: "EAN-13", body: "400638133393", want: '1'},
+		{name: "EAN-13 of an internal code", body: "200000000001", want: '5'},
+		{name: "EAN-8", body: "9638507", want: '4'},
+		{name: "UPC-A", body: "03600029145", want: '2'},
+		{name: "GTIN-14", body: "1061414100041", want: '5'},
+		{name: "sum already a multiple of ten", body: "000000000000", want: '0'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GTINCheckDigit(tt.body); got != tt.want {
+				t.Errorf("GTINCheckDigit(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
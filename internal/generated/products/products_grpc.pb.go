@@ -19,47 +19,99 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Products_CreateCategory_FullMethodName           = "/products.Products/CreateCategory"
-	Products_UpdateCategory_FullMethodName           = "/products.Products/UpdateCategory"
-	Products_DeleteCategory_FullMethodName           = "/products.Products/DeleteCategory"
-	Products_GetCategory_FullMethodName              = "/products.Products/GetCategory"
-	Products_GetListCategory_FullMethodName          = "/products.Products/GetListCategory"
-	Products_CreateProduct_FullMethodName            = "/products.Products/CreateProduct"
-	Products_CreateBulkProducts_FullMethodName       = "/products.Products/CreateBulkProducts"
-	Products_UpdateProduct_FullMethodName            = "/products.Products/UpdateProduct"
-	Products_DeleteProduct_FullMethodName            = "/products.Products/DeleteProduct"
-	Products_GetProduct_FullMethodName               = "/products.Products/GetProduct"
-	Products_GetProductList_FullMethodName           = "/products.Products/GetProductList"
-	Products_CreatePurchase_FullMethodName           = "/products.Products/CreatePurchase"
-	Products_GetPurchase_FullMethodName              = "/products.Products/GetPurchase"
-	Products_GetListPurchase_FullMethodName          = "/products.Products/GetListPurchase"
-	Products_UpdatePurchase_FullMethodName           = "/products.Products/UpdatePurchase"
-	Products_DeletePurchase_FullMethodName           = "/products.Products/DeletePurchase"
-	Products_CalculateTotalSales_FullMethodName      = "/products.Products/CalculateTotalSales"
-	Products_CreateSales_FullMethodName              = "/products.Products/CreateSales"
-	Products_UpdateSales_FullMethodName              = "/products.Products/UpdateSales"
-	Products_GetSales_FullMethodName                 = "/products.Products/GetSales"
-	Products_GetListSales_FullMethodName             = "/products.Products/GetListSales"
-	Products_DeleteSales_FullMethodName              = "/products.Products/DeleteSales"
-	Products_GetCashFlow_FullMethodName              = "/products.Products/GetCashFlow"
-	Products_CreateIncome_FullMethodName             = "/products.Products/CreateIncome"
-	Products_CreateExpense_FullMethodName            = "/products.Products/CreateExpense"
-	Products_GetTotalIncome_FullMethodName           = "/products.Products/GetTotalIncome"
-	Products_GetTotalExpense_FullMethodName          = "/products.Products/GetTotalExpense"
-	Products_GetNetProfit_FullMethodName             = "/products.Products/GetNetProfit"
-	Products_CreateTransfers_FullMethodName          = "/products.Products/CreateTransfers"
-	Products_GetTransfers_FullMethodName             = "/products.Products/GetTransfers"
-	Products_GetTransferList_FullMethodName          = "/products.Products/GetTransferList"
-	Products_TotalPriceOfProducts_FullMethodName     = "/products.Products/TotalPriceOfProducts"
-	Products_TotalSoldProducts_FullMethodName        = "/products.Products/TotalSoldProducts"
-	Products_TotalPurchaseProducts_FullMethodName    = "/products.Products/TotalPurchaseProducts"
-	Products_GetMostSoldProductsByDay_FullMethodName = "/products.Products/GetMostSoldProductsByDay"
-	Products_GetTopClients_FullMethodName            = "/products.Products/GetTopClients"
-	Products_GetTopSuppliers_FullMethodName          = "/products.Products/GetTopSuppliers"
-	Products_GetClientDashboard_FullMethodName       = "/products.Products/GetClientDashboard"
-	Products_GetSaleStatistics_FullMethodName        = "/products.Products/GetSaleStatistics"
-	Products_GetBranchIncome_FullMethodName          = "/products.Products/GetBranchIncome"
-	Products_GetProductDashboard_FullMethodName      = "/products.Products/GetProductDashboard"
+	Products_CreateCategory_FullMethodName               = "/products.Products/CreateCategory"
+	Products_UpdateCategory_FullMethodName               = "/products.Products/UpdateCategory"
+	Products_DeleteCategory_FullMethodName               = "/products.Products/DeleteCategory"
+	Products_GetCategory_FullMethodName                  = "/products.Products/GetCategory"
+	Products_GetListCategory_FullMethodName              = "/products.Products/GetListCategory"
+	Products_CreateProduct_FullMethodName                = "/products.Products/CreateProduct"
+	Products_CreateBulkProducts_FullMethodName           = "/products.Products/CreateBulkProducts"
+	Products_UpdateProduct_FullMethodName                = "/products.Products/UpdateProduct"
+	Products_DeleteProduct_FullMethodName                = "/products.Products/DeleteProduct"
+	Products_GetProduct_FullMethodName                   = "/products.Products/GetProduct"
+	Products_GetProductList_FullMethodName               = "/products.Products/GetProductList"
+	Products_CreatePurchase_FullMethodName               = "/products.Products/CreatePurchase"
+	Products_GetPurchase_FullMethodName                  = "/products.Products/GetPurchase"
+	Products_GetListPurchase_FullMethodName              = "/products.Products/GetListPurchase"
+	Products_UpdatePurchase_FullMethodName               = "/products.Products/UpdatePurchase"
+	Products_DeletePurchase_FullMethodName               = "/products.Products/DeletePurchase"
+	Products_CalculateTotalSales_FullMethodName          = "/products.Products/CalculateTotalSales"
+	Products_CreateSales_FullMethodName                  = "/products.Products/CreateSales"
+	Products_UpdateSales_FullMethodName                  = "/products.Products/UpdateSales"
+	Products_GetSales_FullMethodName                     = "/products.Products/GetSales"
+	Products_GetListSales_FullMethodName                 = "/products.Products/GetListSales"
+	Products_DeleteSales_FullMethodName                  = "/products.Products/DeleteSales"
+	Products_GetCashFlow_FullMethodName                  = "/products.Products/GetCashFlow"
+	Products_CreateIncome_FullMethodName                 = "/products.Products/CreateIncome"
+	Products_CreateExpense_FullMethodName                = "/products.Products/CreateExpense"
+	Products_GetTotalIncome_FullMethodName               = "/products.Products/GetTotalIncome"
+	Products_GetTotalExpense_FullMethodName              = "/products.Products/GetTotalExpense"
+	Products_GetNetProfit_FullMethodName                 = "/products.Products/GetNetProfit"
+	Products_CreateTransfers_FullMethodName              = "/products.Products/CreateTransfers"
+	Products_GetTransfers_FullMethodName                 = "/products.Products/GetTransfers"
+	Products_GetTransferList_FullMethodName              = "/products.Products/GetTransferList"
+	Products_TotalPriceOfProducts_FullMethodName         = "/products.Products/TotalPriceOfProducts"
+	Products_TotalSoldProducts_FullMethodName            = "/products.Products/TotalSoldProducts"
+	Products_TotalPurchaseProducts_FullMethodName        = "/products.Products/TotalPurchaseProducts"
+	Products_GetMostSoldProductsByDay_FullMethodName     = "/products.Products/GetMostSoldProductsByDay"
+	Products_GetTopClients_FullMethodName                = "/products.Products/GetTopClients"
+	Products_GetTopSuppliers_FullMethodName              = "/products.Products/GetTopSuppliers"
+	Products_GetClientDashboard_FullMethodName           = "/products.Products/GetClientDashboard"
+	Products_GetSaleStatistics_FullMethodName            = "/products.Products/GetSaleStatistics"
+	Products_GetBranchIncome_FullMethodName              = "/products.Products/GetBranchIncome"
+	Products_GetProductDashboard_FullMethodName          = "/products.Products/GetProductDashboard"
+	Products_CreateReturn_FullMethodName                 = "/products.Products/CreateReturn"
+	Products_GetReturn_FullMethodName                    = "/products.Products/GetReturn"
+	Products_GetListReturns_FullMethodName               = "/products.Products/GetListReturns"
+	Products_UpdateReturn_FullMethodName                 = "/products.Products/UpdateReturn"
+	Products_DeleteReturn_FullMethodName                 = "/products.Products/DeleteReturn"
+	Products_GetStockMovements_FullMethodName            = "/products.Products/GetStockMovements"
+	Products_GetCompanySettings_FullMethodName           = "/products.Products/GetCompanySettings"
+	Products_UpdateCompanySettings_FullMethodName        = "/products.Products/UpdateCompanySettings"
+	Products_GetLowStockProducts_FullMethodName          = "/products.Products/GetLowStockProducts"
+	Products_CreatePaymentType_FullMethodName            = "/products.Products/CreatePaymentType"
+	Products_UpdatePaymentType_FullMethodName            = "/products.Products/UpdatePaymentType"
+	Products_GetPaymentType_FullMethodName               = "/products.Products/GetPaymentType"
+	Products_GetListPaymentTypes_FullMethodName          = "/products.Products/GetListPaymentTypes"
+	Products_DeletePaymentType_FullMethodName            = "/products.Products/DeletePaymentType"
+	Products_VoidSale_FullMethodName                     = "/products.Products/VoidSale"
+	Products_VoidPurchase_FullMethodName                 = "/products.Products/VoidPurchase"
+	Products_UpdateSaleItems_FullMethodName              = "/products.Products/UpdateSaleItems"
+	Products_UpdatePurchaseItems_FullMethodName          = "/products.Products/UpdatePurchaseItems"
+	Products_CreateSupplierReturn_FullMethodName         = "/products.Products/CreateSupplierReturn"
+	Products_GetSupplierReturn_FullMethodName            = "/products.Products/GetSupplierReturn"
+	Products_GetListSupplierReturns_FullMethodName       = "/products.Products/GetListSupplierReturns"
+	Products_DeleteSupplierReturn_FullMethodName         = "/products.Products/DeleteSupplierReturn"
+	Products_CreatePurchaseOrder_FullMethodName          = "/products.Products/CreatePurchaseOrder"
+	Products_GetPurchaseOrder_FullMethodName             = "/products.Products/GetPurchaseOrder"
+	Products_GetListPurchaseOrders_FullMethodName        = "/products.Products/GetListPurchaseOrders"
+	Products_UpdatePurchaseOrder_FullMethodName          = "/products.Products/UpdatePurchaseOrder"
+	Products_SendPurchaseOrder_FullMethodName            = "/products.Products/SendPurchaseOrder"
+	Products_CancelPurchaseOrder_FullMethodName          = "/products.Products/CancelPurchaseOrder"
+	Products_ReceivePurchaseOrder_FullMethodName         = "/products.Products/ReceivePurchaseOrder"
+	Products_GetOutstandingPurchaseOrders_FullMethodName = "/products.Products/GetOutstandingPurchaseOrders"
+	Products_DispatchTransfer_FullMethodName             = "/products.Products/DispatchTransfer"
+	Products_MarkTransferInTransit_FullMethodName        = "/products.Products/MarkTransferInTransit"
+	Products_ReceiveTransfer_FullMethodName              = "/products.Products/ReceiveTransfer"
+	Products_CancelTransfer_FullMethodName               = "/products.Products/CancelTransfer"
+	Products_GetCatalogStock_FullMethodName              = "/products.Products/GetCatalogStock"
+	Products_StartStocktake_FullMethodName               = "/products.Products/StartStocktake"
+	Products_SubmitStocktakeCounts_FullMethodName        = "/products.Products/SubmitStocktakeCounts"
+	Products_GetStocktake_FullMethodName                 = "/products.Products/GetStocktake"
+	Products_GetListStocktakes_FullMethodName            = "/products.Products/GetListStocktakes"
+	Products_CloseStocktake_FullMethodName               = "/products.Products/CloseStocktake"
+	Products_CancelStocktake_FullMethodName              = "/products.Products/CancelStocktake"
+	Products_AdjustStock_FullMethodName                  = "/products.Products/AdjustStock"
+	Products_GetWriteOffReport_FullMethodName            = "/products.Products/GetWriteOffReport"
+	Products_SetStockThresholds_FullMethodName           = "/products.Products/SetStockThresholds"
+	Products_GetProductByBarcode_FullMethodName          = "/products.Products/GetProductByBarcode"
+	Products_RenderLabels_FullMethodName                 = "/products.Products/RenderLabels"
+	Products_CreateVariant_FullMethodName                = "/products.Products/CreateVariant"
+	Products_GetExpiringStock_FullMethodName             = "/products.Products/GetExpiringStock"
+	Products_TraceSerial_FullMethodName                  = "/products.Products/TraceSerial"
+	Products_GetProductSerials_FullMethodName            = "/products.Products/GetProductSerials"
+	Products_VoidReturn_FullMethodName                   = "/products.Products/VoidReturn"
+	Products_VoidSupplierReturn_FullMethodName           = "/products.Products/VoidSupplierReturn"
 )
 
 // ProductsClient is the client API for Products service.
@@ -116,6 +168,78 @@ type ProductsClient interface {
 	GetSaleStatistics(ctx context.Context, in *SaleStatisticsReq, opts ...grpc.CallOption) (*SaleStatistics, error)
 	GetBranchIncome(ctx context.Context, in *BranchIncomeReq, opts ...grpc.CallOption) (*BranchIncomeRes, error)
 	GetProductDashboard(ctx context.Context, in *GetProductsDashboardReq, opts ...grpc.CallOption) (*GetProductsDashboardRes, error)
+	// -------------------- Returns -----------------------------
+	CreateReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error)
+	GetReturn(ctx context.Context, in *ReturnID, opts ...grpc.CallOption) (*ReturnResponse, error)
+	GetListReturns(ctx context.Context, in *ReturnFilter, opts ...grpc.CallOption) (*ReturnList, error)
+	UpdateReturn(ctx context.Context, in *ReturnUpdate, opts ...grpc.CallOption) (*ReturnResponse, error)
+	DeleteReturn(ctx context.Context, in *ReturnID, opts ...grpc.CallOption) (*Message, error)
+	// -------------------- Stock Movements ---------------------
+	GetStockMovements(ctx context.Context, in *StockMovementFilter, opts ...grpc.CallOption) (*StockMovementList, error)
+	// -------------------- Company Settings --------------------
+	GetCompanySettings(ctx context.Context, in *CompanySettingsReq, opts ...grpc.CallOption) (*CompanySettings, error)
+	UpdateCompanySettings(ctx context.Context, in *CompanySettings, opts ...grpc.CallOption) (*CompanySettings, error)
+	// -------------------- Low Stock ---------------------------
+	GetLowStockProducts(ctx context.Context, in *LowStockFilter, opts ...grpc.CallOption) (*LowStockList, error)
+	// -------------------- Payment Types -----------------------
+	CreatePaymentType(ctx context.Context, in *PaymentType, opts ...grpc.CallOption) (*PaymentType, error)
+	UpdatePaymentType(ctx context.Context, in *PaymentType, opts ...grpc.CallOption) (*PaymentType, error)
+	GetPaymentType(ctx context.Context, in *PaymentTypeID, opts ...grpc.CallOption) (*PaymentType, error)
+	GetListPaymentTypes(ctx context.Context, in *PaymentTypeFilter, opts ...grpc.CallOption) (*PaymentTypeList, error)
+	DeletePaymentType(ctx context.Context, in *PaymentTypeID, opts ...grpc.CallOption) (*Message, error)
+	// -------------------- Void --------------------------------
+	VoidSale(ctx context.Context, in *VoidSaleReq, opts ...grpc.CallOption) (*SaleResponse, error)
+	VoidPurchase(ctx context.Context, in *VoidPurchaseReq, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	// -------------------- Sale Items Update -------------------
+	UpdateSaleItems(ctx context.Context, in *UpdateSaleItemsReq, opts ...grpc.CallOption) (*SaleResponse, error)
+	// -------------------- Purchase Items Update ---------------
+	UpdatePurchaseItems(ctx context.Context, in *UpdatePurchaseItemsReq, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	// -------------------- Supplier Returns --------------------
+	CreateSupplierReturn(ctx context.Context, in *SupplierReturnRequest, opts ...grpc.CallOption) (*SupplierReturnResponse, error)
+	GetSupplierReturn(ctx context.Context, in *SupplierReturnID, opts ...grpc.CallOption) (*SupplierReturnResponse, error)
+	GetListSupplierReturns(ctx context.Context, in *SupplierReturnFilter, opts ...grpc.CallOption) (*SupplierReturnList, error)
+	DeleteSupplierReturn(ctx context.Context, in *SupplierReturnID, opts ...grpc.CallOption) (*Message, error)
+	// -------------------- Purchase Orders ---------------------
+	CreatePurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	GetListPurchaseOrders(ctx context.Context, in *PurchaseOrderFilter, opts ...grpc.CallOption) (*PurchaseOrderList, error)
+	UpdatePurchaseOrder(ctx context.Context, in *PurchaseOrderUpdate, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	SendPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderReq, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	GetOutstandingPurchaseOrders(ctx context.Context, in *OutstandingOrdersReq, opts ...grpc.CallOption) (*OutstandingOrdersList, error)
+	// -------------------- Transfer Workflow -------------------
+	DispatchTransfer(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error)
+	MarkTransferInTransit(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error)
+	ReceiveTransfer(ctx context.Context, in *ReceiveTransferReq, opts ...grpc.CallOption) (*Transfer, error)
+	CancelTransfer(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error)
+	// -------------------- Catalog -----------------------------
+	GetCatalogStock(ctx context.Context, in *CatalogStockReq, opts ...grpc.CallOption) (*CatalogStockList, error)
+	// -------------------- Stocktakes --------------------------
+	StartStocktake(ctx context.Context, in *StocktakeRequest, opts ...grpc.CallOption) (*Stocktake, error)
+	SubmitStocktakeCounts(ctx context.Context, in *SubmitStocktakeCountsReq, opts ...grpc.CallOption) (*Stocktake, error)
+	GetStocktake(ctx context.Context, in *StocktakeID, opts ...grpc.CallOption) (*Stocktake, error)
+	GetListStocktakes(ctx context.Context, in *StocktakeFilter, opts ...grpc.CallOption) (*StocktakeList, error)
+	CloseStocktake(ctx context.Context, in *CloseStocktakeReq, opts ...grpc.CallOption) (*Stocktake, error)
+	CancelStocktake(ctx context.Context, in *StocktakeID, opts ...grpc.CallOption) (*Stocktake, error)
+	// -------------------- Stock Adjustments -------------------
+	AdjustStock(ctx context.Context, in *StockAdjustmentReq, opts ...grpc.CallOption) (*StockAdjustment, error)
+	GetWriteOffReport(ctx context.Context, in *WriteOffReportReq, opts ...grpc.CallOption) (*WriteOffReport, error)
+	// -------------------- Stock Thresholds --------------------
+	SetStockThresholds(ctx context.Context, in *StockThresholdsReq, opts ...grpc.CallOption) (*Product, error)
+	// -------------------- Barcodes ----------------------------
+	GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeReq, opts ...grpc.CallOption) (*Product, error)
+	// -------------------- Labels ------------------------------
+	RenderLabels(ctx context.Context, in *RenderLabelsReq, opts ...grpc.CallOption) (*Labels, error)
+	// -------------------- Variants ----------------------------
+	CreateVariant(ctx context.Context, in *CreateVariantReq, opts ...grpc.CallOption) (*Product, error)
+	// -------------------- Lots --------------------------------
+	GetExpiringStock(ctx context.Context, in *ExpiringStockReq, opts ...grpc.CallOption) (*ExpiringStockList, error)
+	// -------------------- Serial Numbers ----------------------
+	TraceSerial(ctx context.Context, in *TraceSerialReq, opts ...grpc.CallOption) (*SerialTrace, error)
+	GetProductSerials(ctx context.Context, in *ProductSerialsReq, opts ...grpc.CallOption) (*ProductSerialList, error)
+	VoidReturn(ctx context.Context, in *VoidReturnReq, opts ...grpc.CallOption) (*ReturnResponse, error)
+	VoidSupplierReturn(ctx context.Context, in *VoidSupplierReturnReq, opts ...grpc.CallOption) (*SupplierReturnResponse, error)
 }
 
 type productsClient struct {
@@ -536,937 +660,2621 @@ func (c *productsClient) GetProductDashboard(ctx context.Context, in *GetProduct
 	return out, nil
 }
 
-// ProductsServer is the server API for Products service.
-// All implementations must embed UnimplementedProductsServer
-// for forward compatibility
-//
-// -------------------- Services ---------------------------------
-type ProductsServer interface {
-	// ------------ Product Category ----------------------------
-	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
-	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
-	DeleteCategory(context.Context, *GetCategoryRequest) (*Message, error)
-	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
-	GetListCategory(context.Context, *CategoryName) (*CategoryList, error)
-	// ------------- Products -----------------------------------
-	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
-	CreateBulkProducts(context.Context, *CreateBulkProductsRequest) (*BulkCreateResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
-	DeleteProduct(context.Context, *GetProductRequest) (*Message, error)
-	GetProduct(context.Context, *GetProductRequest) (*Product, error)
-	GetProductList(context.Context, *ProductFilter) (*ProductList, error)
-	// -------------- Purchases ---------------------------------
-	CreatePurchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
-	GetPurchase(context.Context, *PurchaseID) (*PurchaseResponse, error)
-	GetListPurchase(context.Context, *FilterPurchase) (*PurchaseList, error)
-	UpdatePurchase(context.Context, *PurchaseUpdate) (*PurchaseResponse, error)
-	DeletePurchase(context.Context, *PurchaseID) (*Message, error)
-	// ------------------ Sales ---------------------------------
-	CalculateTotalSales(context.Context, *SaleRequest) (*SaleResponse, error)
-	CreateSales(context.Context, *SaleRequest) (*SaleResponse, error)
-	UpdateSales(context.Context, *SaleUpdate) (*SaleResponse, error)
-	GetSales(context.Context, *SaleID) (*SaleResponse, error)
-	GetListSales(context.Context, *SaleFilter) (*SaleList, error)
-	DeleteSales(context.Context, *SaleID) (*Message, error)
-	// -------------------- Cash Flow ----------------------------
-	GetCashFlow(context.Context, *CashFlowReq) (*ListCashFlow, error)
-	CreateIncome(context.Context, *CashFlowRequest) (*CashFlow, error)
-	CreateExpense(context.Context, *CashFlowRequest) (*CashFlow, error)
-	GetTotalIncome(context.Context, *StatisticReq) (*PriceProducts, error)
-	GetTotalExpense(context.Context, *StatisticReq) (*PriceProducts, error)
-	GetNetProfit(context.Context, *StatisticReq) (*PriceProducts, error)
-	CreateTransfers(context.Context, *TransferReq) (*Transfer, error)
-	GetTransfers(context.Context, *TransferID) (*Transfer, error)
-	GetTransferList(context.Context, *TransferFilter) (*TransferList, error)
-	// ------------------- statistics --------------------------
-	TotalPriceOfProducts(context.Context, *StatisticReq) (*PriceProducts, error)
-	TotalSoldProducts(context.Context, *StatisticReq) (*PriceProducts, error)
-	TotalPurchaseProducts(context.Context, *StatisticReq) (*PriceProducts, error)
-	GetMostSoldProductsByDay(context.Context, *MostSoldProductsRequest) (*MostSoldProductsResponse, error)
-	GetTopClients(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error)
-	GetTopSuppliers(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error)
-	GetClientDashboard(context.Context, *GetClientDashboardRequest) (*GetClientDashboardResponse, error)
-	// ------------------------ Graph Statistics -----------------
-	GetSaleStatistics(context.Context, *SaleStatisticsReq) (*SaleStatistics, error)
-	GetBranchIncome(context.Context, *BranchIncomeReq) (*BranchIncomeRes, error)
-	GetProductDashboard(context.Context, *GetProductsDashboardReq) (*GetProductsDashboardRes, error)
-	mustEmbedUnimplementedProductsServer()
+func (c *productsClient) CreateReturn(ctx context.Context, in *ReturnRequest, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, Products_CreateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductsServer must be embedded to have forward compatible implementations.
-type UnimplementedProductsServer struct {
+func (c *productsClient) GetReturn(ctx context.Context, in *ReturnID, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, Products_GetReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedProductsServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
-}
-func (UnimplementedProductsServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
-}
-func (UnimplementedProductsServer) DeleteCategory(context.Context, *GetCategoryRequest) (*Message, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+func (c *productsClient) GetListReturns(ctx context.Context, in *ReturnFilter, opts ...grpc.CallOption) (*ReturnList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnList)
+	err := c.cc.Invoke(ctx, Products_GetListReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+
+func (c *productsClient) UpdateReturn(ctx context.Context, in *ReturnUpdate, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, Products_UpdateReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetListCategory(context.Context, *CategoryName) (*CategoryList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListCategory not implemented")
+
+func (c *productsClient) DeleteReturn(ctx context.Context, in *ReturnID, opts ...grpc.CallOption) (*Message, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Message)
+	err := c.cc.Invoke(ctx, Products_DeleteReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+
+func (c *productsClient) GetStockMovements(ctx context.Context, in *StockMovementFilter, opts ...grpc.CallOption) (*StockMovementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovementList)
+	err := c.cc.Invoke(ctx, Products_GetStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreateBulkProducts(context.Context, *CreateBulkProductsRequest) (*BulkCreateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkProducts not implemented")
+
+func (c *productsClient) GetCompanySettings(ctx context.Context, in *CompanySettingsReq, opts ...grpc.CallOption) (*CompanySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanySettings)
+	err := c.cc.Invoke(ctx, Products_GetCompanySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *productsClient) UpdateCompanySettings(ctx context.Context, in *CompanySettings, opts ...grpc.CallOption) (*CompanySettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanySettings)
+	err := c.cc.Invoke(ctx, Products_UpdateCompanySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) DeleteProduct(context.Context, *GetProductRequest) (*Message, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+func (c *productsClient) GetLowStockProducts(ctx context.Context, in *LowStockFilter, opts ...grpc.CallOption) (*LowStockList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowStockList)
+	err := c.cc.Invoke(ctx, Products_GetLowStockProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+
+func (c *productsClient) CreatePaymentType(ctx context.Context, in *PaymentType, opts ...grpc.CallOption) (*PaymentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentType)
+	err := c.cc.Invoke(ctx, Products_CreatePaymentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetProductList(context.Context, *ProductFilter) (*ProductList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductList not implemented")
+
+func (c *productsClient) UpdatePaymentType(ctx context.Context, in *PaymentType, opts ...grpc.CallOption) (*PaymentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentType)
+	err := c.cc.Invoke(ctx, Products_UpdatePaymentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreatePurchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchase not implemented")
+
+func (c *productsClient) GetPaymentType(ctx context.Context, in *PaymentTypeID, opts ...grpc.CallOption) (*PaymentType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentType)
+	err := c.cc.Invoke(ctx, Products_GetPaymentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetPurchase(context.Context, *PurchaseID) (*PurchaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPurchase not implemented")
+
+func (c *productsClient) GetListPaymentTypes(ctx context.Context, in *PaymentTypeFilter, opts ...grpc.CallOption) (*PaymentTypeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentTypeList)
+	err := c.cc.Invoke(ctx, Products_GetListPaymentTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetListPurchase(context.Context, *FilterPurchase) (*PurchaseList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListPurchase not implemented")
+
+func (c *productsClient) DeletePaymentType(ctx context.Context, in *PaymentTypeID, opts ...grpc.CallOption) (*Message, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Message)
+	err := c.cc.Invoke(ctx, Products_DeletePaymentType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) UpdatePurchase(context.Context, *PurchaseUpdate) (*PurchaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchase not implemented")
+
+func (c *productsClient) VoidSale(ctx context.Context, in *VoidSaleReq, opts ...grpc.CallOption) (*SaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaleResponse)
+	err := c.cc.Invoke(ctx, Products_VoidSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) DeletePurchase(context.Context, *PurchaseID) (*Message, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePurchase not implemented")
+
+func (c *productsClient) VoidPurchase(ctx context.Context, in *VoidPurchaseReq, opts ...grpc.CallOption) (*PurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseResponse)
+	err := c.cc.Invoke(ctx, Products_VoidPurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CalculateTotalSales(context.Context, *SaleRequest) (*SaleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateTotalSales not implemented")
+
+func (c *productsClient) UpdateSaleItems(ctx context.Context, in *UpdateSaleItemsReq, opts ...grpc.CallOption) (*SaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaleResponse)
+	err := c.cc.Invoke(ctx, Products_UpdateSaleItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreateSales(context.Context, *SaleRequest) (*SaleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSales not implemented")
+
+func (c *productsClient) UpdatePurchaseItems(ctx context.Context, in *UpdatePurchaseItemsReq, opts ...grpc.CallOption) (*PurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseResponse)
+	err := c.cc.Invoke(ctx, Products_UpdatePurchaseItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) UpdateSales(context.Context, *SaleUpdate) (*SaleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSales not implemented")
+
+func (c *productsClient) CreateSupplierReturn(ctx context.Context, in *SupplierReturnRequest, opts ...grpc.CallOption) (*SupplierReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierReturnResponse)
+	err := c.cc.Invoke(ctx, Products_CreateSupplierReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetSales(context.Context, *SaleID) (*SaleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSales not implemented")
+
+func (c *productsClient) GetSupplierReturn(ctx context.Context, in *SupplierReturnID, opts ...grpc.CallOption) (*SupplierReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierReturnResponse)
+	err := c.cc.Invoke(ctx, Products_GetSupplierReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetListSales(context.Context, *SaleFilter) (*SaleList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListSales not implemented")
+
+func (c *productsClient) GetListSupplierReturns(ctx context.Context, in *SupplierReturnFilter, opts ...grpc.CallOption) (*SupplierReturnList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierReturnList)
+	err := c.cc.Invoke(ctx, Products_GetListSupplierReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) DeleteSales(context.Context, *SaleID) (*Message, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSales not implemented")
+
+func (c *productsClient) DeleteSupplierReturn(ctx context.Context, in *SupplierReturnID, opts ...grpc.CallOption) (*Message, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Message)
+	err := c.cc.Invoke(ctx, Products_DeleteSupplierReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) GetCashFlow(context.Context, *CashFlowReq) (*ListCashFlow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCashFlow not implemented")
+
+func (c *productsClient) CreatePurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, Products_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreateIncome(context.Context, *CashFlowRequest) (*CashFlow, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIncome not implemented")
+
+func (c *productsClient) GetPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, Products_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductsServer) CreateExpense(context.Context, *CashFlowRequest) (*CashFlow, error) {
+
+func (c *productsClient) GetListPurchaseOrders(ctx context.Context, in *PurchaseOrderFilter, opts ...grpc.CallOption) (*PurchaseOrderList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderList)
+	err := c.cc.Invoke(ctx, Products_GetListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) UpdatePurchaseOrder(ctx context.Context, in *PurchaseOrderUpdate, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, Products_UpdatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) SendPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, Products_SendPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) CancelPurchaseOrder(ctx context.Context, in *PurchaseOrderID, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, Products_CancelPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderReq, opts ...grpc.CallOption) (*PurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseResponse)
+	err := c.cc.Invoke(ctx, Products_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetOutstandingPurchaseOrders(ctx context.Context, in *OutstandingOrdersReq, opts ...grpc.CallOption) (*OutstandingOrdersList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutstandingOrdersList)
+	err := c.cc.Invoke(ctx, Products_GetOutstandingPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) DispatchTransfer(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Products_DispatchTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) MarkTransferInTransit(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Products_MarkTransferInTransit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) ReceiveTransfer(ctx context.Context, in *ReceiveTransferReq, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Products_ReceiveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) CancelTransfer(ctx context.Context, in *TransferStatusReq, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, Products_CancelTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetCatalogStock(ctx context.Context, in *CatalogStockReq, opts ...grpc.CallOption) (*CatalogStockList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogStockList)
+	err := c.cc.Invoke(ctx, Products_GetCatalogStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) StartStocktake(ctx context.Context, in *StocktakeRequest, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, Products_StartStocktake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) SubmitStocktakeCounts(ctx context.Context, in *SubmitStocktakeCountsReq, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, Products_SubmitStocktakeCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetStocktake(ctx context.Context, in *StocktakeID, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, Products_GetStocktake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetListStocktakes(ctx context.Context, in *StocktakeFilter, opts ...grpc.CallOption) (*StocktakeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StocktakeList)
+	err := c.cc.Invoke(ctx, Products_GetListStocktakes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) CloseStocktake(ctx context.Context, in *CloseStocktakeReq, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, Products_CloseStocktake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) CancelStocktake(ctx context.Context, in *StocktakeID, opts ...grpc.CallOption) (*Stocktake, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stocktake)
+	err := c.cc.Invoke(ctx, Products_CancelStocktake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) AdjustStock(ctx context.Context, in *StockAdjustmentReq, opts ...grpc.CallOption) (*StockAdjustment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockAdjustment)
+	err := c.cc.Invoke(ctx, Products_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetWriteOffReport(ctx context.Context, in *WriteOffReportReq, opts ...grpc.CallOption) (*WriteOffReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteOffReport)
+	err := c.cc.Invoke(ctx, Products_GetWriteOffReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) SetStockThresholds(ctx context.Context, in *StockThresholdsReq, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, Products_SetStockThresholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeReq, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, Products_GetProductByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) RenderLabels(ctx context.Context, in *RenderLabelsReq, opts ...grpc.CallOption) (*Labels, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Labels)
+	err := c.cc.Invoke(ctx, Products_RenderLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) CreateVariant(ctx context.Context, in *CreateVariantReq, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, Products_CreateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetExpiringStock(ctx context.Context, in *ExpiringStockReq, opts ...grpc.CallOption) (*ExpiringStockList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpiringStockList)
+	err := c.cc.Invoke(ctx, Products_GetExpiringStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) TraceSerial(ctx context.Context, in *TraceSerialReq, opts ...grpc.CallOption) (*SerialTrace, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SerialTrace)
+	err := c.cc.Invoke(ctx, Products_TraceSerial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) GetProductSerials(ctx context.Context, in *ProductSerialsReq, opts ...grpc.CallOption) (*ProductSerialList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductSerialList)
+	err := c.cc.Invoke(ctx, Products_GetProductSerials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) VoidReturn(ctx context.Context, in *VoidReturnReq, opts ...grpc.CallOption) (*ReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnResponse)
+	err := c.cc.Invoke(ctx, Products_VoidReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productsClient) VoidSupplierReturn(ctx context.Context, in *VoidSupplierReturnReq, opts ...grpc.CallOption) (*SupplierReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierReturnResponse)
+	err := c.cc.Invoke(ctx, Products_VoidSupplierReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductsServer is the server API for Products service.
+// All implementations must embed UnimplementedProductsServer
+// for forward compatibility
+//
+// -------------------- Services ---------------------------------
+type ProductsServer interface {
+	// ------------ Product Category ----------------------------
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error)
+	DeleteCategory(context.Context, *GetCategoryRequest) (*Message, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
+	GetListCategory(context.Context, *CategoryName) (*CategoryList, error)
+	// ------------- Products -----------------------------------
+	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
+	CreateBulkProducts(context.Context, *CreateBulkProductsRequest) (*BulkCreateResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	DeleteProduct(context.Context, *GetProductRequest) (*Message, error)
+	GetProduct(context.Context, *GetProductRequest) (*Product, error)
+	GetProductList(context.Context, *ProductFilter) (*ProductList, error)
+	// -------------- Purchases ---------------------------------
+	CreatePurchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
+	GetPurchase(context.Context, *PurchaseID) (*PurchaseResponse, error)
+	GetListPurchase(context.Context, *FilterPurchase) (*PurchaseList, error)
+	UpdatePurchase(context.Context, *PurchaseUpdate) (*PurchaseResponse, error)
+	DeletePurchase(context.Context, *PurchaseID) (*Message, error)
+	// ------------------ Sales ---------------------------------
+	CalculateTotalSales(context.Context, *SaleRequest) (*SaleResponse, error)
+	CreateSales(context.Context, *SaleRequest) (*SaleResponse, error)
+	UpdateSales(context.Context, *SaleUpdate) (*SaleResponse, error)
+	GetSales(context.Context, *SaleID) (*SaleResponse, error)
+	GetListSales(context.Context, *SaleFilter) (*SaleList, error)
+	DeleteSales(context.Context, *SaleID) (*Message, error)
+	// -------------------- Cash Flow ----------------------------
+	GetCashFlow(context.Context, *CashFlowReq) (*ListCashFlow, error)
+	CreateIncome(context.Context, *CashFlowRequest) (*CashFlow, error)
+	CreateExpense(context.Context, *CashFlowRequest) (*CashFlow, error)
+	GetTotalIncome(context.Context, *StatisticReq) (*PriceProducts, error)
+	GetTotalExpense(context.Context, *StatisticReq) (*PriceProducts, error)
+	GetNetProfit(context.Context, *StatisticReq) (*PriceProducts, error)
+	CreateTransfers(context.Context, *TransferReq) (*Transfer, error)
+	GetTransfers(context.Context, *TransferID) (*Transfer, error)
+	GetTransferList(context.Context, *TransferFilter) (*TransferList, error)
+	// ------------------- statistics --------------------------
+	TotalPriceOfProducts(context.Context, *StatisticReq) (*PriceProducts, error)
+	TotalSoldProducts(context.Context, *StatisticReq) (*PriceProducts, error)
+	TotalPurchaseProducts(context.Context, *StatisticReq) (*PriceProducts, error)
+	GetMostSoldProductsByDay(context.Context, *MostSoldProductsRequest) (*MostSoldProductsResponse, error)
+	GetTopClients(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error)
+	GetTopSuppliers(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error)
+	GetClientDashboard(context.Context, *GetClientDashboardRequest) (*GetClientDashboardResponse, error)
+	// ------------------------ Graph Statistics -----------------
+	GetSaleStatistics(context.Context, *SaleStatisticsReq) (*SaleStatistics, error)
+	GetBranchIncome(context.Context, *BranchIncomeReq) (*BranchIncomeRes, error)
+	GetProductDashboard(context.Context, *GetProductsDashboardReq) (*GetProductsDashboardRes, error)
+	// -------------------- Returns -----------------------------
+	CreateReturn(context.Context, *ReturnRequest) (*ReturnResponse, error)
+	GetReturn(context.Context, *ReturnID) (*ReturnResponse, error)
+	GetListReturns(context.Context, *ReturnFilter) (*ReturnList, error)
+	UpdateReturn(context.Context, *ReturnUpdate) (*ReturnResponse, error)
+	DeleteReturn(context.Context, *ReturnID) (*Message, error)
+	// -------------------- Stock Movements ---------------------
+	GetStockMovements(context.Context, *StockMovementFilter) (*StockMovementList, error)
+	// -------------------- Company Settings --------------------
+	GetCompanySettings(context.Context, *CompanySettingsReq) (*CompanySettings, error)
+	UpdateCompanySettings(context.Context, *CompanySettings) (*CompanySettings, error)
+	// -------------------- Low Stock ---------------------------
+	GetLowStockProducts(context.Context, *LowStockFilter) (*LowStockList, error)
+	// -------------------- Payment Types -----------------------
+	CreatePaymentType(context.Context, *PaymentType) (*PaymentType, error)
+	UpdatePaymentType(context.Context, *PaymentType) (*PaymentType, error)
+	GetPaymentType(context.Context, *PaymentTypeID) (*PaymentType, error)
+	GetListPaymentTypes(context.Context, *PaymentTypeFilter) (*PaymentTypeList, error)
+	DeletePaymentType(context.Context, *PaymentTypeID) (*Message, error)
+	// -------------------- Void --------------------------------
+	VoidSale(context.Context, *VoidSaleReq) (*SaleResponse, error)
+	VoidPurchase(context.Context, *VoidPurchaseReq) (*PurchaseResponse, error)
+	// -------------------- Sale Items Update -------------------
+	UpdateSaleItems(context.Context, *UpdateSaleItemsReq) (*SaleResponse, error)
+	// -------------------- Purchase Items Update ---------------
+	UpdatePurchaseItems(context.Context, *UpdatePurchaseItemsReq) (*PurchaseResponse, error)
+	// -------------------- Supplier Returns --------------------
+	CreateSupplierReturn(context.Context, *SupplierReturnRequest) (*SupplierReturnResponse, error)
+	GetSupplierReturn(context.Context, *SupplierReturnID) (*SupplierReturnResponse, error)
+	GetListSupplierReturns(context.Context, *SupplierReturnFilter) (*SupplierReturnList, error)
+	DeleteSupplierReturn(context.Context, *SupplierReturnID) (*Message, error)
+	// -------------------- Purchase Orders ---------------------
+	CreatePurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrder, error)
+	GetPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error)
+	GetListPurchaseOrders(context.Context, *PurchaseOrderFilter) (*PurchaseOrderList, error)
+	UpdatePurchaseOrder(context.Context, *PurchaseOrderUpdate) (*PurchaseOrder, error)
+	SendPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error)
+	CancelPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderReq) (*PurchaseResponse, error)
+	GetOutstandingPurchaseOrders(context.Context, *OutstandingOrdersReq) (*OutstandingOrdersList, error)
+	// -------------------- Transfer Workflow -------------------
+	DispatchTransfer(context.Context, *TransferStatusReq) (*Transfer, error)
+	MarkTransferInTransit(context.Context, *TransferStatusReq) (*Transfer, error)
+	ReceiveTransfer(context.Context, *ReceiveTransferReq) (*Transfer, error)
+	CancelTransfer(context.Context, *TransferStatusReq) (*Transfer, error)
+	// -------------------- Catalog -----------------------------
+	GetCatalogStock(context.Context, *CatalogStockReq) (*CatalogStockList, error)
+	// -------------------- Stocktakes --------------------------
+	StartStocktake(context.Context, *StocktakeRequest) (*Stocktake, error)
+	SubmitStocktakeCounts(context.Context, *SubmitStocktakeCountsReq) (*Stocktake, error)
+	GetStocktake(context.Context, *StocktakeID) (*Stocktake, error)
+	GetListStocktakes(context.Context, *StocktakeFilter) (*StocktakeList, error)
+	CloseStocktake(context.Context, *CloseStocktakeReq) (*Stocktake, error)
+	CancelStocktake(context.Context, *StocktakeID) (*Stocktake, error)
+	// -------------------- Stock Adjustments -------------------
+	AdjustStock(context.Context, *StockAdjustmentReq) (*StockAdjustment, error)
+	GetWriteOffReport(context.Context, *WriteOffReportReq) (*WriteOffReport, error)
+	// -------------------- Stock Thresholds --------------------
+	SetStockThresholds(context.Context, *StockThresholdsReq) (*Product, error)
+	// -------------------- Barcodes ----------------------------
+	GetProductByBarcode(context.Context, *GetProductByBarcodeReq) (*Product, error)
+	// -------------------- Labels ------------------------------
+	RenderLabels(context.Context, *RenderLabelsReq) (*Labels, error)
+	// -------------------- Variants ----------------------------
+	CreateVariant(context.Context, *CreateVariantReq) (*Product, error)
+	// -------------------- Lots --------------------------------
+	GetExpiringStock(context.Context, *ExpiringStockReq) (*ExpiringStockList, error)
+	// -------------------- Serial Numbers ----------------------
+	TraceSerial(context.Context, *TraceSerialReq) (*SerialTrace, error)
+	GetProductSerials(context.Context, *ProductSerialsReq) (*ProductSerialList, error)
+	VoidReturn(context.Context, *VoidReturnReq) (*ReturnResponse, error)
+	VoidSupplierReturn(context.Context, *VoidSupplierReturnReq) (*SupplierReturnResponse, error)
+	mustEmbedUnimplementedProductsServer()
+}
+
+// UnimplementedProductsServer must be embedded to have forward compatible implementations.
+type UnimplementedProductsServer struct {
+}
+
+func (UnimplementedProductsServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedProductsServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductsServer) DeleteCategory(context.Context, *GetCategoryRequest) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductsServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedProductsServer) GetListCategory(context.Context, *CategoryName) (*CategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListCategory not implemented")
+}
+func (UnimplementedProductsServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductsServer) CreateBulkProducts(context.Context, *CreateBulkProductsRequest) (*BulkCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBulkProducts not implemented")
+}
+func (UnimplementedProductsServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductsServer) DeleteProduct(context.Context, *GetProductRequest) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductsServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductsServer) GetProductList(context.Context, *ProductFilter) (*ProductList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductList not implemented")
+}
+func (UnimplementedProductsServer) CreatePurchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchase not implemented")
+}
+func (UnimplementedProductsServer) GetPurchase(context.Context, *PurchaseID) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchase not implemented")
+}
+func (UnimplementedProductsServer) GetListPurchase(context.Context, *FilterPurchase) (*PurchaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPurchase not implemented")
+}
+func (UnimplementedProductsServer) UpdatePurchase(context.Context, *PurchaseUpdate) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchase not implemented")
+}
+func (UnimplementedProductsServer) DeletePurchase(context.Context, *PurchaseID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePurchase not implemented")
+}
+func (UnimplementedProductsServer) CalculateTotalSales(context.Context, *SaleRequest) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTotalSales not implemented")
+}
+func (UnimplementedProductsServer) CreateSales(context.Context, *SaleRequest) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSales not implemented")
+}
+func (UnimplementedProductsServer) UpdateSales(context.Context, *SaleUpdate) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSales not implemented")
+}
+func (UnimplementedProductsServer) GetSales(context.Context, *SaleID) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSales not implemented")
+}
+func (UnimplementedProductsServer) GetListSales(context.Context, *SaleFilter) (*SaleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSales not implemented")
+}
+func (UnimplementedProductsServer) DeleteSales(context.Context, *SaleID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSales not implemented")
+}
+func (UnimplementedProductsServer) GetCashFlow(context.Context, *CashFlowReq) (*ListCashFlow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashFlow not implemented")
+}
+func (UnimplementedProductsServer) CreateIncome(context.Context, *CashFlowRequest) (*CashFlow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIncome not implemented")
+}
+func (UnimplementedProductsServer) CreateExpense(context.Context, *CashFlowRequest) (*CashFlow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateExpense not implemented")
 }
-func (UnimplementedProductsServer) GetTotalIncome(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalIncome not implemented")
+func (UnimplementedProductsServer) GetTotalIncome(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalIncome not implemented")
+}
+func (UnimplementedProductsServer) GetTotalExpense(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalExpense not implemented")
+}
+func (UnimplementedProductsServer) GetNetProfit(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetProfit not implemented")
+}
+func (UnimplementedProductsServer) CreateTransfers(context.Context, *TransferReq) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfers not implemented")
+}
+func (UnimplementedProductsServer) GetTransfers(context.Context, *TransferID) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfers not implemented")
+}
+func (UnimplementedProductsServer) GetTransferList(context.Context, *TransferFilter) (*TransferList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransferList not implemented")
+}
+func (UnimplementedProductsServer) TotalPriceOfProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPriceOfProducts not implemented")
+}
+func (UnimplementedProductsServer) TotalSoldProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSoldProducts not implemented")
+}
+func (UnimplementedProductsServer) TotalPurchaseProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPurchaseProducts not implemented")
+}
+func (UnimplementedProductsServer) GetMostSoldProductsByDay(context.Context, *MostSoldProductsRequest) (*MostSoldProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMostSoldProductsByDay not implemented")
+}
+func (UnimplementedProductsServer) GetTopClients(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopClients not implemented")
+}
+func (UnimplementedProductsServer) GetTopSuppliers(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopSuppliers not implemented")
+}
+func (UnimplementedProductsServer) GetClientDashboard(context.Context, *GetClientDashboardRequest) (*GetClientDashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientDashboard not implemented")
+}
+func (UnimplementedProductsServer) GetSaleStatistics(context.Context, *SaleStatisticsReq) (*SaleStatistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSaleStatistics not implemented")
+}
+func (UnimplementedProductsServer) GetBranchIncome(context.Context, *BranchIncomeReq) (*BranchIncomeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranchIncome not implemented")
+}
+func (UnimplementedProductsServer) GetProductDashboard(context.Context, *GetProductsDashboardReq) (*GetProductsDashboardRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductDashboard not implemented")
+}
+func (UnimplementedProductsServer) CreateReturn(context.Context, *ReturnRequest) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
+}
+func (UnimplementedProductsServer) GetReturn(context.Context, *ReturnID) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturn not implemented")
+}
+func (UnimplementedProductsServer) GetListReturns(context.Context, *ReturnFilter) (*ReturnList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListReturns not implemented")
+}
+func (UnimplementedProductsServer) UpdateReturn(context.Context, *ReturnUpdate) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReturn not implemented")
+}
+func (UnimplementedProductsServer) DeleteReturn(context.Context, *ReturnID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReturn not implemented")
+}
+func (UnimplementedProductsServer) GetStockMovements(context.Context, *StockMovementFilter) (*StockMovementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockMovements not implemented")
+}
+func (UnimplementedProductsServer) GetCompanySettings(context.Context, *CompanySettingsReq) (*CompanySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanySettings not implemented")
+}
+func (UnimplementedProductsServer) UpdateCompanySettings(context.Context, *CompanySettings) (*CompanySettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompanySettings not implemented")
+}
+func (UnimplementedProductsServer) GetLowStockProducts(context.Context, *LowStockFilter) (*LowStockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLowStockProducts not implemented")
+}
+func (UnimplementedProductsServer) CreatePaymentType(context.Context, *PaymentType) (*PaymentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentType not implemented")
+}
+func (UnimplementedProductsServer) UpdatePaymentType(context.Context, *PaymentType) (*PaymentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentType not implemented")
+}
+func (UnimplementedProductsServer) GetPaymentType(context.Context, *PaymentTypeID) (*PaymentType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentType not implemented")
+}
+func (UnimplementedProductsServer) GetListPaymentTypes(context.Context, *PaymentTypeFilter) (*PaymentTypeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPaymentTypes not implemented")
+}
+func (UnimplementedProductsServer) DeletePaymentType(context.Context, *PaymentTypeID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentType not implemented")
+}
+func (UnimplementedProductsServer) VoidSale(context.Context, *VoidSaleReq) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidSale not implemented")
+}
+func (UnimplementedProductsServer) VoidPurchase(context.Context, *VoidPurchaseReq) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidPurchase not implemented")
+}
+func (UnimplementedProductsServer) UpdateSaleItems(context.Context, *UpdateSaleItemsReq) (*SaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSaleItems not implemented")
+}
+func (UnimplementedProductsServer) UpdatePurchaseItems(context.Context, *UpdatePurchaseItemsReq) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchaseItems not implemented")
+}
+func (UnimplementedProductsServer) CreateSupplierReturn(context.Context, *SupplierReturnRequest) (*SupplierReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplierReturn not implemented")
+}
+func (UnimplementedProductsServer) GetSupplierReturn(context.Context, *SupplierReturnID) (*SupplierReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupplierReturn not implemented")
+}
+func (UnimplementedProductsServer) GetListSupplierReturns(context.Context, *SupplierReturnFilter) (*SupplierReturnList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListSupplierReturns not implemented")
+}
+func (UnimplementedProductsServer) DeleteSupplierReturn(context.Context, *SupplierReturnID) (*Message, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSupplierReturn not implemented")
+}
+func (UnimplementedProductsServer) CreatePurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) GetPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) GetListPurchaseOrders(context.Context, *PurchaseOrderFilter) (*PurchaseOrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListPurchaseOrders not implemented")
+}
+func (UnimplementedProductsServer) UpdatePurchaseOrder(context.Context, *PurchaseOrderUpdate) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) SendPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendPurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) CancelPurchaseOrder(context.Context, *PurchaseOrderID) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderReq) (*PurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedProductsServer) GetOutstandingPurchaseOrders(context.Context, *OutstandingOrdersReq) (*OutstandingOrdersList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutstandingPurchaseOrders not implemented")
+}
+func (UnimplementedProductsServer) DispatchTransfer(context.Context, *TransferStatusReq) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchTransfer not implemented")
+}
+func (UnimplementedProductsServer) MarkTransferInTransit(context.Context, *TransferStatusReq) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkTransferInTransit not implemented")
+}
+func (UnimplementedProductsServer) ReceiveTransfer(context.Context, *ReceiveTransferReq) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveTransfer not implemented")
+}
+func (UnimplementedProductsServer) CancelTransfer(context.Context, *TransferStatusReq) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedProductsServer) GetCatalogStock(context.Context, *CatalogStockReq) (*CatalogStockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogStock not implemented")
+}
+func (UnimplementedProductsServer) StartStocktake(context.Context, *StocktakeRequest) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStocktake not implemented")
+}
+func (UnimplementedProductsServer) SubmitStocktakeCounts(context.Context, *SubmitStocktakeCountsReq) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitStocktakeCounts not implemented")
+}
+func (UnimplementedProductsServer) GetStocktake(context.Context, *StocktakeID) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStocktake not implemented")
+}
+func (UnimplementedProductsServer) GetListStocktakes(context.Context, *StocktakeFilter) (*StocktakeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListStocktakes not implemented")
+}
+func (UnimplementedProductsServer) CloseStocktake(context.Context, *CloseStocktakeReq) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseStocktake not implemented")
+}
+func (UnimplementedProductsServer) CancelStocktake(context.Context, *StocktakeID) (*Stocktake, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStocktake not implemented")
+}
+func (UnimplementedProductsServer) AdjustStock(context.Context, *StockAdjustmentReq) (*StockAdjustment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedProductsServer) GetWriteOffReport(context.Context, *WriteOffReportReq) (*WriteOffReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWriteOffReport not implemented")
+}
+func (UnimplementedProductsServer) SetStockThresholds(context.Context, *StockThresholdsReq) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockThresholds not implemented")
+}
+func (UnimplementedProductsServer) GetProductByBarcode(context.Context, *GetProductByBarcodeReq) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByBarcode not implemented")
+}
+func (UnimplementedProductsServer) RenderLabels(context.Context, *RenderLabelsReq) (*Labels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderLabels not implemented")
+}
+func (UnimplementedProductsServer) CreateVariant(context.Context, *CreateVariantReq) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVariant not implemented")
+}
+func (UnimplementedProductsServer) GetExpiringStock(context.Context, *ExpiringStockReq) (*ExpiringStockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiringStock not implemented")
+}
+func (UnimplementedProductsServer) TraceSerial(context.Context, *TraceSerialReq) (*SerialTrace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceSerial not implemented")
+}
+func (UnimplementedProductsServer) GetProductSerials(context.Context, *ProductSerialsReq) (*ProductSerialList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductSerials not implemented")
+}
+func (UnimplementedProductsServer) VoidReturn(context.Context, *VoidReturnReq) (*ReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidReturn not implemented")
+}
+func (UnimplementedProductsServer) VoidSupplierReturn(context.Context, *VoidSupplierReturnReq) (*SupplierReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidSupplierReturn not implemented")
+}
+func (UnimplementedProductsServer) mustEmbedUnimplementedProductsServer() {}
+
+// UnsafeProductsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductsServer will
+// result in compilation errors.
+type UnsafeProductsServer interface {
+	mustEmbedUnimplementedProductsServer()
+}
+
+func RegisterProductsServer(s grpc.ServiceRegistrar, srv ProductsServer) {
+	s.RegisterService(&Products_ServiceDesc, srv)
+}
+
+func _Products_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeleteCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetListCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetListCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetListCategory(ctx, req.(*CategoryName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreateBulkProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBulkProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateBulkProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateBulkProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateBulkProducts(ctx, req.(*CreateBulkProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeleteProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetProductList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetProductList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetProductList(ctx, req.(*ProductFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreatePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreatePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreatePurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreatePurchase(ctx, req.(*PurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetPurchase(ctx, req.(*PurchaseID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetListPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterPurchase)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetListPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetListPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetListPurchase(ctx, req.(*FilterPurchase))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_UpdatePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdatePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdatePurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdatePurchase(ctx, req.(*PurchaseUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_DeletePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeletePurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_DeletePurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeletePurchase(ctx, req.(*PurchaseID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CalculateTotalSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CalculateTotalSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CalculateTotalSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CalculateTotalSales(ctx, req.(*SaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreateSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateSales(ctx, req.(*SaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_UpdateSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdateSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateSales(ctx, req.(*SaleUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetSales(ctx, req.(*SaleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetListSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetListSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetListSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetListSales(ctx, req.(*SaleFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_DeleteSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeleteSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_DeleteSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeleteSales(ctx, req.(*SaleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetCashFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetCashFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetCashFlow(ctx, req.(*CashFlowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreateIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateIncome(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_CreateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateExpense(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetTotalIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTotalIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTotalIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTotalIncome(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetTotalExpense(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalExpense not implemented")
+
+func _Products_GetTotalExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTotalExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTotalExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTotalExpense(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetNetProfit(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetProfit not implemented")
+
+func _Products_GetNetProfit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetNetProfit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetNetProfit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetNetProfit(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) CreateTransfers(context.Context, *TransferReq) (*Transfer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfers not implemented")
+
+func _Products_CreateTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateTransfers(ctx, req.(*TransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetTransfers(context.Context, *TransferID) (*Transfer, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransfers not implemented")
+
+func _Products_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTransfers(ctx, req.(*TransferID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTransferList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTransferList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTransferList(ctx, req.(*TransferFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_TotalPriceOfProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).TotalPriceOfProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_TotalPriceOfProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).TotalPriceOfProducts(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_TotalSoldProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).TotalSoldProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_TotalSoldProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).TotalSoldProducts(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_TotalPurchaseProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).TotalPurchaseProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_TotalPurchaseProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).TotalPurchaseProducts(ctx, req.(*StatisticReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetMostSoldProductsByDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MostSoldProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetMostSoldProductsByDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetMostSoldProductsByDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetMostSoldProductsByDay(ctx, req.(*MostSoldProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetTopClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTopClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTopClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTopClients(ctx, req.(*GetTopEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetTopSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetTopSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetTopSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetTopSuppliers(ctx, req.(*GetTopEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetClientDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetClientDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetClientDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetClientDashboard(ctx, req.(*GetClientDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Products_GetSaleStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaleStatisticsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetSaleStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetSaleStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetSaleStatistics(ctx, req.(*SaleStatisticsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetTransferList(context.Context, *TransferFilter) (*TransferList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransferList not implemented")
+
+func _Products_GetBranchIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchIncomeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetBranchIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetBranchIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetBranchIncome(ctx, req.(*BranchIncomeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) TotalPriceOfProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPriceOfProducts not implemented")
+
+func _Products_GetProductDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsDashboardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetProductDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetProductDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetProductDashboard(ctx, req.(*GetProductsDashboardReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) TotalSoldProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalSoldProducts not implemented")
+
+func _Products_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreateReturn(ctx, req.(*ReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) TotalPurchaseProducts(context.Context, *StatisticReq) (*PriceProducts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalPurchaseProducts not implemented")
+
+func _Products_GetReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetReturn(ctx, req.(*ReturnID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetMostSoldProductsByDay(context.Context, *MostSoldProductsRequest) (*MostSoldProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMostSoldProductsByDay not implemented")
+
+func _Products_GetListReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetListReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetListReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetListReturns(ctx, req.(*ReturnFilter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetTopClients(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopClients not implemented")
+
+func _Products_UpdateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateReturn(ctx, req.(*ReturnUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetTopSuppliers(context.Context, *GetTopEntitiesRequest) (*GetTopEntitiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopSuppliers not implemented")
+
+func _Products_DeleteReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).DeleteReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_DeleteReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).DeleteReturn(ctx, req.(*ReturnID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetClientDashboard(context.Context, *GetClientDashboardRequest) (*GetClientDashboardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClientDashboard not implemented")
+
+func _Products_GetStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockMovementFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetStockMovements(ctx, req.(*StockMovementFilter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetSaleStatistics(context.Context, *SaleStatisticsReq) (*SaleStatistics, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSaleStatistics not implemented")
+
+func _Products_GetCompanySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetCompanySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetCompanySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetCompanySettings(ctx, req.(*CompanySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetBranchIncome(context.Context, *BranchIncomeReq) (*BranchIncomeRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBranchIncome not implemented")
+
+func _Products_UpdateCompanySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanySettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdateCompanySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdateCompanySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdateCompanySettings(ctx, req.(*CompanySettings))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) GetProductDashboard(context.Context, *GetProductsDashboardReq) (*GetProductsDashboardRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductDashboard not implemented")
+
+func _Products_GetLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).GetLowStockProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_GetLowStockProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).GetLowStockProducts(ctx, req.(*LowStockFilter))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductsServer) mustEmbedUnimplementedProductsServer() {}
 
-// UnsafeProductsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductsServer will
-// result in compilation errors.
-type UnsafeProductsServer interface {
-	mustEmbedUnimplementedProductsServer()
+func _Products_CreatePaymentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).CreatePaymentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_CreatePaymentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).CreatePaymentType(ctx, req.(*PaymentType))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductsServer(s grpc.ServiceRegistrar, srv ProductsServer) {
-	s.RegisterService(&Products_ServiceDesc, srv)
+func _Products_UpdatePaymentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductsServer).UpdatePaymentType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Products_UpdatePaymentType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductsServer).UpdatePaymentType(ctx, req.(*PaymentType))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _Products_GetPaymentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentTypeID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateCategory(ctx, in)
+		return srv.(ProductsServer).GetPaymentType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateCategory_FullMethodName,
+		FullMethod: Products_GetPaymentType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(ProductsServer).GetPaymentType(ctx, req.(*PaymentTypeID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _Products_GetListPaymentTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentTypeFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).UpdateCategory(ctx, in)
+		return srv.(ProductsServer).GetListPaymentTypes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_UpdateCategory_FullMethodName,
+		FullMethod: Products_GetListPaymentTypes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(ProductsServer).GetListPaymentTypes(ctx, req.(*PaymentTypeFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _Products_DeletePaymentType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentTypeID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).DeleteCategory(ctx, in)
+		return srv.(ProductsServer).DeletePaymentType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_DeleteCategory_FullMethodName,
+		FullMethod: Products_DeletePaymentType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).DeleteCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(ProductsServer).DeletePaymentType(ctx, req.(*PaymentTypeID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _Products_VoidSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidSaleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetCategory(ctx, in)
+		return srv.(ProductsServer).VoidSale(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetCategory_FullMethodName,
+		FullMethod: Products_VoidSale_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(ProductsServer).VoidSale(ctx, req.(*VoidSaleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CategoryName)
+func _Products_VoidPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPurchaseReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetListCategory(ctx, in)
+		return srv.(ProductsServer).VoidPurchase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetListCategory_FullMethodName,
+		FullMethod: Products_VoidPurchase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetListCategory(ctx, req.(*CategoryName))
+		return srv.(ProductsServer).VoidPurchase(ctx, req.(*VoidPurchaseReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _Products_UpdateSaleItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSaleItemsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateProduct(ctx, in)
+		return srv.(ProductsServer).UpdateSaleItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateProduct_FullMethodName,
+		FullMethod: Products_UpdateSaleItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductsServer).UpdateSaleItems(ctx, req.(*UpdateSaleItemsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateBulkProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBulkProductsRequest)
+func _Products_UpdatePurchaseItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePurchaseItemsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateBulkProducts(ctx, in)
+		return srv.(ProductsServer).UpdatePurchaseItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateBulkProducts_FullMethodName,
+		FullMethod: Products_UpdatePurchaseItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateBulkProducts(ctx, req.(*CreateBulkProductsRequest))
+		return srv.(ProductsServer).UpdatePurchaseItems(ctx, req.(*UpdatePurchaseItemsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _Products_CreateSupplierReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierReturnRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).UpdateProduct(ctx, in)
+		return srv.(ProductsServer).CreateSupplierReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_UpdateProduct_FullMethodName,
+		FullMethod: Products_CreateSupplierReturn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductsServer).CreateSupplierReturn(ctx, req.(*SupplierReturnRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _Products_GetSupplierReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierReturnID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).DeleteProduct(ctx, in)
+		return srv.(ProductsServer).GetSupplierReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_DeleteProduct_FullMethodName,
+		FullMethod: Products_GetSupplierReturn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).DeleteProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductsServer).GetSupplierReturn(ctx, req.(*SupplierReturnID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _Products_GetListSupplierReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierReturnFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetProduct(ctx, in)
+		return srv.(ProductsServer).GetListSupplierReturns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetProduct_FullMethodName,
+		FullMethod: Products_GetListSupplierReturns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductsServer).GetListSupplierReturns(ctx, req.(*SupplierReturnFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetProductList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProductFilter)
+func _Products_DeleteSupplierReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierReturnID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetProductList(ctx, in)
+		return srv.(ProductsServer).DeleteSupplierReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetProductList_FullMethodName,
+		FullMethod: Products_DeleteSupplierReturn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetProductList(ctx, req.(*ProductFilter))
+		return srv.(ProductsServer).DeleteSupplierReturn(ctx, req.(*SupplierReturnID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreatePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseRequest)
+func _Products_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreatePurchase(ctx, in)
+		return srv.(ProductsServer).CreatePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreatePurchase_FullMethodName,
+		FullMethod: Products_CreatePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreatePurchase(ctx, req.(*PurchaseRequest))
+		return srv.(ProductsServer).CreatePurchaseOrder(ctx, req.(*PurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseID)
+func _Products_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetPurchase(ctx, in)
+		return srv.(ProductsServer).GetPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetPurchase_FullMethodName,
+		FullMethod: Products_GetPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetPurchase(ctx, req.(*PurchaseID))
+		return srv.(ProductsServer).GetPurchaseOrder(ctx, req.(*PurchaseOrderID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetListPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilterPurchase)
+func _Products_GetListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetListPurchase(ctx, in)
+		return srv.(ProductsServer).GetListPurchaseOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetListPurchase_FullMethodName,
+		FullMethod: Products_GetListPurchaseOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetListPurchase(ctx, req.(*FilterPurchase))
+		return srv.(ProductsServer).GetListPurchaseOrders(ctx, req.(*PurchaseOrderFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_UpdatePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseUpdate)
+func _Products_UpdatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderUpdate)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).UpdatePurchase(ctx, in)
+		return srv.(ProductsServer).UpdatePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_UpdatePurchase_FullMethodName,
+		FullMethod: Products_UpdatePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).UpdatePurchase(ctx, req.(*PurchaseUpdate))
+		return srv.(ProductsServer).UpdatePurchaseOrder(ctx, req.(*PurchaseOrderUpdate))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_DeletePurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseID)
+func _Products_SendPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).DeletePurchase(ctx, in)
+		return srv.(ProductsServer).SendPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_DeletePurchase_FullMethodName,
+		FullMethod: Products_SendPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).DeletePurchase(ctx, req.(*PurchaseID))
+		return srv.(ProductsServer).SendPurchaseOrder(ctx, req.(*PurchaseOrderID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CalculateTotalSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleRequest)
+func _Products_CancelPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CalculateTotalSales(ctx, in)
+		return srv.(ProductsServer).CancelPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CalculateTotalSales_FullMethodName,
+		FullMethod: Products_CancelPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CalculateTotalSales(ctx, req.(*SaleRequest))
+		return srv.(ProductsServer).CancelPurchaseOrder(ctx, req.(*PurchaseOrderID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleRequest)
+func _Products_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateSales(ctx, in)
+		return srv.(ProductsServer).ReceivePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateSales_FullMethodName,
+		FullMethod: Products_ReceivePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateSales(ctx, req.(*SaleRequest))
+		return srv.(ProductsServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_UpdateSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleUpdate)
+func _Products_GetOutstandingPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutstandingOrdersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).UpdateSales(ctx, in)
+		return srv.(ProductsServer).GetOutstandingPurchaseOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_UpdateSales_FullMethodName,
+		FullMethod: Products_GetOutstandingPurchaseOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).UpdateSales(ctx, req.(*SaleUpdate))
+		return srv.(ProductsServer).GetOutstandingPurchaseOrders(ctx, req.(*OutstandingOrdersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleID)
+func _Products_DispatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetSales(ctx, in)
+		return srv.(ProductsServer).DispatchTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetSales_FullMethodName,
+		FullMethod: Products_DispatchTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetSales(ctx, req.(*SaleID))
+		return srv.(ProductsServer).DispatchTransfer(ctx, req.(*TransferStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetListSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleFilter)
+func _Products_MarkTransferInTransit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetListSales(ctx, in)
+		return srv.(ProductsServer).MarkTransferInTransit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetListSales_FullMethodName,
+		FullMethod: Products_MarkTransferInTransit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetListSales(ctx, req.(*SaleFilter))
+		return srv.(ProductsServer).MarkTransferInTransit(ctx, req.(*TransferStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_DeleteSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleID)
+func _Products_ReceiveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveTransferReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).DeleteSales(ctx, in)
+		return srv.(ProductsServer).ReceiveTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_DeleteSales_FullMethodName,
+		FullMethod: Products_ReceiveTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).DeleteSales(ctx, req.(*SaleID))
+		return srv.(ProductsServer).ReceiveTransfer(ctx, req.(*ReceiveTransferReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetCashFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CashFlowReq)
+func _Products_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetCashFlow(ctx, in)
+		return srv.(ProductsServer).CancelTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetCashFlow_FullMethodName,
+		FullMethod: Products_CancelTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetCashFlow(ctx, req.(*CashFlowReq))
+		return srv.(ProductsServer).CancelTransfer(ctx, req.(*TransferStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CashFlowRequest)
+func _Products_GetCatalogStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogStockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateIncome(ctx, in)
+		return srv.(ProductsServer).GetCatalogStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateIncome_FullMethodName,
+		FullMethod: Products_GetCatalogStock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateIncome(ctx, req.(*CashFlowRequest))
+		return srv.(ProductsServer).GetCatalogStock(ctx, req.(*CatalogStockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CashFlowRequest)
+func _Products_StartStocktake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateExpense(ctx, in)
+		return srv.(ProductsServer).StartStocktake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateExpense_FullMethodName,
+		FullMethod: Products_StartStocktake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateExpense(ctx, req.(*CashFlowRequest))
+		return srv.(ProductsServer).StartStocktake(ctx, req.(*StocktakeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTotalIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_SubmitStocktakeCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitStocktakeCountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTotalIncome(ctx, in)
+		return srv.(ProductsServer).SubmitStocktakeCounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTotalIncome_FullMethodName,
+		FullMethod: Products_SubmitStocktakeCounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTotalIncome(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).SubmitStocktakeCounts(ctx, req.(*SubmitStocktakeCountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTotalExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_GetStocktake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTotalExpense(ctx, in)
+		return srv.(ProductsServer).GetStocktake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTotalExpense_FullMethodName,
+		FullMethod: Products_GetStocktake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTotalExpense(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).GetStocktake(ctx, req.(*StocktakeID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetNetProfit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_GetListStocktakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetNetProfit(ctx, in)
+		return srv.(ProductsServer).GetListStocktakes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetNetProfit_FullMethodName,
+		FullMethod: Products_GetListStocktakes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetNetProfit(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).GetListStocktakes(ctx, req.(*StocktakeFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_CreateTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferReq)
+func _Products_CloseStocktake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseStocktakeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).CreateTransfers(ctx, in)
+		return srv.(ProductsServer).CloseStocktake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_CreateTransfers_FullMethodName,
+		FullMethod: Products_CloseStocktake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).CreateTransfers(ctx, req.(*TransferReq))
+		return srv.(ProductsServer).CloseStocktake(ctx, req.(*CloseStocktakeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferID)
+func _Products_CancelStocktake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StocktakeID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTransfers(ctx, in)
+		return srv.(ProductsServer).CancelStocktake(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTransfers_FullMethodName,
+		FullMethod: Products_CancelStocktake_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTransfers(ctx, req.(*TransferID))
+		return srv.(ProductsServer).CancelStocktake(ctx, req.(*StocktakeID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTransferList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferFilter)
+func _Products_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockAdjustmentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTransferList(ctx, in)
+		return srv.(ProductsServer).AdjustStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTransferList_FullMethodName,
+		FullMethod: Products_AdjustStock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTransferList(ctx, req.(*TransferFilter))
+		return srv.(ProductsServer).AdjustStock(ctx, req.(*StockAdjustmentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_TotalPriceOfProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_GetWriteOffReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffReportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).TotalPriceOfProducts(ctx, in)
+		return srv.(ProductsServer).GetWriteOffReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_TotalPriceOfProducts_FullMethodName,
+		FullMethod: Products_GetWriteOffReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).TotalPriceOfProducts(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).GetWriteOffReport(ctx, req.(*WriteOffReportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_TotalSoldProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_SetStockThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockThresholdsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).TotalSoldProducts(ctx, in)
+		return srv.(ProductsServer).SetStockThresholds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_TotalSoldProducts_FullMethodName,
+		FullMethod: Products_SetStockThresholds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).TotalSoldProducts(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).SetStockThresholds(ctx, req.(*StockThresholdsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_TotalPurchaseProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatisticReq)
+func _Products_GetProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByBarcodeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).TotalPurchaseProducts(ctx, in)
+		return srv.(ProductsServer).GetProductByBarcode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_TotalPurchaseProducts_FullMethodName,
+		FullMethod: Products_GetProductByBarcode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).TotalPurchaseProducts(ctx, req.(*StatisticReq))
+		return srv.(ProductsServer).GetProductByBarcode(ctx, req.(*GetProductByBarcodeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetMostSoldProductsByDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MostSoldProductsRequest)
+func _Products_RenderLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderLabelsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetMostSoldProductsByDay(ctx, in)
+		return srv.(ProductsServer).RenderLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetMostSoldProductsByDay_FullMethodName,
+		FullMethod: Products_RenderLabels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetMostSoldProductsByDay(ctx, req.(*MostSoldProductsRequest))
+		return srv.(ProductsServer).RenderLabels(ctx, req.(*RenderLabelsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTopClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopEntitiesRequest)
+func _Products_CreateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariantReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTopClients(ctx, in)
+		return srv.(ProductsServer).CreateVariant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTopClients_FullMethodName,
+		FullMethod: Products_CreateVariant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTopClients(ctx, req.(*GetTopEntitiesRequest))
+		return srv.(ProductsServer).CreateVariant(ctx, req.(*CreateVariantReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetTopSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopEntitiesRequest)
+func _Products_GetExpiringStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringStockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetTopSuppliers(ctx, in)
+		return srv.(ProductsServer).GetExpiringStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetTopSuppliers_FullMethodName,
+		FullMethod: Products_GetExpiringStock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetTopSuppliers(ctx, req.(*GetTopEntitiesRequest))
+		return srv.(ProductsServer).GetExpiringStock(ctx, req.(*ExpiringStockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetClientDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClientDashboardRequest)
+func _Products_TraceSerial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceSerialReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetClientDashboard(ctx, in)
+		return srv.(ProductsServer).TraceSerial(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetClientDashboard_FullMethodName,
+		FullMethod: Products_TraceSerial_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetClientDashboard(ctx, req.(*GetClientDashboardRequest))
+		return srv.(ProductsServer).TraceSerial(ctx, req.(*TraceSerialReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetSaleStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaleStatisticsReq)
+func _Products_GetProductSerials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductSerialsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetSaleStatistics(ctx, in)
+		return srv.(ProductsServer).GetProductSerials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetSaleStatistics_FullMethodName,
+		FullMethod: Products_GetProductSerials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetSaleStatistics(ctx, req.(*SaleStatisticsReq))
+		return srv.(ProductsServer).GetProductSerials(ctx, req.(*ProductSerialsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetBranchIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BranchIncomeReq)
+func _Products_VoidReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidReturnReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetBranchIncome(ctx, in)
+		return srv.(ProductsServer).VoidReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetBranchIncome_FullMethodName,
+		FullMethod: Products_VoidReturn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetBranchIncome(ctx, req.(*BranchIncomeReq))
+		return srv.(ProductsServer).VoidReturn(ctx, req.(*VoidReturnReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Products_GetProductDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductsDashboardReq)
+func _Products_VoidSupplierReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidSupplierReturnReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductsServer).GetProductDashboard(ctx, in)
+		return srv.(ProductsServer).VoidSupplierReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Products_GetProductDashboard_FullMethodName,
+		FullMethod: Products_VoidSupplierReturn_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductsServer).GetProductDashboard(ctx, req.(*GetProductsDashboardReq))
+		return srv.(ProductsServer).VoidSupplierReturn(ctx, req.(*VoidSupplierReturnReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1642,6 +3450,214 @@ var Products_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductDashboard",
 			Handler:    _Products_GetProductDashboard_Handler,
 		},
+		{
+			MethodName: "CreateReturn",
+			Handler:    _Products_CreateReturn_Handler,
+		},
+		{
+			MethodName: "GetReturn",
+			Handler:    _Products_GetReturn_Handler,
+		},
+		{
+			MethodName: "GetListReturns",
+			Handler:    _Products_GetListReturns_Handler,
+		},
+		{
+			MethodName: "UpdateReturn",
+			Handler:    _Products_UpdateReturn_Handler,
+		},
+		{
+			MethodName: "DeleteReturn",
+			Handler:    _Products_DeleteReturn_Handler,
+		},
+		{
+			MethodName: "GetStockMovements",
+			Handler:    _Products_GetStockMovements_Handler,
+		},
+		{
+			MethodName: "GetCompanySettings",
+			Handler:    _Products_GetCompanySettings_Handler,
+		},
+		{
+			MethodName: "UpdateCompanySettings",
+			Handler:    _Products_UpdateCompanySettings_Handler,
+		},
+		{
+			MethodName: "GetLowStockProducts",
+			Handler:    _Products_GetLowStockProducts_Handler,
+		},
+		{
+			MethodName: "CreatePaymentType",
+			Handler:    _Products_CreatePaymentType_Handler,
+		},
+		{
+			MethodName: "UpdatePaymentType",
+			Handler:    _Products_UpdatePaymentType_Handler,
+		},
+		{
+			MethodName: "GetPaymentType",
+			Handler:    _Products_GetPaymentType_Handler,
+		},
+		{
+			MethodName: "GetListPaymentTypes",
+			Handler:    _Products_GetListPaymentTypes_Handler,
+		},
+		{
+			MethodName: "DeletePaymentType",
+			Handler:    _Products_DeletePaymentType_Handler,
+		},
+		{
+			MethodName: "VoidSale",
+			Handler:    _Products_VoidSale_Handler,
+		},
+		{
+			MethodName: "VoidPurchase",
+			Handler:    _Products_VoidPurchase_Handler,
+		},
+		{
+			MethodName: "UpdateSaleItems",
+			Handler:    _Products_UpdateSaleItems_Handler,
+		},
+		{
+			MethodName: "UpdatePurchaseItems",
+			Handler:    _Products_UpdatePurchaseItems_Handler,
+		},
+		{
+			MethodName: "CreateSupplierReturn",
+			Handler:    _Products_CreateSupplierReturn_Handler,
+		},
+		{
+			MethodName: "GetSupplierReturn",
+			Handler:    _Products_GetSupplierReturn_Handler,
+		},
+		{
+			MethodName: "GetListSupplierReturns",
+			Handler:    _Products_GetListSupplierReturns_Handler,
+		},
+		{
+			MethodName: "DeleteSupplierReturn",
+			Handler:    _Products_DeleteSupplierReturn_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _Products_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _Products_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetListPurchaseOrders",
+			Handler:    _Products_GetListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "UpdatePurchaseOrder",
+			Handler:    _Products_UpdatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "SendPurchaseOrder",
+			Handler:    _Products_SendPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "CancelPurchaseOrder",
+			Handler:    _Products_CancelPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _Products_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetOutstandingPurchaseOrders",
+			Handler:    _Products_GetOutstandingPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "DispatchTransfer",
+			Handler:    _Products_DispatchTransfer_Handler,
+		},
+		{
+			MethodName: "MarkTransferInTransit",
+			Handler:    _Products_MarkTransferInTransit_Handler,
+		},
+		{
+			MethodName: "ReceiveTransfer",
+			Handler:    _Products_ReceiveTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _Products_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "GetCatalogStock",
+			Handler:    _Products_GetCatalogStock_Handler,
+		},
+		{
+			MethodName: "StartStocktake",
+			Handler:    _Products_StartStocktake_Handler,
+		},
+		{
+			MethodName: "SubmitStocktakeCounts",
+			Handler:    _Products_SubmitStocktakeCounts_Handler,
+		},
+		{
+			MethodName: "GetStocktake",
+			Handler:    _Products_GetStocktake_Handler,
+		},
+		{
+			MethodName: "GetListStocktakes",
+			Handler:    _Products_GetListStocktakes_Handler,
+		},
+		{
+			MethodName: "CloseStocktake",
+			Handler:    _Products_CloseStocktake_Handler,
+		},
+		{
+			MethodName: "CancelStocktake",
+			Handler:    _Products_CancelStocktake_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _Products_AdjustStock_Handler,
+		},
+		{
+			MethodName: "GetWriteOffReport",
+			Handler:    _Products_GetWriteOffReport_Handler,
+		},
+		{
+			MethodName: "SetStockThresholds",
+			Handler:    _Products_SetStockThresholds_Handler,
+		},
+		{
+			MethodName: "GetProductByBarcode",
+			Handler:    _Products_GetProductByBarcode_Handler,
+		},
+		{
+			MethodName: "RenderLabels",
+			Handler:    _Products_RenderLabels_Handler,
+		},
+		{
+			MethodName: "CreateVariant",
+			Handler:    _Products_CreateVariant_Handler,
+		},
+		{
+			MethodName: "GetExpiringStock",
+			Handler:    _Products_GetExpiringStock_Handler,
+		},
+		{
+			MethodName: "TraceSerial",
+			Handler:    _Products_TraceSerial_Handler,
+		},
+		{
+			MethodName: "GetProductSerials",
+			Handler:    _Products_GetProductSerials_Handler,
+		},
+		{
+			MethodName: "VoidReturn",
+			Handler:    _Products_VoidReturn_Handler,
+		},
+		{
+			MethodName: "VoidSupplierReturn",
+			Handler:    _Products_VoidSupplierReturn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "products/products.proto",
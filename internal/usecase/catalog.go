@@ -0,0 +1,42 @@
+package usecase
+
+import "fmt"
+
+// catalogRef is a document line that can name its product by the company catalog item
+// instead of the product row of the branch.
+type catalogRef struct {
+	catalogID string
+	productID *string
+}
+
+// resolveCatalogProducts fills in the product row of the branch for every line that names only its catalog item.
+// A line with a product_id keeps it; a catalog item the branch does not stock is an error.
+func resolveCatalogProducts(products ProductQuantity, companyID, branchID string, refs []catalogRef) error {
+	var catalogIDs []string
+	for _, ref := range refs {
+		if *ref.productID == "" && ref.catalogID != "" {
+			catalogIDs = append(catalogIDs, ref.catalogID)
+		}
+	}
+	if len(catalogIDs) == 0 {
+		return nil
+	}
+
+	rows, err := products.GetBranchProducts(companyID, branchID, catalogIDs)
+	if err != nil {
+		return fmt.Errorf("error resolving catalog items: %w", err)
+	}
+
+	for _, ref := range refs {
+		if *ref.productID != "" || ref.catalogID == "" {
+			continue
+		}
+		productID, ok := rows[ref.catalogID]
+		if !ok {
+			return fmt.Errorf("catalog item %v is not stocked in branch %v", ref.catalogID, branchID)
+		}
+		*ref.productID = productID
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import "testing"
+
+type branchProductsStub struct {
+	ProductQuantity
+	rows map[string]string
+}
+
+func (s branchProductsStub) GetBranchProducts(companyID, branchID string, catalogIDs []string) (map[string]string, error) {
+	res := make(map[string]string)
+	for _, id := range catalogIDs {
+		if productID, ok := s.rows[id]; ok {
+			res[id] = productID
+		}
+	}
+	return res, nil
+}
+
+func TestResolveCatalogProducts(t *testing.T) {
+	products := branchProductsStub{rows: map[string]string{"phone": "phone-branch-1"}}
+
+	tests := []struct {
+		name      string
+		catalogID string
+		productID string
+		want      string
+		wantErr   bool
+	}{
+		{name: "catalog item resolved to the branch row", catalogID: "phone", want: "phone-branch-1"},
+		{name: "product id wins", catalogID: "phone", productID: "phone-other", want: "phone-other"},
+		{name: "product id only", productID: "case", want: "case"},
+		{name: "catalog item not stocked in the branch", catalogID: "tablet", wantErr: true},
+		{name: "neither given", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productID := tt.productID
+			err := resolveCatalogProducts(products, "company", "branch-1", []catalogRef{{catalogID: tt.catalogID, productID: &productID}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveCatalogProducts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && productID != tt.want {
+				t.Errorf("resolveCatalogProducts() product = %q, want %q", productID, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package usecase
 
 import (
+	"context"
 	"crm-admin/internal/entity"
+	"crm-admin/internal/generated/company"
+	"crm-admin/internal/generated/debts"
 	pb "crm-admin/internal/generated/products"
+	"google.golang.org/grpc"
 )
 
 type ProductsRepo interface {
@@ -18,18 +22,35 @@ type ProductsRepo interface {
 	DeleteProduct(in *pb.GetProductRequest) (*pb.Message, error)
 	GetProduct(in *pb.GetProductRequest) (*pb.Product, error)
 	GetProductList(in *pb.ProductFilter) (*pb.ProductList, error)
+	GetCatalogStock(in *pb.CatalogStockReq) (*pb.CatalogStockList, error)
+	SetStockThresholds(in *pb.StockThresholdsReq) (*pb.Product, error)
+	GetProductByBarcode(in *pb.GetProductByBarcodeReq) (*pb.Product, error)
+	CreateVariant(in *pb.CreateVariantReq) (*pb.Product, error)
 
 	GetProductDashboard(in *pb.GetProductsDashboardReq) (*entity.ProductsDashboardDbRes, error)
 }
 
 type ProductQuantity interface {
 	AddProduct(in *entity.CountProductReq) (*entity.ProductNumber, error)
-	RemoveProducts(soldProducts []entity.SalesItem) error
+	RemoveProducts(soldProducts []entity.SalesItem, info entity.MovementInfo) error
 	GetProductCount(in *entity.ProductID) (*entity.ProductNumber, error)
 	ProductCountChecker(in *entity.CountProductReq) (bool, error)
 
-	TransferProducts(in *pb.TransferReq) error
-	RemoveProductsPurchase(soldProducts []*pb.PurchaseItemResponse) error
+	TransferOut(in *pb.TransferReq, transferID string) error
+	TransferIn(in *pb.TransferReq, transferID string) error
+	RemoveProductsPurchase(soldProducts []*pb.PurchaseItemResponse, info entity.MovementInfo) error
+	AdjustStock(adjustments []entity.StockAdjustment, info entity.MovementInfo) error
+
+	GetStockMovements(in *pb.StockMovementFilter) (*pb.StockMovementList, error)
+	GetMovementCosts(sourceID string) (map[string]float64, error)
+	RecostPurchase(costs map[string]float64, info entity.MovementInfo) error
+
+	CheckStock(items []entity.SalesItem) ([]entity.StockShortage, error)
+	GetUnitFactors(productIDs []string) (map[string]map[string]int64, error)
+	GetBranchProducts(companyID, branchID string, catalogIDs []string) (map[string]string, error)
+	RecordShortages(lines []entity.StockShortage, info entity.MovementInfo) error
+	GetLowStockProducts(in *pb.LowStockFilter) (*pb.LowStockList, error)
+	GetExpiringStock(in *pb.ExpiringStockReq) (*pb.ExpiringStockList, error)
 }
 
 type PurchasesRepo interface {
@@ -37,11 +58,18 @@ type PurchasesRepo interface {
 	UpdatePurchase(in *pb.PurchaseUpdate) (*pb.PurchaseResponse, error)
 	GetPurchase(in *pb.PurchaseID) (*pb.PurchaseResponse, error)
 	GetPurchaseList(in *pb.FilterPurchase) (*pb.PurchaseList, error)
-	DeletePurchase(in *pb.PurchaseID) (*pb.Message, error)
+	VoidPurchase(in *pb.VoidPurchaseReq) error
+	LockPurchase(purchaseID string) error
+	AddPurchasePayments(purchaseID string, payments []entity.Payment, companyID, branchID string) error
+	ReplacePurchaseItems(purchaseID string, in *entity.PurchaseRequest) error
+	ReducePurchasePayments(purchaseID string, amount float64) error
 
 	CreateTransfers(in *pb.TransferReq) (*pb.Transfer, error)
 	GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 	GetTransferList(in *pb.TransferFilter) (*pb.TransferList, error)
+	SetTransferStatus(in *pb.TransferStatusReq, status string, from ...string) error
+	ReceiveTransferItems(in *pb.ReceiveTransferReq, items []entity.ReceivedTransferItem) (string, error)
+	SetTransferShortfall(transferID, adjustmentID string) error
 }
 
 type SalesRepo interface {
@@ -49,7 +77,12 @@ type SalesRepo interface {
 	UpdateSale(in *pb.SaleUpdate) (*pb.SaleResponse, error)
 	GetSale(in *pb.SaleID) (*pb.SaleResponse, error)
 	GetSaleList(filter *pb.SaleFilter) (*pb.SaleList, error)
-	DeleteSale(in *pb.SaleID) (*pb.Message, error)
+	VoidSale(in *pb.VoidSaleReq) error
+	LockSale(saleID string) error
+	AddSalePayments(saleID string, payments []entity.Payment, companyID, branchID string) error
+	ReplaceSaleItems(saleID string, in *entity.SalesTotal) error
+	SetSaleItemCosts(saleID string) error
+	ReduceSalePayments(saleID string, amount float64) error
 
 	GetSalesByDay(request *pb.MostSoldProductsRequest) ([]*pb.DailySales, error)
 	GetTopClients(req *pb.GetTopEntitiesRequest) ([]*pb.TopEntity, error)
@@ -74,12 +107,136 @@ type CashFlowRepo interface {
 	GetNetProfit(req *pb.StatisticReq) (*pb.PriceProducts, error)
 
 	GetBranchIncome(in *pb.BranchIncomeReq) (*pb.BranchIncomeRes, error)
+
+	ReverseBySource(sourceType, sourceID, userID, description string) ([]*pb.CashFlow, error)
 }
 
 type ReturnedProductsRepo interface {
-	CreateReturnedProducts() error
-	UpdateReturnedProducts() error
-	GetReturnedProducts() error
-	GetReturnedProductsList() error
-	DeleteReturnedProducts() error
+	CreateReturnedProducts(in *entity.ReturnRequest) (*pb.ReturnResponse, error)
+	UpdateReturnedProducts(in *pb.ReturnUpdate) (*pb.ReturnResponse, error)
+	GetReturnedProducts(in *pb.ReturnID) (*pb.ReturnResponse, error)
+	GetReturnedProductsList(in *pb.ReturnFilter) (*pb.ReturnList, error)
+	VoidReturn(in *pb.VoidReturnReq) error
+
+	HasReturns(saleID string) (bool, error)
+	GetReturnedQuantities(saleID string) (map[string]int64, error)
+	GetRefundedAmount(saleID string) (float64, error)
+	SetReturnCashFlow(returnID, cashFlowID string) error
+}
+
+type PurchaseOrdersRepo interface {
+	CreatePurchaseOrder(in *entity.PurchaseOrderRequest) (*pb.PurchaseOrder, error)
+	GetPurchaseOrder(in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error)
+	GetPurchaseOrderList(in *pb.PurchaseOrderFilter) (*pb.PurchaseOrderList, error)
+	UpdatePurchaseOrder(id string, in *entity.PurchaseOrderRequest) (*pb.PurchaseOrder, error)
+	SetPurchaseOrderStatus(in *pb.PurchaseOrderID, status string, from ...string) error
+
+	ReceivePurchaseOrderItems(in *pb.PurchaseOrderID, items []entity.ReceivedOrderItem) error
+	GetOutstandingPurchaseOrders(in *pb.OutstandingOrdersReq) (*pb.OutstandingOrdersList, error)
+}
+
+type SupplierReturnsRepo interface {
+	CreateSupplierReturn(in *entity.SupplierReturnRequest) (*pb.SupplierReturnResponse, error)
+	GetSupplierReturn(in *pb.SupplierReturnID) (*pb.SupplierReturnResponse, error)
+	GetSupplierReturnList(in *pb.SupplierReturnFilter) (*pb.SupplierReturnList, error)
+	VoidSupplierReturn(in *pb.VoidSupplierReturnReq) error
+	HasSupplierReturns(purchaseID string) (bool, error)
+
+	GetSupplierReturnedQuantities(purchaseID string) (map[string]int64, error)
+	SetSupplierReturnCashFlow(returnID, cashFlowID string) error
+}
+
+type StocktakesRepo interface {
+	CreateStocktake(in *pb.StocktakeRequest) (*pb.Stocktake, error)
+	GetStocktake(in *pb.StocktakeID) (*pb.Stocktake, error)
+	GetStocktakeList(in *pb.StocktakeFilter) (*pb.StocktakeList, error)
+	AddStocktakeCounts(in *pb.SubmitStocktakeCountsReq) error
+	CloseStocktake(in *pb.CloseStocktakeReq, shrinkage, surplus float64) error
+	SetStocktakeStatus(in *pb.StocktakeID, status string, from ...string) error
+	SetStocktakeCashFlow(stocktakeID, cashFlowID string) error
+}
+
+type StockAdjustmentsRepo interface {
+	CreateStockAdjustment(in *entity.StockAdjustmentRequest) (*pb.StockAdjustment, error)
+	GetStockAdjustment(id string) (*pb.StockAdjustment, error)
+	ValueStockAdjustment(id string) (*pb.StockAdjustment, error)
+	SetStockAdjustmentCashFlow(adjustmentID, cashFlowID string) error
+
+	GetWriteOffReport(in *pb.WriteOffReportReq) (*pb.WriteOffReport, error)
+}
+
+type StockAlertsRepo interface {
+	ClaimStockAlerts(limit int) ([]entity.StockAlert, error)
+	SetStockAlertStatus(id, status, errMsg string) error
+}
+
+type SaleDebtsRepo interface {
+	EnqueueSaleDebt(in entity.SaleDebt) error
+	ReduceSaleDebt(saleID, sourceID, payType string, amount float64) error
+	VoidSaleDebt(saleID string) error
+	ClaimSaleDebts(limit int, saleID string) ([]entity.SaleDebt, error)
+	SetSaleDebtClient(id, saleID, clientID string) error
+	SetSaleDebtStatus(id, status, debtID, errMsg string) error
+}
+
+type SettingsRepo interface {
+	GetCompanySettings(in *pb.CompanySettingsReq) (*pb.CompanySettings, error)
+	UpdateCompanySettings(in *pb.CompanySettings) (*pb.CompanySettings, error)
+}
+
+type PaymentTypesRepo interface {
+	CreatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error)
+	UpdatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error)
+	GetPaymentType(in *pb.PaymentTypeID) (*pb.PaymentType, error)
+	GetListPaymentTypes(in *pb.PaymentTypeFilter) (*pb.PaymentTypeList, error)
+	DeletePaymentType(in *pb.PaymentTypeID) (*pb.Message, error)
+
+	EnsureDefaultPaymentTypes(companyID string) error
+}
+
+// DebtsClient is the part of the debts service used by credit sales.
+// debts.DebtsServiceClient implements it; webapi has a stub for local runs.
+type DebtsClient interface {
+	AddClient(ctx context.Context, in *debts.CreateClients, opts ...grpc.CallOption) (*debts.Client, error)
+	CreateDebts(ctx context.Context, in *debts.DebtsRequest, opts ...grpc.CallOption) (*debts.Debts, error)
+	GetDebts(ctx context.Context, in *debts.DebtsID, opts ...grpc.CallOption) (*debts.Debts, error)
+	PayDebts(ctx context.Context, in *debts.PayDebtsReq, opts ...grpc.CallOption) (*debts.Debts, error)
+}
+
+// SMSSender is the part of the company service used by low-stock alerts.
+// company.CompanyServiceClient implements it; webapi has a stub for local runs.
+type SMSSender interface {
+	SendSMS(ctx context.Context, in *company.SmsRequest, opts ...grpc.CallOption) (*company.Message, error)
+}
+
+type SerialsRepo interface {
+	GetSerializedProducts(productIDs []string) (map[string]bool, error)
+	ReceiveSerials(productID string, serials []string, info entity.MovementInfo) error
+	SetSerialStatus(productID string, serials []string, from, to string, info entity.MovementInfo) error
+	MoveSerials(productID, toBranchID string, serials []string, info entity.MovementInfo) error
+	TraceSerial(in *pb.TraceSerialReq) (*pb.SerialTrace, error)
+	GetProductSerials(in *pb.ProductSerialsReq) (*pb.ProductSerialList, error)
+}
+
+// TxRepos repositories bound to one transaction of a UnitOfWork
+type TxRepos struct {
+	Product   ProductQuantity
+	Sales     SalesRepo
+	Purchases PurchasesRepo
+	CashFlow  CashFlowRepo
+	Returns   ReturnedProductsRepo
+	Settings  SettingsRepo
+
+	PaymentTypes    PaymentTypesRepo
+	SupplierReturns SupplierReturnsRepo
+	PurchaseOrders  PurchaseOrdersRepo
+	Stocktakes      StocktakesRepo
+
+	StockAdjustments StockAdjustmentsRepo
+	Serials          SerialsRepo
+	SaleDebts        SaleDebtsRepo
+}
+
+type UnitOfWork interface {
+	Do(fn func(r *TxRepos) error) error
 }
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+type InventoryUseCase struct {
+	quantity ProductQuantity
+	log      *slog.Logger
+}
+
+func NewInventoryUseCase(quantity ProductQuantity, log *slog.Logger) *InventoryUseCase {
+	return &InventoryUseCase{
+		quantity: quantity,
+		log:      log,
+	}
+}
+
+// GetStockMovements retrieves the stock movement ledger based on filters.
+func (i *InventoryUseCase) GetStockMovements(in *pb.StockMovementFilter) (*pb.StockMovementList, error) {
+	if in == nil {
+		return nil, errors.New("stock movement filter is nil")
+	}
+
+	res, err := i.quantity.GetStockMovements(in)
+	if err != nil {
+		i.log.Error("Error fetching stock movements", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching stock movements: %w", err)
+	}
+	return res, nil
+}
+
+// GetLowStockProducts retrieves the products that ran out of stock or were oversold.
+func (i *InventoryUseCase) GetLowStockProducts(in *pb.LowStockFilter) (*pb.LowStockList, error) {
+	if in == nil {
+		return nil, errors.New("low stock filter is nil")
+	}
+
+	res, err := i.quantity.GetLowStockProducts(in)
+	if err != nil {
+		i.log.Error("Error fetching low stock products", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching low stock products: %w", err)
+	}
+	return res, nil
+}
+
+// GetExpiringStock retrieves the lots of a branch that expire within the horizon, expired lots included.
+func (i *InventoryUseCase) GetExpiringStock(in *pb.ExpiringStockReq) (*pb.ExpiringStockList, error) {
+	if in == nil {
+		return nil, errors.New("expiring stock request is nil")
+	}
+	if in.Days < 0 {
+		return nil, fmt.Errorf("invalid horizon %d: must not be negative", in.Days)
+	}
+
+	res, err := i.quantity.GetExpiringStock(in)
+	if err != nil {
+		i.log.Error("Error fetching expiring stock", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching expiring stock: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/pkg/label"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"strings"
+)
+
+// maxLabels caps one rendering so a typo in a quantity cannot produce a huge document
+const maxLabels = 1000
+
+type LabelsUseCase struct {
+	repo ProductsRepo
+	log  *slog.Logger
+}
+
+func NewLabelsUseCase(repo ProductsRepo, log *slog.Logger) *LabelsUseCase {
+	return &LabelsUseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// RenderLabels draws price labels for branch products, each repeated by its quantity.
+// A label shows the product name, its selling price in the bill_format currency and its EAN-13 barcode.
+func (l *LabelsUseCase) RenderLabels(in *pb.RenderLabelsReq) (*pb.Labels, error) {
+	if in == nil {
+		return nil, errors.New("render labels request is nil")
+	}
+	if len(in.Items) == 0 {
+		return nil, errors.New("label items are empty")
+	}
+
+	format := strings.ToLower(in.Format)
+	var labels []label.Label
+	for _, item := range in.Items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("invalid label quantity for product %v: must be positive", item.ProductId)
+		}
+		if int64(len(labels))+item.Quantity > maxLabels {
+			return nil, fmt.Errorf("too many labels: at most %d can be rendered at once", maxLabels)
+		}
+
+		product, err := l.repo.GetProduct(&pb.GetProductRequest{Id: item.ProductId, CompanyId: in.CompanyId, BranchId: in.BranchId})
+		if err != nil {
+			l.log.Error("Error fetching product for labels", "productID", item.ProductId, "error", err)
+			return nil, fmt.Errorf("error fetching product %v: %w", item.ProductId, err)
+		}
+
+		barcode := labelBarcode(product.Barcodes)
+		if barcode == "" {
+			return nil, fmt.Errorf("product %s has no EAN-13 or UPC-A barcode", product.Name)
+		}
+
+		printed := label.Label{
+			Name:    product.Name,
+			Price:   formatPrice(product.StandardPrice, product.BillFormat),
+			Barcode: barcode,
+		}
+		for i := int64(0); i < item.Quantity; i++ {
+			labels = append(labels, printed)
+		}
+	}
+
+	content, contentType, err := label.Render(format, labels)
+	if err != nil {
+		l.log.Error("Error rendering labels", "format", format, "error", err)
+		return nil, fmt.Errorf("error rendering labels: %w", err)
+	}
+
+	return &pb.Labels{
+		Format:      format,
+		ContentType: contentType,
+		Content:     content,
+		LabelCount:  int64(len(labels)),
+	}, nil
+}
+
+// labelBarcode picks the first barcode that can be printed as EAN-13; a UPC-A code is an EAN-13 starting with 0.
+func labelBarcode(barcodes []string) string {
+	for _, barcode := range barcodes {
+		if len(barcode) == 12 {
+			barcode = "0" + barcode
+		}
+		if len(barcode) == 13 && validateBarcode(barcode) == nil && strings.Trim(barcode, "0123456789") == "" {
+			return barcode
+		}
+	}
+	return ""
+}
+
+// formatPrice writes the price the way it is shown at the till: whole so'm, dollars with cents.
+func formatPrice(amount float64, billFormat string) string {
+	if strings.ToUpper(billFormat) == "USD" {
+		return "$" + groupThousands(decimal.NewFromFloat(amount).StringFixed(2))
+	}
+	return groupThousands(decimal.NewFromFloat(amount).StringFixed(0)) + " so'm"
+}
+
+// groupThousands separates the thousands of the integer part with spaces.
+func groupThousands(number string) string {
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign, integer = "-", integer[1:]
+	}
+
+	var b strings.Builder
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+
+	if hasFraction {
+		return sign + b.String() + "." + fraction
+	}
+	return sign + b.String()
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+type PaymentTypesUseCase struct {
+	repo PaymentTypesRepo
+	log  *slog.Logger
+}
+
+func NewPaymentTypesUseCase(repo PaymentTypesRepo, log *slog.Logger) *PaymentTypesUseCase {
+	return &PaymentTypesUseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// validatePaymentType checks the fields of a payment type and normalizes its currency.
+func validatePaymentType(in *pb.PaymentType) error {
+	if in == nil || in.CompanyId == "" {
+		return errors.New("company_id is required")
+	}
+
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" {
+		return errors.New("payment type name is required")
+	}
+
+	in.Currency = strings.ToLower(strings.TrimSpace(in.Currency))
+	if in.Currency == "" {
+		in.Currency = "uzs"
+	}
+	if len(in.Currency) != 3 {
+		return fmt.Errorf("invalid currency %q: must be a 3-letter code", in.Currency)
+	}
+
+	return nil
+}
+
+// CreatePaymentType adds a payment type to the company. New types are always active;
+// with is_default the new type becomes the default of the company.
+func (p *PaymentTypesUseCase) CreatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error) {
+	if err := validatePaymentType(in); err != nil {
+		return nil, err
+	}
+	in.Active = true
+
+	res, err := p.repo.CreatePaymentType(in)
+	if err != nil {
+		p.log.Error("Error creating payment type", "companyID", in.CompanyId, "error", err)
+		return nil, fmt.Errorf("error creating payment type: %w", err)
+	}
+	return res, nil
+}
+
+// UpdatePaymentType replaces the fields of a payment type; inactive types cannot be used in new documents.
+// Setting is_default makes the type the default of the company; the default type stays active.
+func (p *PaymentTypesUseCase) UpdatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error) {
+	if err := validatePaymentType(in); err != nil {
+		return nil, err
+	}
+	if in.Id == "" {
+		return nil, errors.New("payment type id is required")
+	}
+
+	if !in.Active {
+		current, err := p.repo.GetPaymentType(&pb.PaymentTypeID{Id: in.Id, CompanyId: in.CompanyId})
+		if err != nil {
+			p.log.Error("Error fetching payment type", "paymentTypeID", in.Id, "error", err)
+			return nil, fmt.Errorf("error fetching payment type: %w", err)
+		}
+		if current.IsDefault || in.IsDefault {
+			return nil, errors.New("the default payment type cannot be deactivated: make another type the default first")
+		}
+	}
+
+	res, err := p.repo.UpdatePaymentType(in)
+	if err != nil {
+		p.log.Error("Error updating payment type", "paymentTypeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error updating payment type: %w", err)
+	}
+	return res, nil
+}
+
+// GetPaymentType retrieves a payment type by ID or name.
+func (p *PaymentTypesUseCase) GetPaymentType(in *pb.PaymentTypeID) (*pb.PaymentType, error) {
+	if in == nil || in.CompanyId == "" || in.Id == "" {
+		return nil, errors.New("payment type id and company_id are required")
+	}
+
+	res, err := p.repo.GetPaymentType(in)
+	if err != nil {
+		p.log.Error("Error fetching payment type", "paymentTypeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching payment type: %w", err)
+	}
+	return res, nil
+}
+
+// GetListPaymentTypes lists the payment types of a company, seeding the defaults for a company that has none yet.
+func (p *PaymentTypesUseCase) GetListPaymentTypes(in *pb.PaymentTypeFilter) (*pb.PaymentTypeList, error) {
+	if in == nil || in.CompanyId == "" {
+		return nil, errors.New("company_id is required")
+	}
+
+	res, err := p.repo.GetListPaymentTypes(in)
+	if err == nil && len(res.PaymentTypes) == 0 && in.Name == "" && !in.ActiveOnly {
+		if err = p.repo.EnsureDefaultPaymentTypes(in.CompanyId); err != nil {
+			p.log.Error("Error seeding default payment types", "companyID", in.CompanyId, "error", err)
+			return nil, fmt.Errorf("error seeding default payment types: %w", err)
+		}
+		res, err = p.repo.GetListPaymentTypes(in)
+	}
+	if err != nil {
+		p.log.Error("Error fetching payment types", "companyID", in.CompanyId, "error", err)
+		return nil, fmt.Errorf("error fetching payment types: %w", err)
+	}
+	return res, nil
+}
+
+// DeletePaymentType deletes a payment type that no document uses yet. The default type cannot be deleted.
+func (p *PaymentTypesUseCase) DeletePaymentType(in *pb.PaymentTypeID) (*pb.Message, error) {
+	if in == nil || in.CompanyId == "" || in.Id == "" {
+		return nil, errors.New("payment type id and company_id are required")
+	}
+
+	current, err := p.repo.GetPaymentType(in)
+	if err != nil {
+		p.log.Error("Error fetching payment type", "paymentTypeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching payment type: %w", err)
+	}
+	if current.IsDefault {
+		return nil, errors.New("the default payment type cannot be deleted: make another type the default first")
+	}
+
+	res, err := p.repo.DeletePaymentType(&pb.PaymentTypeID{Id: current.Id, CompanyId: in.CompanyId})
+	if err != nil {
+		p.log.Error("Error deleting payment type", "paymentTypeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error deleting payment type: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+)
+
+// splitPayments checks that the payments add up to the amount due.
+// Without payments the whole amount is paid with the single payment method of the document;
+// when the document names none either, resolvePaymentTypes puts in the default type of the company.
+func splitPayments(payments []entity.Payment, paymentMethod string, due float64) ([]entity.Payment, error) {
+	if len(payments) == 0 {
+		if due <= 0 {
+			return nil, nil
+		}
+		return []entity.Payment{{
+			Amount:        due,
+			PaymentMethod: paymentMethod,
+		}}, nil
+	}
+
+	var total decimal.Decimal
+	result := make([]entity.Payment, 0, len(payments))
+	for _, p := range payments {
+		if p.Amount <= 0 {
+			return nil, fmt.Errorf("invalid payment amount %v: must be positive", p.Amount)
+		}
+		if p.PaymentMethod == "" {
+			return nil, errors.New("payment method is required for every payment")
+		}
+
+		total = total.Add(decimal.NewFromFloat(p.Amount))
+		result = append(result, p)
+	}
+
+	if !total.Round(2).Equal(decimal.NewFromFloat(due).Round(2)) {
+		return nil, fmt.Errorf("payments total %v does not match the amount due %v", total.Round(2), due)
+	}
+
+	return result, nil
+}
+
+// resolvePaymentTypes checks that the document and all its payments use active payment types of the company.
+// Payments may name a type by id or name, or leave it empty for the default type of the company; they are rewritten
+// to the type name and get the currency of the type. Returns the payment type of the document itself.
+func resolvePaymentTypes(r PaymentTypesRepo, companyID, paymentMethod string, payments []entity.Payment) (*pb.PaymentType, error) {
+	resolved := make(map[string]*pb.PaymentType)
+	resolve := func(ref string) (*pb.PaymentType, error) {
+		if t, ok := resolved[ref]; ok {
+			return t, nil
+		}
+
+		t, err := getPaymentType(r, companyID, ref)
+		if err != nil {
+			return nil, err
+		}
+		if !t.Active {
+			return nil, fmt.Errorf("payment type %q is not active", t.Name)
+		}
+
+		resolved[ref] = t
+		return t, nil
+	}
+
+	docType, err := resolve(paymentMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range payments {
+		t, err := resolve(payments[i].PaymentMethod)
+		if err != nil {
+			return nil, err
+		}
+		payments[i].PaymentMethod = t.Name
+		payments[i].Currency = t.Currency
+	}
+
+	return docType, nil
+}
+
+// getPaymentType looks up a payment type of the company. The defaults are seeded when the company writes
+// its settings; a company that has not done so yet gets them on its first miss.
+func getPaymentType(r PaymentTypesRepo, companyID, ref string) (*pb.PaymentType, error) {
+	in := &pb.PaymentTypeID{Id: ref, CompanyId: companyID}
+	t, err := r.GetPaymentType(in)
+	if err == nil {
+		return t, nil
+	}
+
+	if seedErr := r.EnsureDefaultPaymentTypes(companyID); seedErr != nil {
+		return nil, seedErr
+	}
+	return r.GetPaymentType(in)
+}
+
+// paymentsToPb converts saved payments for a response
+func paymentsToPb(payments []entity.Payment) []*pb.SplitPayment {
+	var res []*pb.SplitPayment
+	for _, p := range payments {
+		res = append(res, &pb.SplitPayment{
+			Amount:        p.Amount,
+			PaymentMethod: p.PaymentMethod,
+			Currency:      p.Currency,
+			CashFlowId:    p.CashFlowID,
+		})
+	}
+	return res
+}
+
+// paymentsFromPb converts requested payments of an RPC that reaches the use case as a protobuf message
+func paymentsFromPb(in []*pb.SplitPayment) []entity.Payment {
+	var payments []entity.Payment
+	for _, p := range in {
+		payments = append(payments, entity.Payment{
+			Amount:        p.Amount,
+			PaymentMethod: p.PaymentMethod,
+			Currency:      p.Currency,
+		})
+	}
+	return payments
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	"reflect"
+	"testing"
+)
+
+func TestSplitPayments(t *testing.T) {
+	tests := []struct {
+		name          string
+		payments      []entity.Payment
+		paymentMethod string
+		due           float64
+		want          []entity.Payment
+		wantErr       bool
+	}{
+		{
+			name:          "no payments pay the whole amount with the document method",
+			paymentMethod: "card",
+			due:           150000,
+			want:          []entity.Payment{{Amount: 150000, PaymentMethod: "card"}},
+		},
+		{
+			name: "no payments and no method leave the type to the company default",
+			due:  10,
+			want: []entity.Payment{{Amount: 10}},
+		},
+		{
+			name:          "nothing due needs no payment",
+			paymentMethod: "card",
+			due:           0,
+		},
+		{
+			name: "split payments adding up to the amount due",
+			payments: []entity.Payment{
+				{Amount: 100000.10, PaymentMethod: "uzs"},
+				{Amount: 49999.90, PaymentMethod: "card"},
+			},
+			due: 150000,
+			want: []entity.Payment{
+				{Amount: 100000.10, PaymentMethod: "uzs"},
+				{Amount: 49999.90, PaymentMethod: "card"},
+			},
+		},
+		{
+			name:     "floating point sums are compared to the cent",
+			payments: []entity.Payment{{Amount: 0.1, PaymentMethod: "uzs"}, {Amount: 0.2, PaymentMethod: "card"}},
+			due:      0.3,
+			want:     []entity.Payment{{Amount: 0.1, PaymentMethod: "uzs"}, {Amount: 0.2, PaymentMethod: "card"}},
+		},
+		{
+			name:     "payments below the amount due",
+			payments: []entity.Payment{{Amount: 100, PaymentMethod: "uzs"}},
+			due:      150,
+			wantErr:  true,
+		},
+		{
+			name:     "payments above the amount due",
+			payments: []entity.Payment{{Amount: 100, PaymentMethod: "uzs"}, {Amount: 60, PaymentMethod: "card"}},
+			due:      150,
+			wantErr:  true,
+		},
+		{
+			name:     "zero payment",
+			payments: []entity.Payment{{Amount: 0, PaymentMethod: "uzs"}, {Amount: 150, PaymentMethod: "card"}},
+			due:      150,
+			wantErr:  true,
+		},
+		{
+			name:     "negative payment",
+			payments: []entity.Payment{{Amount: -50, PaymentMethod: "uzs"}, {Amount: 200, PaymentMethod: "card"}},
+			due:      150,
+			wantErr:  true,
+		},
+		{
+			name:     "payment without a method",
+			payments: []entity.Payment{{Amount: 150}},
+			due:      150,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitPayments(tt.payments, tt.paymentMethod, tt.due)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitPayments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitPayments() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
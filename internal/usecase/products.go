@@ -1,8 +1,10 @@
 package usecase
 
 import (
+	"crm-admin/internal/entity"
 	pb "crm-admin/internal/generated/products"
 	"crm-admin/internal/webapi"
+	"errors"
 	"fmt"
 	"github.com/shopspring/decimal"
 	"log/slog"
@@ -78,6 +80,23 @@ func (p *ProductsUseCase) GetListCategory(in *pb.CategoryName) (*pb.CategoryList
 // --------------------------- Products ----------------------------------------------------------------------------
 
 func (p *ProductsUseCase) CreateProduct(in *pb.CreateProductRequest) (*pb.Product, error) {
+	var err error
+	if in.Sku, in.Barcodes, err = normalizeProductCodes(in.Sku, in.Barcodes); err != nil {
+		return nil, err
+	}
+	if in.VariantAxes, err = normalizeVariantAxes(in.VariantAxes); err != nil {
+		return nil, err
+	}
+	if in.BaseUnit, err = normalizeUnits(in.BaseUnit, in.Units); err != nil {
+		return nil, err
+	}
+	if len(in.VariantAxes) > 0 && in.TotalCount != 0 {
+		return nil, errors.New("parent product cannot hold stock: add the stock to its variants")
+	}
+	if len(in.VariantAxes) > 0 && len(in.Barcodes) > 0 {
+		return nil, errors.New("parent product cannot have barcodes: add the barcodes to its variants")
+	}
+
 	res, err := p.repo.CreateProduct(in)
 
 	if err != nil {
@@ -88,6 +107,13 @@ func (p *ProductsUseCase) CreateProduct(in *pb.CreateProductRequest) (*pb.Produc
 	return res, nil
 }
 func (p *ProductsUseCase) CreateBulkProducts(in *pb.CreateBulkProductsRequest) (*pb.BulkCreateResponse, error) {
+	var err error
+	for _, product := range in.Products {
+		if product.Sku, product.Barcodes, err = normalizeProductCodes(product.Sku, product.Barcodes); err != nil {
+			return nil, fmt.Errorf("product %q: %w", product.Name, err)
+		}
+	}
+
 	res, err := p.repo.CreateBulkProducts(in)
 
 	if err != nil {
@@ -99,6 +125,14 @@ func (p *ProductsUseCase) CreateBulkProducts(in *pb.CreateBulkProductsRequest) (
 }
 
 func (p *ProductsUseCase) UpdateProduct(in *pb.UpdateProductRequest) (*pb.Product, error) {
+	var err error
+	if in.Sku, in.Barcodes, err = normalizeProductCodes(in.Sku, in.Barcodes); err != nil {
+		return nil, err
+	}
+	if in.BaseUnit, err = normalizeUnits(in.BaseUnit, in.Units); err != nil {
+		return nil, err
+	}
+
 	res, err := p.repo.UpdateProduct(in)
 
 	if err != nil {
@@ -142,6 +176,86 @@ func (p *ProductsUseCase) GetProductList(in *pb.ProductFilter) (*pb.ProductList,
 	return res, nil
 }
 
+// GetProductByBarcode finds the branch product a scanned barcode or SKU belongs to.
+func (p *ProductsUseCase) GetProductByBarcode(in *pb.GetProductByBarcodeReq) (*pb.Product, error) {
+	if in == nil {
+		return nil, errors.New("barcode request is nil")
+	}
+	in.Barcode = strings.TrimSpace(in.Barcode)
+	if in.Barcode == "" {
+		return nil, errors.New("barcode is required")
+	}
+
+	res, err := p.repo.GetProductByBarcode(in)
+	if err != nil {
+		p.log.Error("GetProductByBarcode", "error", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateVariant adds a variant with one value per axis of its parent. The variant keeps its own stock and barcode;
+// without its own standard price it sells at the parent's price.
+func (p *ProductsUseCase) CreateVariant(in *pb.CreateVariantReq) (*pb.Product, error) {
+	if in == nil {
+		return nil, errors.New("variant request is nil")
+	}
+	if in.IncomingPrice < 0 || in.StandardPrice < 0 {
+		return nil, errors.New("invalid variant prices: must not be negative")
+	}
+	for i, value := range in.VariantValues {
+		in.VariantValues[i] = strings.TrimSpace(value)
+		if in.VariantValues[i] == "" {
+			return nil, errors.New("variant values must not be empty")
+		}
+	}
+
+	var err error
+	if in.Sku, in.Barcodes, err = normalizeProductCodes(in.Sku, in.Barcodes); err != nil {
+		return nil, err
+	}
+
+	res, err := p.repo.CreateVariant(in)
+	if err != nil {
+		p.log.Error("CreateVariant", "error", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetCatalogStock returns company catalog items with the stock and prices of every branch.
+func (p *ProductsUseCase) GetCatalogStock(in *pb.CatalogStockReq) (*pb.CatalogStockList, error) {
+	res, err := p.repo.GetCatalogStock(in)
+
+	if err != nil {
+		p.log.Error("GetCatalogStock", "error", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SetStockThresholds sets the low-stock threshold and the reorder quantity of a branch product.
+// A zero min_stock turns the low-stock alerts of the product off.
+func (p *ProductsUseCase) SetStockThresholds(in *pb.StockThresholdsReq) (*pb.Product, error) {
+	if in == nil {
+		return nil, errors.New("stock thresholds request is nil")
+	}
+	if in.MinStock < 0 || in.ReorderQty < 0 {
+		return nil, fmt.Errorf("invalid stock thresholds for product %v: must not be negative", in.Id)
+	}
+
+	res, err := p.repo.SetStockThresholds(in)
+	if err != nil {
+		p.log.Error("SetStockThresholds", "error", err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (p *ProductsUseCase) GetProductDashboard(in *pb.GetProductsDashboardReq) (*pb.GetProductsDashboardRes, error) {
 
 	dbRes, err := p.repo.GetProductDashboard(in)
@@ -204,3 +318,82 @@ func (p *ProductsUseCase) GetProductDashboard(in *pb.GetProductsDashboardReq) (*
 		AmountSalePrice:     sumSale.InexactFloat64(),
 	}, nil
 }
+
+const maxProductCodeLength = 64
+
+// normalizeVariantAxes trims the variant axes of a parent product; axes must be non-empty and unique.
+func normalizeVariantAxes(axes []string) ([]string, error) {
+	seen := make(map[string]bool, len(axes))
+	res := make([]string, 0, len(axes))
+	for _, axis := range axes {
+		axis = strings.TrimSpace(axis)
+		if axis == "" {
+			return nil, errors.New("variant axes must not be empty")
+		}
+		if seen[strings.ToLower(axis)] {
+			return nil, fmt.Errorf("duplicate variant axis %s", axis)
+		}
+		seen[strings.ToLower(axis)] = true
+		res = append(res, axis)
+	}
+	return res, nil
+}
+
+// normalizeProductCodes trims the SKU and the barcodes and checks them. Barcodes must be unique within
+// the list; all-digit codes of GTIN length (EAN-8, UPC-A, EAN-13, GTIN-14) must carry a valid check digit.
+func normalizeProductCodes(sku string, barcodes []string) (string, []string, error) {
+	sku = strings.TrimSpace(sku)
+	if len(sku) > maxProductCodeLength {
+		return "", nil, fmt.Errorf("invalid sku %q: longer than %d characters", sku, maxProductCodeLength)
+	}
+
+	seen := make(map[string]bool, len(barcodes))
+	codes := make([]string, 0, len(barcodes))
+	for _, barcode := range barcodes {
+		barcode = strings.TrimSpace(barcode)
+		if barcode == "" {
+			continue
+		}
+		if err := validateBarcode(barcode); err != nil {
+			return "", nil, err
+		}
+		if seen[barcode] {
+			return "", nil, fmt.Errorf("duplicate barcode %s", barcode)
+		}
+		seen[barcode] = true
+		codes = append(codes, barcode)
+	}
+
+	return sku, codes, nil
+}
+
+// validateBarcode accepts GTIN codes with a valid check digit and internal codes of letters, digits, '-', '_' and '.'.
+func validateBarcode(barcode string) error {
+	if len(barcode) > maxProductCodeLength {
+		return fmt.Errorf("invalid barcode %q: longer than %d characters", barcode, maxProductCodeLength)
+	}
+
+	digits := true
+	for _, r := range barcode {
+		switch {
+		case r >= '0' && r <= '9':
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-', r == '_', r == '.':
+			digits = false
+		default:
+			return fmt.Errorf("invalid barcode %q: unexpected character %q", barcode, r)
+		}
+	}
+
+	switch len(barcode) {
+	case 8, 12, 13, 14:
+		if digits && !validGTIN(barcode) {
+			return fmt.Errorf("invalid barcode %s: wrong check digit", barcode)
+		}
+	}
+	return nil
+}
+
+// validGTIN checks the last digit of a GTIN code.
+func validGTIN(code string) bool {
+	return code[len(code)-1] == entity.GTINCheckDigit(code[:len(code)-1])
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		barcode string
+		wantErr bool
+	}{
+		{name: "EAN-13", barcode: "4006381333931"},
+		{name: "EAN-8", barcode: "96385074"},
+		{name: "UPC-A", barcode: "036000291452"},
+		{name: "GTIN-14", barcode: "10614141000415"},
+		{name: "EAN-13 with a wrong check digit", barcode: "4006381333932", wantErr: true},
+		{name: "EAN-8 with a wrong check digit", barcode: "96385075", wantErr: true},
+		{name: "UPC-A with a wrong check digit", barcode: "036000291453", wantErr: true},
+		{name: "digits of another length are not checked", barcode: "12345"},
+		{name: "internal code of the GTIN length is not checked", barcode: "ABC-1234.X_9"},
+		{name: "internal code", barcode: "SKU_42-b.1"},
+		{name: "space", barcode: "4006 381", wantErr: true},
+		{name: "non-ASCII letter", barcode: "код123", wantErr: true},
+		{name: "longest code", barcode: strings.Repeat("A", maxProductCodeLength)},
+		{name: "too long", barcode: strings.Repeat("A", maxProductCodeLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateBarcode(tt.barcode); (err != nil) != tt.wantErr {
+				t.Errorf("validateBarcode(%q) error = %v, wantErr %v", tt.barcode, err, tt.wantErr)
+			}
+		})
+	}
+}
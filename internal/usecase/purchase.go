@@ -8,28 +8,38 @@ import (
 	"log"
 	"log/slog"
 	"math"
-	"sync"
+	"strings"
+	"time"
 )
 
+const maxLotNumberLength = 64
+
 type PurchaseUseCase struct {
 	repo    PurchasesRepo
 	product ProductQuantity
 	cash    CashFlowRepo // добавляем репозиторий для работы с cash_flow
+	uow     UnitOfWork
 	log     *slog.Logger
 }
 
 // NewPurchaseUseCase создает новый экземпляр PurchaseUseCase
-func NewPurchaseUseCase(repo PurchasesRepo, pr ProductQuantity, log *slog.Logger, cash CashFlowRepo) *PurchaseUseCase {
+func NewPurchaseUseCase(repo PurchasesRepo, pr ProductQuantity, log *slog.Logger, cash CashFlowRepo, uow UnitOfWork) *PurchaseUseCase {
 	return &PurchaseUseCase{
 		repo:    repo,
 		product: pr,
 		cash:    cash,
+		uow:     uow,
 		log:     log,
 	}
 }
 
 func (p *PurchaseUseCase) CalculateTotalPurchases(in *entity.Purchase) (*entity.PurchaseRequest, error) {
+	return p.calculateTotalPurchases(p.product, in)
+}
 
+// calculateTotalPurchases считает закупку, читая карточки каталога и единицы товаров через переданный репозиторий:
+// внутри транзакции это репозиторий транзакции
+func (p *PurchaseUseCase) calculateTotalPurchases(products ProductQuantity, in *entity.Purchase) (*entity.PurchaseRequest, error) {
 	if in == nil {
 		return nil, fmt.Errorf("input purchase is nil")
 	}
@@ -38,18 +48,56 @@ func (p *PurchaseUseCase) CalculateTotalPurchases(in *entity.Purchase) (*entity.
 		return nil, fmt.Errorf("purchase items are empty")
 	}
 
+	// Позиция может ссылаться на карточку каталога вместо строки товара филиала
+	refs := make([]catalogRef, len(in.PurchaseItems))
+	for i := range in.PurchaseItems {
+		refs[i] = catalogRef{catalogID: in.PurchaseItems[i].CatalogID, productID: &in.PurchaseItems[i].ProductID}
+	}
+	if err := resolveCatalogProducts(products, in.CompanyID, in.BranchID, refs); err != nil {
+		return nil, err
+	}
+
 	var result entity.PurchaseRequest
 	totalSum := decimal.NewFromFloat(0)
 	var purchaseList []entity.PurchaseItemReq
 
+	var unitProducts []string
+	for _, pr := range in.PurchaseItems {
+		if pr.Unit != "" {
+			unitProducts = append(unitProducts, pr.ProductID)
+		}
+	}
+	factors, err := unitFactors(products, unitProducts)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, pr := range in.PurchaseItems {
+		// Позиция в единице закупки (коробка, кг) оценивается по цене этой единицы и приходуется в базовых единицах
+		quantity := decimal.NewFromFloat(float64(pr.Quantity))
+		purchasePrice := decimal.NewFromFloat(float64(pr.PurchasePrice))
+		if pr.Unit != "" {
+			pr.Quantity = 0
+			if pr.UnitQuantity > 0 {
+				quantity = decimal.NewFromFloat(pr.UnitQuantity)
+				var baseQuantity int64
+				baseQuantity, pr.PurchasePrice, err = toBaseUnits(factors, pr.ProductID, pr.Unit, pr.UnitQuantity, pr.PurchasePrice)
+				if err != nil {
+					return nil, err
+				}
+				pr.Quantity = int(baseQuantity)
+			}
+		}
 		if pr.Quantity == 0 {
 			p.log.Warn("Skipping item with zero quantity", "productID", pr.ProductID, "SupplierID", in.SupplierID)
 			continue
 		}
 
-		quantity := decimal.NewFromFloat(float64(pr.Quantity))
-		purchasePrice := decimal.NewFromFloat(float64(pr.PurchasePrice))
+		lotNumber, expiresAt, err := normalizeLot(pr.ProductID, pr.LotNumber, pr.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
 		totalPrice := quantity.Mul(purchasePrice)
 
 		total, _ := totalPrice.Float64()
@@ -59,6 +107,11 @@ func (p *PurchaseUseCase) CalculateTotalPurchases(in *entity.Purchase) (*entity.
 			ProductID:     pr.ProductID,
 			Quantity:      pr.Quantity,
 			TotalPrice:    total,
+			Unit:          pr.Unit,
+			UnitQuantity:  pr.UnitQuantity,
+			LotNumber:     lotNumber,
+			ExpiresAt:     expiresAt,
+			Serials:       pr.Serials,
 		}
 
 		purchaseList = append(purchaseList, purchase)
@@ -70,20 +123,61 @@ func (p *PurchaseUseCase) CalculateTotalPurchases(in *entity.Purchase) (*entity.
 
 	log.Println(totalCost)
 
+	payments, err := splitPayments(in.Payments, in.PaymentMethod, totalCost)
+	if err != nil {
+		return nil, err
+	}
+	paymentMethod := in.PaymentMethod
+	if paymentMethod == "" && len(payments) > 0 {
+		paymentMethod = payments[0].PaymentMethod
+	}
+
 	result = entity.PurchaseRequest{
 		PurchasedBy:   in.PurchasedBy,
 		SupplierID:    in.SupplierID,
 		PurchaseItems: purchaseList,
 		TotalCost:     totalCost, // Применяем округление
-		PaymentMethod: in.PaymentMethod,
+		PaymentMethod: paymentMethod,
+		Payments:      payments,
 		Description:   in.Description,
 		CompanyID:     in.CompanyID,
 		BranchID:      in.BranchID,
+
+		PurchaseOrderID: in.PurchaseOrderID,
 	}
 
 	return &result, nil
 }
 
+// normalizeLot проверяет номер партии и срок годности позиции закупки. Срок приводится к виду YYYY-MM-DD
+func normalizeLot(productID, lotNumber, expiresAt string) (string, string, error) {
+	lotNumber = strings.TrimSpace(lotNumber)
+	if len(lotNumber) > maxLotNumberLength {
+		return "", "", fmt.Errorf("invalid lot number for product %v: longer than %d characters", productID, maxLotNumberLength)
+	}
+
+	expiresAt = strings.TrimSpace(expiresAt)
+	if expiresAt == "" {
+		return lotNumber, "", nil
+	}
+	date, err := time.Parse(time.DateOnly, expiresAt)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid expiry date %q for product %v: must be YYYY-MM-DD", expiresAt, productID)
+	}
+
+	return lotNumber, date.Format(time.DateOnly), nil
+}
+
+// purchaseLot партия, которую приходует позиция закупки, по цене закупки базовой единицы
+func purchaseLot(item entity.PurchaseItemReq) *entity.StockLot {
+	return &entity.StockLot{
+		LotNumber: item.LotNumber,
+		ExpiresAt: item.ExpiresAt,
+		Cost:      item.PurchasePrice,
+	}
+}
+
+// CreatePurchase сохраняет закупку, приходует товары и записывает расход в одной транзакции
 func (p *PurchaseUseCase) CreatePurchase(in *entity.Purchase) (*pb.PurchaseResponse, error) {
 	req, err := p.CalculateTotalPurchases(in)
 	if err != nil {
@@ -91,52 +185,96 @@ func (p *PurchaseUseCase) CreatePurchase(in *entity.Purchase) (*pb.PurchaseRespo
 		return nil, fmt.Errorf("error calculating total purchase cost: %w", err)
 	}
 
-	// Создаем покупку в репозитории
-	res, err := p.repo.CreatePurchase(req)
+	var res *pb.PurchaseResponse
+	err = p.uow.Do(func(r *TxRepos) error {
+		res, err = p.createPurchase(r, req)
+		return err
+	})
 	if err != nil {
 		p.log.Error("Failed to create purchase", "error", err)
-		return nil, fmt.Errorf("error creating purchase: %w", err)
+		return nil, err
 	}
 
-	// Добавляем запись в cash flow для покупки
-	cashFlowRequest := &pb.CashFlowRequest{
-		UserId:        in.PurchasedBy,
-		Amount:        req.TotalCost,
-		Description:   fmt.Sprintf("Mahsulot Sotib olindi"),
-		PaymentMethod: in.PaymentMethod,
-		CompanyId:     in.CompanyID,
-		BranchId:      in.BranchID,
+	return res, nil
+}
+
+// createPurchase сохраняет рассчитанную закупку внутри уже открытой транзакции: приёмка заказа поставщику
+// создаёт закупку вместе с изменением заказа
+func (p *PurchaseUseCase) createPurchase(r *TxRepos, req *entity.PurchaseRequest) (*pb.PurchaseResponse, error) {
+	paymentType, err := resolvePaymentTypes(r.PaymentTypes, req.CompanyID, req.PaymentMethod, req.Payments)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving payment types: %w", err)
 	}
+	req.PaymentMethod = paymentType.Name
 
-	_, err = p.cash.CreateExpense(cashFlowRequest) // предполагаем наличие метода Create в CashFlowRepo
+	// Серийный товар приходуется только вместе с номерами всех единиц
+	productIDs := make([]string, 0, len(req.PurchaseItems))
+	for _, item := range req.PurchaseItems {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	serialized, err := serializedProducts(r.Serials, productIDs)
 	if err != nil {
-		p.log.Error("Failed to create cash flow entry for purchase", "error", err)
-		return nil, fmt.Errorf("error creating cash flow entry: %w", err)
+		return nil, err
+	}
+	for i, item := range req.PurchaseItems {
+		req.PurchaseItems[i].Serials, err = normalizeSerials(serialized, item.ProductID, int64(item.Quantity), item.Serials)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Обрабатываем товары, добавляем их в инвентарь
-	var wg sync.WaitGroup
-	semaphore := make(chan struct{}, 10) // ограничиваем до 10 горутин
+	// Создаем покупку в репозитории
+	res, err := r.Purchases.CreatePurchase(req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating purchase: %w", err)
+	}
 
-	for _, item := range in.PurchaseItems {
-		wg.Add(1)
-		go func(item entity.PurchaseItem) {
-			defer wg.Done()
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
+	// Добавляем запись в cash flow для каждой оплаты закупки
+	for i, payment := range req.Payments {
+		cashFlowRequest := &pb.CashFlowRequest{
+			UserId:        req.PurchasedBy,
+			Amount:        payment.Amount,
+			Description:   "Mahsulot Sotib olindi",
+			PaymentMethod: payment.PaymentMethod,
+			CompanyId:     req.CompanyID,
+			BranchId:      req.BranchID,
+			SourceType:    entity.CashSourcePurchase,
+			SourceId:      res.Id,
+		}
 
-			productQuantityReq := &entity.CountProductReq{
-				ID:    item.ProductID,
-				Count: item.Quantity,
-			}
+		cashFlow, err := r.CashFlow.CreateExpense(cashFlowRequest)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cash flow entry: %w", err)
+		}
+		req.Payments[i].CashFlowID = cashFlow.Id
+	}
 
-			if _, err := p.product.AddProduct(productQuantityReq); err != nil {
-				p.log.Error("Failed to add product quantity", "productID", item.ProductID, "error", err)
-			}
-		}(item)
+	if err = r.Purchases.AddPurchasePayments(res.Id, req.Payments, req.CompanyID, req.BranchID); err != nil {
+		return nil, fmt.Errorf("error saving purchase payments: %w", err)
+	}
+	res.Payments = paymentsToPb(req.Payments)
+
+	// Добавляем товары в инвентарь: каждая позиция приходует свою партию и серийные номера
+	for _, item := range req.PurchaseItems {
+		productQuantityReq := &entity.CountProductReq{
+			ID:    item.ProductID,
+			Count: item.Quantity,
+			Movement: entity.MovementInfo{
+				Reason:    entity.MovementPurchase,
+				SourceID:  res.Id,
+				CreatedBy: req.PurchasedBy,
+			},
+			Lot: purchaseLot(item),
+		}
+
+		if _, err := r.Product.AddProduct(productQuantityReq); err != nil {
+			return nil, fmt.Errorf("error adding product quantity for product %s: %w", item.ProductID, err)
+		}
+		if err := r.Serials.ReceiveSerials(item.ProductID, item.Serials, productQuantityReq.Movement); err != nil {
+			return nil, fmt.Errorf("error receiving serials of product %s: %w", item.ProductID, err)
+		}
 	}
 
-	wg.Wait()
 	return res, nil
 }
 
@@ -185,50 +323,370 @@ func (p *PurchaseUseCase) GetListPurchase(req *pb.FilterPurchase) (*pb.PurchaseL
 	return res, nil
 }
 
-// DeletePurchase удаляет покупку
+// VoidPurchase аннулирует закупку: списывает товары и сторнирует расходы в одной транзакции, строки закупки остаются
+func (p *PurchaseUseCase) VoidPurchase(in *pb.VoidPurchaseReq) (*pb.PurchaseResponse, error) {
+	if in == nil {
+		return nil, fmt.Errorf("void purchase request is nil")
+	}
+
+	purchaseID := &pb.PurchaseID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.PurchaseResponse
+	err := p.uow.Do(func(r *TxRepos) error {
+		// Возвраты поставщику тоже блокируют закупку, поэтому ни один не проскочит, пока она меняется
+		if err := r.Purchases.LockPurchase(in.Id); err != nil {
+			return err
+		}
+
+		// 1. Получаем информацию о покупке
+		purchase, err := r.Purchases.GetPurchase(purchaseID)
+		if err != nil {
+			return fmt.Errorf("error fetching purchase data: %w", err)
+		}
+		if purchase.Id == "" {
+			return fmt.Errorf("purchase not found")
+		}
+		if purchase.Status == entity.DocumentVoided {
+			return fmt.Errorf("purchase is already voided")
+		}
+		if err = rejectSupplierReturns(r, in.Id); err != nil {
+			return err
+		}
+
+		voidedBy := in.VoidedBy
+		if voidedBy == "" {
+			voidedBy = purchase.PurchasedBy
+		}
+
+		// 2. Сторнируем расходы закупки
+		_, err = r.CashFlow.ReverseBySource(entity.CashSourcePurchase, in.Id, voidedBy, "Product kirimi bekor qilindi")
+		if err != nil {
+			return fmt.Errorf("error reversing cash flow: %w", err)
+		}
+
+		// 3. Списываем товары со склада одним SQL-запросом
+		err = r.Product.RemoveProductsPurchase(purchase.Items, entity.MovementInfo{
+			Reason:    entity.MovementPurchase,
+			SourceID:  in.Id,
+			CreatedBy: voidedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("error removing purchased products from stock: %w", err)
+		}
+
+		// Серийные номера закупки должны быть на складе: проданную единицу сначала возвращают
+		for _, item := range purchase.Items {
+			err = r.Serials.SetSerialStatus(item.ProductId, item.Serials, entity.SerialInStock, entity.SerialVoided, entity.MovementInfo{
+				Reason:    entity.MovementPurchase,
+				SourceID:  in.Id,
+				CreatedBy: voidedBy,
+			})
+			if err != nil {
+				return fmt.Errorf("error voiding purchase serials: %w", err)
+			}
+		}
+
+		// 4. Аннулируем покупку
+		if err = r.Purchases.VoidPurchase(in); err != nil {
+			return fmt.Errorf("error voiding purchase: %w", err)
+		}
+
+		res, err = r.Purchases.GetPurchase(purchaseID)
+		if err != nil {
+			return fmt.Errorf("error fetching voided purchase: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		p.log.Error("Failed to void purchase", "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeletePurchase оставлен для старых клиентов: закупка аннулируется, а не удаляется
 func (p *PurchaseUseCase) DeletePurchase(req *pb.PurchaseID) (*pb.Message, error) {
 	if req == nil {
 		return nil, fmt.Errorf("purchase ID request is nil")
 	}
 
-	// 1. Получаем информацию о покупке
-	purchase, err := p.repo.GetPurchase(req)
+	_, err := p.VoidPurchase(&pb.VoidPurchaseReq{
+		Id:        req.Id,
+		CompanyId: req.CompanyId,
+		BranchId:  req.BranchId,
+		Reason:    "deleted",
+	})
 	if err != nil {
-		p.log.Error("Failed to fetch purchase data", "error", err)
-		return nil, fmt.Errorf("error fetching purchase data: %w", err)
+		return nil, err
 	}
 
-	// 2. Удаляем запись о cash flow
-	cashFlowRequest := &pb.CashFlowRequest{
-		UserId:        purchase.PurchasedBy,
-		Amount:        purchase.TotalCost,
-		Description:   fmt.Sprintf("Product kirimi ochirildi"),
-		PaymentMethod: purchase.PaymentMethod,
-		BranchId:      req.BranchId,
-		CompanyId:     req.CompanyId,
+	return &pb.Message{Message: "Purchase voided successfully"}, nil
+}
+
+// UpdatePurchaseItems заменяет позиции закупки в одной транзакции. Остатки меняются на разницу с сохранёнными позициями,
+// уменьшить приход ниже того, что ещё лежит на складе, нельзя. Изменение цены переоценивает остаток прихода закупки
+// (см. RecostPurchase), изменение суммы записывается одним расходом закупки
+func (p *PurchaseUseCase) UpdatePurchaseItems(in *pb.UpdatePurchaseItemsReq) (*pb.PurchaseResponse, error) {
+	if in == nil {
+		return nil, fmt.Errorf("update purchase items request is nil")
 	}
 
-	_, err = p.cash.CreateIncome(cashFlowRequest) // предполагаем наличие метода Delete в CashFlowRepo
+	purchaseID := &pb.PurchaseID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.PurchaseResponse
+	err := p.uow.Do(func(r *TxRepos) error {
+		// Возвраты поставщику тоже блокируют закупку, поэтому ни один не проскочит, пока она меняется
+		if err := r.Purchases.LockPurchase(in.Id); err != nil {
+			return err
+		}
+
+		purchase, err := r.Purchases.GetPurchase(purchaseID)
+		if err != nil {
+			return fmt.Errorf("error fetching purchase data: %w", err)
+		}
+		if purchase.Id == "" {
+			return fmt.Errorf("purchase not found")
+		}
+		if purchase.Status == entity.DocumentVoided {
+			return fmt.Errorf("purchase is voided")
+		}
+		if err = rejectReturnedPurchaseEdit(r, in.Id); err != nil {
+			return err
+		}
+
+		refs := make([]catalogRef, 0, len(in.Items))
+		for _, item := range in.Items {
+			refs = append(refs, catalogRef{catalogID: item.CatalogId, productID: &item.ProductId})
+		}
+		if err = resolveCatalogProducts(r.Product, in.CompanyId, in.BranchId, refs); err != nil {
+			return err
+		}
+
+		productIDs := make([]string, 0, len(purchase.Items)+len(in.Items))
+		for _, item := range purchase.Items {
+			productIDs = append(productIDs, item.ProductId)
+		}
+		for _, item := range in.Items {
+			productIDs = append(productIDs, item.ProductId)
+		}
+		if err = rejectSerialized(r.Serials, productIDs, "purchase"); err != nil {
+			return err
+		}
+
+		updatedBy := in.UpdatedBy
+		if updatedBy == "" {
+			updatedBy = purchase.PurchasedBy
+		}
+
+		items := &entity.Purchase{
+			SupplierID:    purchase.SupplierId,
+			PurchasedBy:   purchase.PurchasedBy,
+			PaymentMethod: purchase.PaymentMethod,
+			CompanyID:     in.CompanyId,
+			BranchID:      in.BranchId,
+		}
+		for _, item := range in.Items {
+			items.PurchaseItems = append(items.PurchaseItems, entity.PurchaseItem{
+				ProductID:     item.ProductId,
+				Quantity:      int(item.Quantity),
+				PurchasePrice: item.PurchasePrice,
+				Unit:          item.Unit,
+				UnitQuantity:  item.UnitQuantity,
+				LotNumber:     item.LotNumber,
+				ExpiresAt:     item.ExpiresAt,
+			})
+		}
+
+		req, err := p.calculateTotalPurchases(r.Product, items)
+		if err != nil {
+			return fmt.Errorf("error calculating total purchase cost: %w", err)
+		}
+		if len(req.PurchaseItems) == 0 {
+			return fmt.Errorf("purchase items are empty")
+		}
+
+		// Разница между новыми и сохранёнными позициями по каждому товару
+		deltas := make(map[string]int64)
+		var order []string
+		addDelta := func(productID string, quantity int64) {
+			if _, ok := deltas[productID]; !ok {
+				order = append(order, productID)
+			}
+			deltas[productID] += quantity
+		}
+		// Прирост прихода заводит партию по первой новой позиции товара
+		lots := make(map[string]*entity.StockLot)
+		for _, item := range req.PurchaseItems {
+			addDelta(item.ProductID, int64(item.Quantity))
+			if _, ok := lots[item.ProductID]; !ok {
+				lots[item.ProductID] = purchaseLot(item)
+			}
+		}
+		for _, item := range purchase.Items {
+			addDelta(item.ProductId, -int64(item.Quantity))
+		}
+
+		info := entity.MovementInfo{
+			Reason:    entity.MovementPurchase,
+			SourceID:  in.Id,
+			CreatedBy: updatedBy,
+		}
+
+		var reduced []entity.SalesItem
+		for _, id := range order {
+			d := deltas[id]
+			if d < 0 {
+				reduced = append(reduced, entity.SalesItem{ProductID: id, Quantity: -d})
+				continue
+			}
+			if d > 0 {
+				_, err := r.Product.AddProduct(&entity.CountProductReq{ID: id, Count: int(d), Movement: info, Lot: lots[id]})
+				if err != nil {
+					return fmt.Errorf("error adding product quantity for product %s: %w", id, err)
+				}
+			}
+		}
+
+		// Уменьшение прихода списывает товар, поэтому на складе его должно хватать
+		if len(reduced) > 0 {
+			shortages, err := r.Product.CheckStock(reduced)
+			if err != nil {
+				return fmt.Errorf("error checking product stock: %w", err)
+			}
+			if len(shortages) > 0 {
+				return &entity.ShortageError{Lines: shortages}
+			}
+			if err = r.Product.RemoveProducts(reduced, info); err != nil {
+				return fmt.Errorf("error removing purchased products from stock: %w", err)
+			}
+		}
+
+		if err = r.Purchases.ReplacePurchaseItems(in.Id, req); err != nil {
+			return fmt.Errorf("error replacing purchase items: %w", err)
+		}
+
+		// Изменение цены переоценивает то, что от закупки ещё лежит на складе, даже если количество не изменилось
+		if err = r.Product.RecostPurchase(changedPurchaseCosts(purchase.Items, req.PurchaseItems), info); err != nil {
+			return fmt.Errorf("error recosting purchase: %w", err)
+		}
+
+		// Разница суммы записывается одним расходом со знаком, чтобы итог расходов совпадал с закупкой
+		diff := decimal.NewFromFloat(req.TotalCost).Sub(decimal.NewFromFloat(purchase.TotalCost)).Round(2)
+		if !diff.IsZero() {
+			paymentType, err := resolvePaymentTypes(r.PaymentTypes, in.CompanyId, purchase.PaymentMethod, nil)
+			if err != nil {
+				return fmt.Errorf("error resolving payment types: %w", err)
+			}
+
+			cashFlow, err := r.CashFlow.CreateExpense(&pb.CashFlowRequest{
+				UserId:        updatedBy,
+				Amount:        diff.InexactFloat64(),
+				Description:   fmt.Sprintf("Purchase %s items changed", in.Id),
+				PaymentMethod: paymentType.Name,
+				CompanyId:     in.CompanyId,
+				BranchId:      in.BranchId,
+				SourceType:    entity.CashSourcePurchase,
+				SourceId:      in.Id,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating cash flow entry: %w", err)
+			}
+
+			if diff.IsPositive() {
+				err = r.Purchases.AddPurchasePayments(in.Id, []entity.Payment{{
+					Amount:        diff.InexactFloat64(),
+					PaymentMethod: paymentType.Name,
+					Currency:      paymentType.Currency,
+					CashFlowID:    cashFlow.Id,
+				}}, in.CompanyId, in.BranchId)
+			} else {
+				err = r.Purchases.ReducePurchasePayments(in.Id, diff.Neg().InexactFloat64())
+			}
+			if err != nil {
+				return fmt.Errorf("error adjusting purchase payments: %w", err)
+			}
+		}
+
+		res, err = r.Purchases.GetPurchase(purchaseID)
+		if err != nil {
+			return fmt.Errorf("error fetching updated purchase: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		p.log.Error("Failed to delete cash flow entry", "error", err)
-		return nil, fmt.Errorf("error deleting cash flow entry: %w", err)
+		p.log.Error("Failed to update purchase items", "purchaseID", in.Id, "error", err)
+		return nil, err
 	}
 
-	// 3. Возвращаем товары на склад (замена горутин на 1 SQL-запрос)
-	err = p.product.RemoveProductsPurchase(purchase.Items)
-	if err != nil {
-		p.log.Error("Error adding product quantity back to stock", "error", err)
-		return nil, fmt.Errorf("error adding product quantity back to stock: %w", err)
+	return res, nil
+}
+
+// changedPurchaseCosts цена закупки базовой единицы по товарам новых позиций, которая отличается от сохранённой.
+// Несколько позиций одного товара дают среднюю цену, взвешенную по количеству
+func changedPurchaseCosts(old []*pb.PurchaseItemResponse, items []entity.PurchaseItemReq) map[string]float64 {
+	type priced struct {
+		value    decimal.Decimal
+		quantity int64
+	}
+	add := func(prices map[string]*priced, productID string, quantity int64, price float64) {
+		p, ok := prices[productID]
+		if !ok {
+			p = &priced{}
+			prices[productID] = p
+		}
+		p.value = p.value.Add(decimal.NewFromInt(quantity).Mul(decimal.NewFromFloat(price)))
+		p.quantity += quantity
+	}
+	cost := func(p *priced) decimal.Decimal {
+		return p.value.Div(decimal.NewFromInt(p.quantity)).Round(6)
+	}
+
+	before := make(map[string]*priced)
+	for _, item := range old {
+		add(before, item.ProductId, int64(item.Quantity), item.PurchasePrice)
+	}
+	after := make(map[string]*priced)
+	for _, item := range items {
+		add(after, item.ProductID, int64(item.Quantity), item.PurchasePrice)
 	}
 
-	// 4. Удаляем покупку
-	res, err := p.repo.DeletePurchase(req)
+	costs := make(map[string]float64)
+	for productID, p := range after {
+		if b, ok := before[productID]; ok && cost(b).Equal(cost(p)) {
+			continue
+		}
+		costs[productID] = cost(p).InexactFloat64()
+	}
+	return costs
+}
+
+// rejectSupplierReturns запрещает аннулировать закупку, по которой есть действующие возвраты поставщику:
+// возвращённый товар уже списан со склада, поэтому сначала аннулируются возвраты
+func rejectSupplierReturns(r *TxRepos, purchaseID string) error {
+	returned, err := r.SupplierReturns.GetSupplierReturnedQuantities(purchaseID)
 	if err != nil {
-		p.log.Error("Failed to delete purchase", "error", err)
-		return nil, fmt.Errorf("error deleting purchase: %w", err)
+		return fmt.Errorf("error fetching supplier returns of purchase: %w", err)
 	}
+	if len(returned) > 0 {
+		return fmt.Errorf("purchase has supplier returns: void the supplier returns first")
+	}
+	return nil
+}
 
-	return res, nil
+// rejectReturnedPurchaseEdit запрещает менять позиции закупки, по которой оформлялись возвраты поставщику:
+// позиции возвратов, в том числе аннулированных, ссылаются на строки закупки
+func rejectReturnedPurchaseEdit(r *TxRepos, purchaseID string) error {
+	hasReturns, err := r.SupplierReturns.HasSupplierReturns(purchaseID)
+	if err != nil {
+		return fmt.Errorf("error fetching supplier returns of purchase: %w", err)
+	}
+	if hasReturns {
+		return fmt.Errorf("purchase with supplier returns cannot be edited: void the purchase and enter it again")
+	}
+	return nil
 }
 
 // validatePurchaseItems проверяет корректность элементов покупки
@@ -258,18 +716,400 @@ func (p *PurchaseUseCase) validatePurchaseItems(purchase *pb.PurchaseResponse) e
 
 // --------------------------------------------------- Transfers Func --------------------------------------------------------------
 
+// CreateTransfers сохраняет черновик перемещения. С флагом dispatch товар сразу списывается с исходного филиала
 func (p *PurchaseUseCase) CreateTransfers(in *pb.TransferReq) (*pb.Transfer, error) {
+	// Позиция может ссылаться на карточку каталога: перемещается её строка в филиале-источнике
+	refs := make([]catalogRef, 0, len(in.Products))
+	for _, product := range in.Products {
+		refs = append(refs, catalogRef{catalogID: product.CatalogId, productID: &product.ProductId})
+	}
+	if err := resolveCatalogProducts(p.product, in.CompanyId, in.FromBranchId, refs); err != nil {
+		p.log.Error("Failed to resolve transfer catalog items", "error", err)
+		return nil, err
+	}
+
+	if err := p.transferUnits(in); err != nil {
+		p.log.Error("Failed to convert transfer units", "error", err)
+		return nil, err
+	}
+
+	var res *pb.Transfer
+	err := p.uow.Do(func(r *TxRepos) error {
+		// Серийный товар перемещается конкретными номерами, по одному на единицу
+		productIDs := make([]string, 0, len(in.Products))
+		for _, product := range in.Products {
+			productIDs = append(productIDs, product.ProductId)
+		}
+		serialized, err := serializedProducts(r.Serials, productIDs)
+		if err != nil {
+			return err
+		}
+		for _, product := range in.Products {
+			product.Serials, err = normalizeSerials(serialized, product.ProductId, product.ProductQuantity, product.Serials)
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err = r.Purchases.CreateTransfers(in)
+		if err != nil {
+			return fmt.Errorf("error creating transfers - 1: %w", err)
+		}
+
+		if !in.Dispatch {
+			return nil
+		}
+
+		res, err = p.dispatchTransfer(r, &pb.TransferStatusReq{Id: res.Id, CompanyId: in.CompanyId, UserId: in.TransferredBy})
+		if err != nil {
+			return fmt.Errorf("error creating transfers - 2: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		p.log.Error("Failed to create transfers", "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// transferUnits переводит позиции перемещения, введённые в единицах товара, в базовые единицы
+func (p *PurchaseUseCase) transferUnits(in *pb.TransferReq) error {
+	var unitProducts []string
+	for _, product := range in.Products {
+		if product.Unit != "" {
+			unitProducts = append(unitProducts, product.ProductId)
+		}
+	}
+	factors, err := unitFactors(p.product, unitProducts)
+	if err != nil {
+		return err
+	}
+
+	for _, product := range in.Products {
+		if product.Unit == "" {
+			continue
+		}
+		product.ProductQuantity, _, err = toBaseUnits(factors, product.ProductId, product.Unit, product.UnitQuantity, 0)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transferStock собирает запрос на движение товаров перемещения с количеством по каждой позиции
+func transferStock(t *pb.Transfer, userID string, quantity func(line *pb.TransfersProducts) int64) *pb.TransferReq {
+	req := &pb.TransferReq{
+		TransferredBy: userID,
+		FromBranchId:  t.FromBranchId,
+		ToBranchId:    t.ToBranchId,
+		CompanyId:     t.CompanyId,
+	}
+	if req.TransferredBy == "" {
+		req.TransferredBy = t.TransferredBy
+	}
+
+	for _, line := range t.Products {
+		if q := quantity(line); q > 0 {
+			req.Products = append(req.Products, &pb.TransfersProductsReq{ProductId: line.ProductId, ProductQuantity: q})
+		}
+	}
+	return req
+}
+
+func sentQuantity(line *pb.TransfersProducts) int64 {
+	return line.ProductQuantity
+}
+
+// dispatchTransfer списывает товар с исходного филиала внутри уже открытой транзакции
+func (p *PurchaseUseCase) dispatchTransfer(r *TxRepos, in *pb.TransferStatusReq) (*pb.Transfer, error) {
+	transferID := &pb.TransferID{Id: in.Id, CompanyId: in.CompanyId}
+
+	transfer, err := r.Purchases.GetTransfers(transferID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching transfer: %w", err)
+	}
+
+	if err = r.Purchases.SetTransferStatus(in, entity.TransferDispatched, entity.TransferDraft); err != nil {
+		return nil, err
+	}
+
+	stock := transferStock(transfer, in.UserId, sentQuantity)
+	if err = r.Product.TransferOut(stock, in.Id); err != nil {
+		return nil, fmt.Errorf("error removing products from source branch: %w", err)
+	}
+
+	// Отправленные серийные номера в пути, пока их не примут или не отменят перемещение
+	for _, line := range transfer.Products {
+		err = r.Serials.SetSerialStatus(line.ProductId, line.Serials, entity.SerialInStock, entity.SerialInTransit, entity.MovementInfo{
+			Reason:    entity.MovementTransferOut,
+			SourceID:  in.Id,
+			CreatedBy: stock.TransferredBy,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error dispatching serials: %w", err)
+		}
+	}
+
+	return r.Purchases.GetTransfers(transferID)
+}
+
+// DispatchTransfer отправляет черновик перемещения: товар уходит с исходного филиала
+func (p *PurchaseUseCase) DispatchTransfer(in *pb.TransferStatusReq) (*pb.Transfer, error) {
+	if in == nil {
+		return nil, fmt.Errorf("transfer status request is nil")
+	}
+
+	var res *pb.Transfer
+	err := p.uow.Do(func(r *TxRepos) error {
+		var err error
+		res, err = p.dispatchTransfer(r, in)
+		return err
+	})
+	if err != nil {
+		p.log.Error("Failed to dispatch transfer", "transferID", in.Id, "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MarkTransferInTransit отмечает, что отправленный товар в пути. Остатки не меняются
+func (p *PurchaseUseCase) MarkTransferInTransit(in *pb.TransferStatusReq) (*pb.Transfer, error) {
+	if in == nil {
+		return nil, fmt.Errorf("transfer status request is nil")
+	}
+
+	if err := p.repo.SetTransferStatus(in, entity.TransferInTransit, entity.TransferDispatched); err != nil {
+		p.log.Error("Failed to mark transfer in transit", "transferID", in.Id, "error", err)
+		return nil, err
+	}
+
+	return p.GetTransfers(&pb.TransferID{Id: in.Id, CompanyId: in.CompanyId})
+}
+
+// ReceiveTransfer приходует товар в целевом филиале. Если пришло меньше отправленного,
+// перемещение становится частично полученным, а разница остаётся в позициях как расхождение
+func (p *PurchaseUseCase) ReceiveTransfer(in *pb.ReceiveTransferReq) (*pb.Transfer, error) {
+	if in == nil {
+		return nil, fmt.Errorf("receive transfer request is nil")
+	}
+
+	transferID := &pb.TransferID{Id: in.Id, CompanyId: in.CompanyId}
+
+	var res *pb.Transfer
+	err := p.uow.Do(func(r *TxRepos) error {
+		transfer, err := r.Purchases.GetTransfers(transferID)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer: %w", err)
+		}
+
+		lines := make(map[string]*pb.TransfersProducts, len(transfer.Products))
+		for _, line := range transfer.Products {
+			lines[line.Id] = line
+		}
+
+		received := make(map[string]int64, len(in.Items))
+		receivedSerials := make(map[string][]string, len(in.Items))
+		var items []entity.ReceivedTransferItem
+		for _, item := range in.Items {
+			if item.ReceivedQuantity < 0 {
+				return fmt.Errorf("invalid received quantity for transfer line %v: must not be negative", item.TransferProductId)
+			}
+			if _, ok := received[item.TransferProductId]; ok {
+				return fmt.Errorf("transfer line %v is listed twice", item.TransferProductId)
+			}
+
+			// Частично принятая серийная позиция называет пришедшие номера, остальные списываются с недостачей
+			var serials []string
+			if line, ok := lines[item.TransferProductId]; ok && item.ReceivedQuantity > 0 {
+				serials, err = pickLineSerials(line.ProductId, line.Serials, "transfer line "+line.Id, item.ReceivedQuantity, 0, item.Serials)
+				if err != nil {
+					return err
+				}
+			}
+
+			received[item.TransferProductId] = item.ReceivedQuantity
+			receivedSerials[item.TransferProductId] = serials
+			items = append(items, entity.ReceivedTransferItem{
+				TransferProductID: item.TransferProductId,
+				Quantity:          item.ReceivedQuantity,
+				Note:              item.Note,
+				Serials:           serials,
+			})
+		}
+
+		// Количество и состояние проверяются в базе: принять больше отправленного или неотправленное перемещение нельзя
+		if _, err = r.Purchases.ReceiveTransferItems(in, items); err != nil {
+			return fmt.Errorf("error receiving transfer lines: %w", err)
+		}
+
+		stock := transferStock(transfer, in.ReceivedBy, func(line *pb.TransfersProducts) int64 {
+			if q, ok := received[line.Id]; ok {
+				return q
+			}
+			return line.ProductQuantity
+		})
+		if len(stock.Products) > 0 {
+			if err = r.Product.TransferIn(stock, in.Id); err != nil {
+				return fmt.Errorf("error adding products to target branch: %w", err)
+			}
+		}
+
+		for _, line := range transfer.Products {
+			serials, ok := receivedSerials[line.Id]
+			if !ok {
+				serials = line.Serials
+			}
+			err = r.Serials.MoveSerials(line.ProductId, transfer.ToBranchId, serials, entity.MovementInfo{
+				Reason:    entity.MovementTransferIn,
+				SourceID:  in.Id,
+				CreatedBy: stock.TransferredBy,
+			})
+			if err != nil {
+				return fmt.Errorf("error receiving serials in target branch: %w", err)
+			}
+		}
+
+		if err = writeOffShortfall(r, transfer, received, receivedSerials, stock.TransferredBy); err != nil {
+			return err
+		}
+
+		res, err = r.Purchases.GetTransfers(transferID)
+		return err
+	})
+	if err != nil {
+		p.log.Error("Failed to receive transfer", "transferID", in.Id, "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
 
-	err := p.product.TransferProducts(in)
+// writeOffShortfall списывает недостачу частично принятого перемещения. Непришедший товар возвращается на исходный
+// филиал по себестоимости отправки и сразу списывается документом потерь, который его оценивает; непришедшие серийные
+// номера списываются вместе с ним
+func writeOffShortfall(r *TxRepos, transfer *pb.Transfer, received map[string]int64, receivedSerials map[string][]string, userID string) error {
+	adjustment := &entity.StockAdjustmentRequest{
+		Reason:      entity.AdjustmentLoss,
+		Description: fmt.Sprintf("Transfer %s shortfall", transfer.Id),
+		CreatedBy:   userID,
+		CompanyID:   transfer.CompanyId,
+		BranchID:    transfer.FromBranchId,
+	}
+	info := entity.MovementInfo{
+		Reason:    entity.MovementTransferIn,
+		SourceID:  transfer.Id,
+		CreatedBy: userID,
+	}
+
+	for _, line := range transfer.Products {
+		q, ok := received[line.Id]
+		if !ok || q >= line.ProductQuantity {
+			continue
+		}
+
+		arrived := make(map[string]bool, len(receivedSerials[line.Id]))
+		for _, serial := range receivedSerials[line.Id] {
+			arrived[serial] = true
+		}
+		var lost []string
+		for _, serial := range line.Serials {
+			if !arrived[serial] {
+				lost = append(lost, serial)
+			}
+		}
+
+		_, err := r.Product.AddProduct(&entity.CountProductReq{ID: line.ProductId, Count: int(line.ProductQuantity - q), Movement: info})
+		if err != nil {
+			return fmt.Errorf("error returning shortfall to source branch: %w", err)
+		}
+		if err = r.Serials.SetSerialStatus(line.ProductId, lost, entity.SerialInTransit, entity.SerialInStock, info); err != nil {
+			return fmt.Errorf("error returning shortfall serials to source branch: %w", err)
+		}
+
+		adjustment.Items = append(adjustment.Items, entity.StockAdjustment{ProductID: line.ProductId, Delta: q - line.ProductQuantity, Serials: lost})
+	}
+
+	if len(adjustment.Items) == 0 {
+		return nil
+	}
+
+	res, err := recordAdjustment(r, adjustment)
 	if err != nil {
-		p.log.Error("Failed to create transfers - 1", "error", err)
-		return nil, fmt.Errorf("error creating transfers - 1: %w", err)
+		return fmt.Errorf("error writing off transfer shortfall: %w", err)
+	}
+	if err = r.Purchases.SetTransferShortfall(transfer.Id, res.Id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CancelTransfer отменяет перемещение, которое ещё не получено. Отправленный товар возвращается на исходный филиал
+func (p *PurchaseUseCase) CancelTransfer(in *pb.TransferStatusReq) (*pb.Transfer, error) {
+	if in == nil {
+		return nil, fmt.Errorf("transfer status request is nil")
 	}
 
-	res, err := p.repo.CreateTransfers(in)
+	transferID := &pb.TransferID{Id: in.Id, CompanyId: in.CompanyId}
+
+	var res *pb.Transfer
+	err := p.uow.Do(func(r *TxRepos) error {
+		transfer, err := r.Purchases.GetTransfers(transferID)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer: %w", err)
+		}
+
+		switch transfer.Status {
+		case entity.TransferDraft, entity.TransferDispatched, entity.TransferInTransit:
+		default:
+			return fmt.Errorf("transfer is %s and cannot be cancelled", transfer.Status)
+		}
+
+		// Переход только из прочитанного состояния: параллельная отправка не останется без возврата товара
+		if err = r.Purchases.SetTransferStatus(in, entity.TransferCancelled, transfer.Status); err != nil {
+			return err
+		}
+
+		if transfer.Status != entity.TransferDraft {
+			userID := in.UserId
+			if userID == "" {
+				userID = transfer.TransferredBy
+			}
+			for _, line := range transfer.Products {
+				_, err := r.Product.AddProduct(&entity.CountProductReq{
+					ID:    line.ProductId,
+					Count: int(line.ProductQuantity),
+					Movement: entity.MovementInfo{
+						Reason:    entity.MovementTransferIn,
+						SourceID:  in.Id,
+						CreatedBy: userID,
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("error returning products to source branch: %w", err)
+				}
+				err = r.Serials.SetSerialStatus(line.ProductId, line.Serials, entity.SerialInTransit, entity.SerialInStock, entity.MovementInfo{
+					Reason:    entity.MovementTransferIn,
+					SourceID:  in.Id,
+					CreatedBy: userID,
+				})
+				if err != nil {
+					return fmt.Errorf("error returning serials to source branch: %w", err)
+				}
+			}
+		}
+
+		res, err = r.Purchases.GetTransfers(transferID)
+		return err
+	})
 	if err != nil {
-		p.log.Error("Failed to create transfers - 2", "error", err)
-		return nil, fmt.Errorf("error creating transfers - 2: %w", err)
+		p.log.Error("Failed to cancel transfer", "transferID", in.Id, "error", err)
+		return nil, err
 	}
 
 	return res, nil
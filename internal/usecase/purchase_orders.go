@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"time"
+)
+
+type PurchaseOrdersUseCase struct {
+	repo     PurchaseOrdersRepo
+	purchase *PurchaseUseCase
+	uow      UnitOfWork
+	log      *slog.Logger
+}
+
+func NewPurchaseOrdersUseCase(repo PurchaseOrdersRepo, purchase *PurchaseUseCase, log *slog.Logger, uow UnitOfWork) *PurchaseOrdersUseCase {
+	return &PurchaseOrdersUseCase{
+		repo:     repo,
+		purchase: purchase,
+		uow:      uow,
+		log:      log,
+	}
+}
+
+// calculateOrder prices the order lines and returns them with the order total.
+func calculateOrder(in []*pb.PurchaseOrderItemReq) ([]entity.PurchaseItemReq, float64, error) {
+	var total decimal.Decimal
+	items := make([]entity.PurchaseItemReq, 0, len(in))
+
+	for _, item := range in {
+		if item.Quantity <= 0 {
+			return nil, 0, fmt.Errorf("invalid quantity for product %v: must be positive", item.ProductId)
+		}
+		if item.PurchasePrice < 0 {
+			return nil, 0, fmt.Errorf("invalid purchase price for product %v: must not be negative", item.ProductId)
+		}
+
+		totalPrice := decimal.NewFromInt(int64(item.Quantity)).Mul(decimal.NewFromFloat(item.PurchasePrice)).Round(2)
+		total = total.Add(totalPrice)
+
+		items = append(items, entity.PurchaseItemReq{
+			ProductID:     item.ProductId,
+			Quantity:      int(item.Quantity),
+			PurchasePrice: item.PurchasePrice,
+			TotalPrice:    totalPrice.InexactFloat64(),
+		})
+	}
+
+	return items, total.Round(2).InexactFloat64(), nil
+}
+
+// validateExpectedAt checks the expected delivery date, which may be left empty.
+func validateExpectedAt(expectedAt string) error {
+	if expectedAt == "" {
+		return nil
+	}
+	if _, err := time.Parse("2006-01-02", expectedAt); err != nil {
+		return fmt.Errorf("invalid expected_at %q: want YYYY-MM-DD", expectedAt)
+	}
+	return nil
+}
+
+// CreatePurchaseOrder saves a draft order. Nothing reaches the stock or the cash flow until the order is received.
+func (o *PurchaseOrdersUseCase) CreatePurchaseOrder(in *pb.PurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if in == nil {
+		return nil, errors.New("purchase order request is nil")
+	}
+	if len(in.Items) == 0 {
+		return nil, errors.New("purchase order items are empty")
+	}
+	if err := validateExpectedAt(in.ExpectedAt); err != nil {
+		return nil, err
+	}
+
+	items, total, err := calculateOrder(in.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.repo.CreatePurchaseOrder(&entity.PurchaseOrderRequest{
+		SupplierID:  in.SupplierId,
+		OrderedBy:   in.OrderedBy,
+		ExpectedAt:  in.ExpectedAt,
+		Description: in.Description,
+		TotalCost:   total,
+		CompanyID:   in.CompanyId,
+		BranchID:    in.BranchId,
+		Items:       items,
+	})
+	if err != nil {
+		o.log.Error("Error creating purchase order", "error", err)
+		return nil, fmt.Errorf("error creating purchase order: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetPurchaseOrder retrieves a purchase order with its lines.
+func (o *PurchaseOrdersUseCase) GetPurchaseOrder(in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+	if in == nil {
+		return nil, errors.New("purchase order ID request is nil")
+	}
+
+	res, err := o.repo.GetPurchaseOrder(in)
+	if err != nil {
+		o.log.Error("Error fetching purchase order", "orderID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching purchase order: %w", err)
+	}
+	return res, nil
+}
+
+// GetListPurchaseOrders retrieves purchase orders based on filters.
+func (o *PurchaseOrdersUseCase) GetListPurchaseOrders(in *pb.PurchaseOrderFilter) (*pb.PurchaseOrderList, error) {
+	if in == nil {
+		return nil, errors.New("purchase order filter request is nil")
+	}
+
+	res, err := o.repo.GetPurchaseOrderList(in)
+	if err != nil {
+		o.log.Error("Error fetching purchase orders list", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching purchase orders list: %w", err)
+	}
+	return res, nil
+}
+
+// UpdatePurchaseOrder changes a draft order. Once sent, the order is only received or cancelled.
+func (o *PurchaseOrdersUseCase) UpdatePurchaseOrder(in *pb.PurchaseOrderUpdate) (*pb.PurchaseOrder, error) {
+	if in == nil {
+		return nil, errors.New("purchase order update is nil")
+	}
+	if err := validateExpectedAt(in.ExpectedAt); err != nil {
+		return nil, err
+	}
+
+	req := &entity.PurchaseOrderRequest{
+		ExpectedAt:  in.ExpectedAt,
+		Description: in.Description,
+		CompanyID:   in.CompanyId,
+		BranchID:    in.BranchId,
+	}
+	if len(in.Items) > 0 {
+		items, total, err := calculateOrder(in.Items)
+		if err != nil {
+			return nil, err
+		}
+		req.Items = items
+		req.TotalCost = total
+	}
+
+	res, err := o.repo.UpdatePurchaseOrder(in.Id, req)
+	if err != nil {
+		o.log.Error("Error updating purchase order", "orderID", in.Id, "error", err)
+		return nil, fmt.Errorf("error updating purchase order: %w", err)
+	}
+	return res, nil
+}
+
+// SendPurchaseOrder marks a draft as sent to the supplier, which opens it for receiving.
+func (o *PurchaseOrdersUseCase) SendPurchaseOrder(in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+	return o.setStatus(in, entity.OrderSent, entity.OrderDraft)
+}
+
+// CancelPurchaseOrder closes an order that is not fully received. Purchases already received from it stay.
+func (o *PurchaseOrdersUseCase) CancelPurchaseOrder(in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+	return o.setStatus(in, entity.OrderCancelled, entity.OrderDraft, entity.OrderSent, entity.OrderPartiallyReceived)
+}
+
+func (o *PurchaseOrdersUseCase) setStatus(in *pb.PurchaseOrderID, status string, from ...string) (*pb.PurchaseOrder, error) {
+	if in == nil {
+		return nil, errors.New("purchase order ID request is nil")
+	}
+
+	if err := o.repo.SetPurchaseOrderStatus(in, status, from...); err != nil {
+		o.log.Error("Error changing purchase order status", "orderID", in.Id, "status", status, "error", err)
+		return nil, fmt.Errorf("error changing purchase order status: %w", err)
+	}
+
+	return o.GetPurchaseOrder(in)
+}
+
+// ReceivePurchaseOrder creates a purchase for the received quantities only and moves the order
+// to partially_received or received. An empty item list receives everything still outstanding;
+// serialized products have to be listed with the serials of the received units.
+func (o *PurchaseOrdersUseCase) ReceivePurchaseOrder(in *pb.ReceivePurchaseOrderReq) (*pb.PurchaseResponse, error) {
+	if in == nil {
+		return nil, errors.New("receive purchase order request is nil")
+	}
+
+	orderID := &pb.PurchaseOrderID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.PurchaseResponse
+	err := o.uow.Do(func(r *TxRepos) error {
+		order, err := r.PurchaseOrders.GetPurchaseOrder(orderID)
+		if err != nil {
+			return fmt.Errorf("error fetching purchase order: %w", err)
+		}
+		if order.Status != entity.OrderSent && order.Status != entity.OrderPartiallyReceived {
+			return fmt.Errorf("purchase order is %s: only sent orders can be received", order.Status)
+		}
+
+		orderItems := make(map[string]*pb.PurchaseOrderItem, len(order.Items))
+		for _, item := range order.Items {
+			orderItems[item.Id] = item
+		}
+
+		requested := in.Items
+		if len(requested) == 0 {
+			for _, item := range order.Items {
+				if left := item.Quantity - item.ReceivedQuantity; left > 0 {
+					requested = append(requested, &pb.ReceiveOrderItem{OrderItemId: item.Id, Quantity: left})
+				}
+			}
+		}
+		if len(requested) == 0 {
+			return errors.New("nothing left to receive on this order")
+		}
+
+		purchase := &entity.Purchase{
+			SupplierID:      order.SupplierId,
+			PurchasedBy:     in.ReceivedBy,
+			Description:     in.Description,
+			PaymentMethod:   in.PaymentMethod,
+			CompanyID:       in.CompanyId,
+			BranchID:        in.BranchId,
+			Payments:        paymentsFromPb(in.Payments),
+			PurchaseOrderID: in.Id,
+		}
+		var unitProducts []string
+		for _, item := range requested {
+			if orderItem, ok := orderItems[item.OrderItemId]; ok && item.Unit != "" {
+				unitProducts = append(unitProducts, orderItem.ProductId)
+			}
+		}
+		factors, err := unitFactors(r.Product, unitProducts)
+		if err != nil {
+			return err
+		}
+
+		var received []entity.ReceivedOrderItem
+		for _, item := range requested {
+			orderItem, ok := orderItems[item.OrderItemId]
+			if !ok {
+				return fmt.Errorf("order item %v does not belong to purchase order %v", item.OrderItemId, in.Id)
+			}
+
+			// The order counts base units; a line received in a unit is priced per that unit
+			quantity := int64(item.Quantity)
+			price := item.PurchasePrice
+			if item.Unit != "" {
+				if quantity, _, err = toBaseUnits(factors, orderItem.ProductId, item.Unit, item.UnitQuantity, 0); err != nil {
+					return err
+				}
+				if price == 0 {
+					price = orderItem.PurchasePrice * float64(quantity) / item.UnitQuantity
+				}
+			}
+			if quantity <= 0 {
+				return fmt.Errorf("invalid received quantity for order item %v: must be positive", item.OrderItemId)
+			}
+			if price == 0 {
+				price = orderItem.PurchasePrice
+			}
+
+			purchase.PurchaseItems = append(purchase.PurchaseItems, entity.PurchaseItem{
+				ProductID:     orderItem.ProductId,
+				Quantity:      int(quantity),
+				PurchasePrice: price,
+				Unit:          item.Unit,
+				UnitQuantity:  item.UnitQuantity,
+				LotNumber:     item.LotNumber,
+				ExpiresAt:     item.ExpiresAt,
+				Serials:       item.Serials,
+			})
+			received = append(received, entity.ReceivedOrderItem{OrderItemID: item.OrderItemId, Quantity: int(quantity)})
+		}
+		if purchase.PurchasedBy == "" {
+			purchase.PurchasedBy = order.OrderedBy
+		}
+		if purchase.Description == "" {
+			purchase.Description = order.Description
+		}
+
+		// The conditional update refuses to receive more than was ordered, even for concurrent receipts
+		if err = r.PurchaseOrders.ReceivePurchaseOrderItems(orderID, received); err != nil {
+			return fmt.Errorf("error receiving purchase order items: %w", err)
+		}
+
+		req, err := o.purchase.calculateTotalPurchases(r.Product, purchase)
+		if err != nil {
+			return fmt.Errorf("error calculating total purchase cost: %w", err)
+		}
+
+		res, err = o.purchase.createPurchase(r, req)
+		return err
+	})
+	if err != nil {
+		o.log.Error("Error receiving purchase order", "orderID", in.Id, "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOutstandingPurchaseOrders reports what is still expected from every supplier on open orders.
+func (o *PurchaseOrdersUseCase) GetOutstandingPurchaseOrders(in *pb.OutstandingOrdersReq) (*pb.OutstandingOrdersList, error) {
+	if in == nil {
+		return nil, errors.New("outstanding orders request is nil")
+	}
+
+	res, err := o.repo.GetOutstandingPurchaseOrders(in)
+	if err != nil {
+		o.log.Error("Error fetching outstanding purchase orders", "error", err)
+		return nil, fmt.Errorf("error fetching outstanding purchase orders: %w", err)
+	}
+	return res, nil
+}
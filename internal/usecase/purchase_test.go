@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"reflect"
+	"testing"
+)
+
+func TestChangedPurchaseCosts(t *testing.T) {
+	tests := []struct {
+		name  string
+		old   []*pb.PurchaseItemResponse
+		items []entity.PurchaseItemReq
+		want  map[string]float64
+	}{
+		{
+			name:  "price changed, quantity kept",
+			old:   []*pb.PurchaseItemResponse{{ProductId: "p", Quantity: 10, PurchasePrice: 100}},
+			items: []entity.PurchaseItemReq{{ProductID: "p", Quantity: 10, PurchasePrice: 120}},
+			want:  map[string]float64{"p": 120},
+		},
+		{
+			name:  "only quantity changed",
+			old:   []*pb.PurchaseItemResponse{{ProductId: "p", Quantity: 10, PurchasePrice: 100}},
+			items: []entity.PurchaseItemReq{{ProductID: "p", Quantity: 4, PurchasePrice: 100}},
+			want:  map[string]float64{},
+		},
+		{
+			name: "lines of one product weighted by quantity",
+			old:  []*pb.PurchaseItemResponse{{ProductId: "p", Quantity: 4, PurchasePrice: 100}},
+			items: []entity.PurchaseItemReq{
+				{ProductID: "p", Quantity: 1, PurchasePrice: 100},
+				{ProductID: "p", Quantity: 3, PurchasePrice: 120},
+			},
+			want: map[string]float64{"p": 115},
+		},
+		{
+			name:  "new product",
+			old:   []*pb.PurchaseItemResponse{{ProductId: "p", Quantity: 2, PurchasePrice: 100}},
+			items: []entity.PurchaseItemReq{{ProductID: "p", Quantity: 2, PurchasePrice: 100}, {ProductID: "q", Quantity: 1, PurchasePrice: 50}},
+			want:  map[string]float64{"q": 50},
+		},
+		{
+			name:  "removed product",
+			old:   []*pb.PurchaseItemResponse{{ProductId: "p", Quantity: 2, PurchasePrice: 100}, {ProductId: "q", Quantity: 1, PurchasePrice: 50}},
+			items: []entity.PurchaseItemReq{{ProductID: "p", Quantity: 2, PurchasePrice: 100}},
+			want:  map[string]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedPurchaseCosts(tt.old, tt.items); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedPurchaseCosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
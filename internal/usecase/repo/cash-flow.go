@@ -10,27 +10,36 @@ import (
 )
 
 type cashFlow struct {
-	db *sqlx.DB
+	db dbtx
 }
 
 func NewCashFlow(db *sqlx.DB) usecase.CashFlowRepo {
 	return &cashFlow{db: db}
 }
 
+// cashFlowColumns колонки записи денежного потока в порядке cashFlowDest
+var cashFlowColumns = `id, user_id, transaction_date, amount, transaction_type, description, ` + paymentTypeName("payment_type_id") + `,
+		company_id, branch_id, COALESCE(source_type, ''), COALESCE(source_id::TEXT, ''), COALESCE(reversal_of::TEXT, '')`
+
+func cashFlowDest(cf *pb.CashFlow) []interface{} {
+	return []interface{}{&cf.Id, &cf.UserId, &cf.TransactionDate, &cf.Amount, &cf.TransactionType, &cf.Description,
+		&cf.PaymentMethod, &cf.CompanyId, &cf.BranchId, &cf.SourceType, &cf.SourceId, &cf.ReversalOf}
+}
+
 // CreateIncome создает запись о доходе
 func (c *cashFlow) CreateIncome(in *pb.CashFlowRequest) (*pb.CashFlow, error) {
 	id := uuid.NewString()
 
 	// SQL-запрос для записи о доходе
-	query := `
-		INSERT INTO cash_flow (id, user_id, amount, transaction_type, description, payment_method, company_id, branch_id)
-		VALUES ($1, $2, $3, 'income', $4, $5, $6, $7)
-		RETURNING id, user_id, transaction_date, amount, transaction_type, description, payment_method, company_id, branch_id
-	`
+	query := fmt.Sprintf(`
+		INSERT INTO cash_flow (id, user_id, amount, transaction_type, description, payment_type_id, company_id, branch_id, source_type, source_id)
+		VALUES ($1, $2, $3, 'income', $4, %s, $6, $7, NULLIF($8, ''), NULLIF($9, '')::UUID)
+		RETURNING %s
+	`, paymentTypeID("$6", "$5"), cashFlowColumns)
 
 	var cashFlow pb.CashFlow
-	err := c.db.QueryRowx(query, id, in.UserId, in.Amount, in.Description, in.PaymentMethod, in.CompanyId, in.BranchId).
-		Scan(&cashFlow.Id, &cashFlow.UserId, &cashFlow.TransactionDate, &cashFlow.Amount, &cashFlow.TransactionType, &cashFlow.Description, &cashFlow.PaymentMethod, &cashFlow.CompanyId, &cashFlow.BranchId)
+	err := c.db.QueryRowx(query, id, in.UserId, in.Amount, in.Description, in.PaymentMethod, in.CompanyId, in.BranchId, in.SourceType, in.SourceId).
+		Scan(cashFlowDest(&cashFlow)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create income: %w", err)
 	}
@@ -43,15 +52,15 @@ func (c *cashFlow) CreateExpense(in *pb.CashFlowRequest) (*pb.CashFlow, error) {
 	id := uuid.NewString()
 
 	// SQL-запрос для записи о расходе
-	query := `
-		INSERT INTO cash_flow (id, user_id, amount, transaction_type, description, payment_method, company_id, branch_id)
-		VALUES ($1, $2, $3, 'expense', $4, $5, $6, $7)
-		RETURNING id, user_id, transaction_date, amount, transaction_type, description, payment_method, company_id, branch_id
-	`
+	query := fmt.Sprintf(`
+		INSERT INTO cash_flow (id, user_id, amount, transaction_type, description, payment_type_id, company_id, branch_id, source_type, source_id)
+		VALUES ($1, $2, $3, 'expense', $4, %s, $6, $7, NULLIF($8, ''), NULLIF($9, '')::UUID)
+		RETURNING %s
+	`, paymentTypeID("$6", "$5"), cashFlowColumns)
 
 	var cashFlow pb.CashFlow
-	err := c.db.QueryRowx(query, id, in.UserId, in.Amount, in.Description, in.PaymentMethod, in.CompanyId, in.BranchId).
-		Scan(&cashFlow.Id, &cashFlow.UserId, &cashFlow.TransactionDate, &cashFlow.Amount, &cashFlow.TransactionType, &cashFlow.Description, &cashFlow.PaymentMethod, &cashFlow.CompanyId, &cashFlow.BranchId)
+	err := c.db.QueryRowx(query, id, in.UserId, in.Amount, in.Description, in.PaymentMethod, in.CompanyId, in.BranchId, in.SourceType, in.SourceId).
+		Scan(cashFlowDest(&cashFlow)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create expense: %w", err)
 	}
@@ -61,15 +70,15 @@ func (c *cashFlow) CreateExpense(in *pb.CashFlowRequest) (*pb.CashFlow, error) {
 
 func (c *cashFlow) Get(in *pb.CashFlowReq) (*pb.ListCashFlow, error) {
 	// Базовый запрос с обязательными фильтрами и оконной функцией для подсчёта общего количества
-	query := `
+	query := fmt.Sprintf(`
 		SELECT 
-			id, user_id, transaction_date, amount, transaction_type, description, payment_method, company_id, branch_id,
+			%s,
 			COUNT(*) OVER() AS total_count
 		FROM cash_flow
 		WHERE company_id = $1 
 		  AND branch_id = $2
 		  AND transaction_date BETWEEN $3 AND $4
-	`
+	`, cashFlowColumns)
 	args := []interface{}{in.CompanyId, in.BranchId, in.StartDate, in.EndDate}
 	index := 5
 
@@ -85,10 +94,20 @@ func (c *cashFlow) Get(in *pb.CashFlowReq) (*pb.ListCashFlow, error) {
 		index++
 	}
 	if in.PaymentMethod != "" {
-		query += fmt.Sprintf(" AND payment_method = $%d", index)
+		query += fmt.Sprintf(" AND payment_type_id = %s", paymentTypeID("$1", fmt.Sprintf("$%d", index)))
 		args = append(args, in.PaymentMethod)
 		index++
 	}
+	if in.SourceType != "" {
+		query += fmt.Sprintf(" AND source_type = $%d", index)
+		args = append(args, in.SourceType)
+		index++
+	}
+	if in.SourceId != "" {
+		query += fmt.Sprintf(" AND source_id = $%d", index)
+		args = append(args, in.SourceId)
+		index++
+	}
 
 	query += " ORDER BY transaction_date DESC"
 	if in.Limit > 0 && in.Page > 0 {
@@ -109,18 +128,7 @@ func (c *cashFlow) Get(in *pb.CashFlowReq) (*pb.ListCashFlow, error) {
 	for rows.Next() {
 		var cashFlow pb.CashFlow
 		var cnt int64
-		if err := rows.Scan(
-			&cashFlow.Id,
-			&cashFlow.UserId,
-			&cashFlow.TransactionDate,
-			&cashFlow.Amount,
-			&cashFlow.TransactionType,
-			&cashFlow.Description,
-			&cashFlow.PaymentMethod,
-			&cashFlow.CompanyId,
-			&cashFlow.BranchId,
-			&cnt,
-		); err != nil {
+		if err := rows.Scan(append(cashFlowDest(&cashFlow), &cnt)...); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
@@ -138,6 +146,42 @@ func (c *cashFlow) Get(in *pb.CashFlowReq) (*pb.ListCashFlow, error) {
 	}, nil
 }
 
+// ReverseBySource сторнирует все ещё не отменённые записи документа: для каждой создаётся запись
+// того же типа с отрицательной суммой и ссылкой на исходную, поэтому итоги доходов и расходов не раздуваются
+func (c *cashFlow) ReverseBySource(sourceType, sourceID, userID, description string) ([]*pb.CashFlow, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO cash_flow (id, user_id, amount, transaction_type, description, payment_type_id, company_id, branch_id,
+		                       source_type, source_id, reversal_of)
+		SELECT gen_random_uuid(), $3, -c.amount, c.transaction_type, $4, c.payment_type_id, c.company_id, c.branch_id,
+		       c.source_type, c.source_id, c.id
+		FROM cash_flow c
+		WHERE c.source_type = $1 AND c.source_id = $2 AND c.reversal_of IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM cash_flow r WHERE r.reversal_of = c.id)
+		RETURNING %s
+	`, cashFlowColumns)
+
+	rows, err := c.db.Queryx(query, sourceType, sourceID, userID, description)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reverse cash flow of %s %s: %w", sourceType, sourceID, err)
+	}
+	defer rows.Close()
+
+	var reversals []*pb.CashFlow
+	for rows.Next() {
+		var cf pb.CashFlow
+		if err := rows.Scan(cashFlowDest(&cf)...); err != nil {
+			return nil, fmt.Errorf("failed to scan reversal: %w", err)
+		}
+		reversals = append(reversals, &cf)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reversals: %w", err)
+	}
+
+	return reversals, nil
+}
+
 func (cf *cashFlow) GetTotalIncome(req *pb.StatisticReq) (*pb.PriceProducts, error) {
 	var args []interface{}
 	argIndex := 1
@@ -146,14 +190,15 @@ func (cf *cashFlow) GetTotalIncome(req *pb.StatisticReq) (*pb.PriceProducts, err
 	queryBuilder := strings.Builder{}
 	queryBuilder.WriteString(`
 		SELECT 
-			COALESCE(payment_method, 'uzs') AS many_type, 
-			SUM(amount) AS total_price
+			pt.name AS many_type, 
+			SUM(cf.amount) AS total_price
 		FROM 
-			cash_flow
+			cash_flow cf
+		JOIN payment_types pt ON pt.id = cf.payment_type_id
 		WHERE 
-			transaction_type = 'income'
-			AND company_id = $1
-			AND branch_id = $2
+			cf.transaction_type = 'income'
+			AND cf.company_id = $1
+			AND cf.branch_id = $2
 	`)
 
 	args = append(args, req.CompanyId, req.BranchId)
@@ -161,12 +206,12 @@ func (cf *cashFlow) GetTotalIncome(req *pb.StatisticReq) (*pb.PriceProducts, err
 
 	// Фильтр по датам
 	if req.StartDate != "" {
-		queryBuilder.WriteString(fmt.Sprintf(" AND transaction_date >= $%d", argIndex))
+		queryBuilder.WriteString(fmt.Sprintf(" AND cf.transaction_date >= $%d", argIndex))
 		args = append(args, req.StartDate)
 		argIndex++
 	}
 	if req.EndDate != "" {
-		queryBuilder.WriteString(fmt.Sprintf(" AND transaction_date <= $%d", argIndex))
+		queryBuilder.WriteString(fmt.Sprintf(" AND cf.transaction_date <= $%d", argIndex))
 		args = append(args, req.EndDate)
 		argIndex++
 	}
@@ -174,7 +219,7 @@ func (cf *cashFlow) GetTotalIncome(req *pb.StatisticReq) (*pb.PriceProducts, err
 	// Группировка
 	queryBuilder.WriteString(`
 		GROUP BY 
-			payment_method
+			pt.name
 		ORDER BY 
 			total_price DESC
 	`)
@@ -216,17 +261,18 @@ func (cf *cashFlow) GetTotalIncome(req *pb.StatisticReq) (*pb.PriceProducts, err
 func (cf *cashFlow) GetTotalExpense(req *pb.StatisticReq) (*pb.PriceProducts, error) {
 	query := `
 		SELECT 
-			payment_method AS many_type, 
-			SUM(amount) AS total_price
+			pt.name AS many_type, 
+			SUM(cf.amount) AS total_price
 		FROM 
-			cash_flow
+			cash_flow cf
+		JOIN payment_types pt ON pt.id = cf.payment_type_id
 		WHERE 
-			transaction_type = 'expense'
-			AND transaction_date BETWEEN $1 AND $2
-			AND company_id = $3
-			AND branch_id = $4
+			cf.transaction_type = 'expense'
+			AND cf.transaction_date BETWEEN $1 AND $2
+			AND cf.company_id = $3
+			AND cf.branch_id = $4
 		GROUP BY 
-			payment_method;
+			pt.name;
 	`
 
 	rows, err := cf.db.Query(query, req.StartDate, req.EndDate, req.CompanyId, req.BranchId)
@@ -255,17 +301,18 @@ func (cf *cashFlow) GetTotalExpense(req *pb.StatisticReq) (*pb.PriceProducts, er
 func (cf *cashFlow) GetNetProfit(req *pb.StatisticReq) (*pb.PriceProducts, error) {
 	query := `
 		SELECT 
-			payment_method AS many_type, 
-			SUM(CASE WHEN transaction_type = 'income' THEN amount ELSE 0 END) -
-			SUM(CASE WHEN transaction_type = 'expense' THEN amount ELSE 0 END) AS total_price
+			pt.name AS many_type, 
+			SUM(CASE WHEN cf.transaction_type = 'income' THEN cf.amount ELSE 0 END) -
+			SUM(CASE WHEN cf.transaction_type = 'expense' THEN cf.amount ELSE 0 END) AS total_price
 		FROM 
-			cash_flow
+			cash_flow cf
+		JOIN payment_types pt ON pt.id = cf.payment_type_id
 		WHERE 
-			transaction_date BETWEEN $1 AND $2
-			AND company_id = $3
-			AND branch_id = $4
+			cf.transaction_date BETWEEN $1 AND $2
+			AND cf.company_id = $3
+			AND cf.branch_id = $4
 		GROUP BY 
-			payment_method;
+			pt.name;
 	`
 
 	rows, err := cf.db.Query(query, req.StartDate, req.EndDate, req.CompanyId, req.BranchId)
@@ -293,19 +340,20 @@ func (cf *cashFlow) GetNetProfit(req *pb.StatisticReq) (*pb.PriceProducts, error
 func (r *cashFlow) GetBranchIncome(in *pb.BranchIncomeReq) (*pb.BranchIncomeRes, error) {
 	query := `
         SELECT 
-            branch_id,
-            payment_method,
-            SUM(amount) AS total_income
+            cf.branch_id,
+            pt.name,
+            SUM(cf.amount) AS total_income
         FROM 
-            cash_flow
+            cash_flow cf
+        JOIN payment_types pt ON pt.id = cf.payment_type_id
         WHERE 
-            transaction_date BETWEEN $1 AND $2
-            AND company_id = $3
-            AND transaction_type = 'income'
+            cf.transaction_date BETWEEN $1 AND $2
+            AND cf.company_id = $3
+            AND cf.transaction_type = 'income'
         GROUP BY 
-            branch_id, payment_method
+            cf.branch_id, pt.name
         ORDER BY 
-            branch_id;
+            cf.branch_id;
     `
 
 	rows, err := r.db.Query(query, in.StartDate, in.EndDate, in.CompanyId)
@@ -315,7 +363,7 @@ func (r *cashFlow) GetBranchIncome(in *pb.BranchIncomeReq) (*pb.BranchIncomeRes,
 	defer rows.Close()
 
 	// Для хранения данных по филиалам
-	branchMap := make(map[string]map[string]float64) // branch_id -> payment_method -> total_income
+	branchMap := make(map[string]map[string]float64) // branch_id -> payment type -> total_income
 	totalIncome := 0.0
 
 	for rows.Next() {
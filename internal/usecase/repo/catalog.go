@@ -0,0 +1,391 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// catalogItem карточка товара, общая для всех филиалов компании
+type catalogItem struct {
+	ID         string `db:"id"`
+	Name       string `db:"name"`
+	ImageURL   string `db:"image_url"`
+	BillFormat string `db:"bill_format"`
+	BaseUnit   string `db:"base_unit"`
+	// Каждая единица карточки учитывается по своему серийному номеру
+	Serialized bool `db:"is_serialized"`
+
+	// Карточка варианта ссылается на родителя и хранит значения по его осям
+	ParentID string         `db:"parent_id"`
+	Axes     pq.StringArray `db:"variant_axes"`
+	Values   pq.StringArray `db:"variant_values"`
+}
+
+// resolveCatalogItem возвращает карточку компании по id или создаёт новую из данных товара
+func resolveCatalogItem(tx dbtx, catalogID, companyID, createdBy string, card catalogItem) (catalogItem, error) {
+	if catalogID != "" {
+		var existing catalogItem
+		err := tx.Get(&existing, `
+			SELECT id, name, image_url, bill_format, base_unit, is_serialized, COALESCE(parent_id::TEXT, '') AS parent_id, variant_axes, variant_values
+			FROM catalog_items
+			WHERE id = $1 AND company_id = $2`,
+			catalogID, companyID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return catalogItem{}, fmt.Errorf("catalog item %s not found", catalogID)
+		}
+		if err != nil {
+			return catalogItem{}, fmt.Errorf("failed to fetch catalog item: %w", err)
+		}
+		return existing, nil
+	}
+
+	if card.BaseUnit == "" {
+		card.BaseUnit = entity.DefaultBaseUnit
+	}
+
+	err := tx.QueryRowx(`
+		INSERT INTO catalog_items (name, image_url, bill_format, company_id, created_by, parent_id, variant_axes, variant_values, base_unit, is_serialized)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::UUID, COALESCE($7::TEXT[], '{}'), COALESCE($8::TEXT[], '{}'), $9, $10)
+		RETURNING id`,
+		card.Name, card.ImageURL, card.BillFormat, companyID, createdBy, card.ParentID, pq.StringArray(card.Axes), pq.StringArray(card.Values),
+		card.BaseUnit, card.Serialized).Scan(&card.ID)
+	if err != nil {
+		return catalogItem{}, fmt.Errorf("failed to create catalog item: %w", err)
+	}
+
+	return card, nil
+}
+
+// syncCatalogItem переносит название, картинку и формат чека строки филиала в карточку и остальные филиалы
+func syncCatalogItem(tx dbtx, productID string) error {
+	_, err := tx.Exec(`
+		UPDATE catalog_items c
+		SET name = p.name, image_url = p.image_url, bill_format = p.bill_format
+		FROM products p
+		WHERE p.id = $1 AND c.id = p.catalog_id`,
+		productID)
+	if err != nil {
+		return fmt.Errorf("failed to update catalog item: %w", err)
+	}
+
+	_, err = tx.Exec(`
+		UPDATE products p
+		SET name = c.name, image_url = c.image_url, bill_format = c.bill_format
+		FROM catalog_items c
+		WHERE c.id = p.catalog_id
+		  AND p.catalog_id = (SELECT catalog_id FROM products WHERE id = $1)
+		  AND p.id <> $1`,
+		productID)
+	if err != nil {
+		return fmt.Errorf("failed to update catalog item in other branches: %w", err)
+	}
+
+	return nil
+}
+
+// ensureBranchParent возвращает строку родителя вариантов в филиале. Если родителя в филиале ещё нет,
+// заводит строку без остатка с ценами родителя из другого филиала
+func ensureBranchParent(tx dbtx, parentCatalogID, categoryID, branchID, companyID, createdBy string) (string, error) {
+	var id string
+	err := tx.Get(&id, `SELECT id FROM products WHERE catalog_id = $1 AND branch_id = $2`, parentCatalogID, branchID)
+	if err == nil {
+		return id, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("failed to fetch parent product: %w", err)
+	}
+
+	err = tx.Get(&id, `
+		INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, company_id, branch_id, created_by, total_count, catalog_id, is_parent)
+		SELECT $1, c.name, c.image_url, c.bill_format,
+			COALESCE((SELECT incoming_price FROM products WHERE catalog_id = c.id ORDER BY created_at LIMIT 1), 0),
+			COALESCE((SELECT standard_price FROM products WHERE catalog_id = c.id ORDER BY created_at LIMIT 1), 0),
+			c.company_id, $2, $3, 0, c.id, TRUE
+		FROM catalog_items c
+		WHERE c.id = $4 AND c.company_id = $5
+		RETURNING id`,
+		categoryID, branchID, createdBy, parentCatalogID, companyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create parent product in branch: %w", err)
+	}
+
+	return id, nil
+}
+
+// inheritParentPrices подставляет в незаданные цены варианта цены родителя.
+// Возвращает true, если у варианта своя цена продажи
+func inheritParentPrices(tx dbtx, parentID string, in *pb.CreateProductRequest) (bool, error) {
+	var prices struct {
+		IncomingPrice float64 `db:"incoming_price"`
+		StandardPrice float64 `db:"standard_price"`
+	}
+	err := tx.Get(&prices, `SELECT incoming_price, standard_price FROM products WHERE id = $1`, parentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch parent product prices: %w", err)
+	}
+
+	if in.IncomingPrice == 0 {
+		in.IncomingPrice = prices.IncomingPrice
+	}
+	if in.StandardPrice == 0 {
+		in.StandardPrice = prices.StandardPrice
+		return false, nil
+	}
+	return true, nil
+}
+
+// setCatalogUnits заменяет единицы закупки и продажи карточки новым списком
+func setCatalogUnits(tx dbtx, catalogID, companyID string, units []*pb.ProductUnit) error {
+	if _, err := tx.Exec(`DELETE FROM catalog_units WHERE catalog_id = $1`, catalogID); err != nil {
+		return fmt.Errorf("failed to delete catalog item units: %w", err)
+	}
+	for _, unit := range units {
+		_, err := tx.Exec(`INSERT INTO catalog_units (catalog_id, name, factor, company_id) VALUES ($1, $2, $3, $4)`,
+			catalogID, unit.Name, unit.Factor, companyID)
+		if err != nil {
+			return fmt.Errorf("failed to add catalog item unit %s: %w", unit.Name, err)
+		}
+	}
+	return nil
+}
+
+// productUnits читает единицы карточки из JSON-массива, собранного запросом
+type productUnits struct {
+	units *[]*pb.ProductUnit
+}
+
+func (u productUnits) Scan(src interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported product units type %T", src)
+	}
+	return json.Unmarshal(data, u.units)
+}
+
+// setCatalogSKU задаёт артикул карточки. Артикул уникален внутри компании
+func setCatalogSKU(tx dbtx, catalogID, sku string) error {
+	_, err := tx.Exec(`UPDATE catalog_items SET sku = $1 WHERE id = $2`, sku, catalogID)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("sku %s is already used by another product", sku)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set catalog item sku: %w", err)
+	}
+	return nil
+}
+
+// addCatalogBarcodes привязывает штрихкоды к карточке. Уже привязанные к ней коды пропускаются,
+// код другой карточки компании вызывает ошибку
+func addCatalogBarcodes(tx dbtx, catalogID, companyID string, barcodes []string) error {
+	for _, barcode := range barcodes {
+		_, err := tx.Exec(`
+			INSERT INTO catalog_barcodes (catalog_id, barcode, company_id)
+			SELECT $1, $2, $3
+			WHERE NOT EXISTS (SELECT 1 FROM catalog_barcodes WHERE catalog_id = $1 AND barcode = $2)`,
+			catalogID, barcode, companyID)
+		if isUniqueViolation(err) {
+			return fmt.Errorf("barcode %s is already used by another product", barcode)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to add catalog item barcode: %w", err)
+		}
+	}
+	return nil
+}
+
+// replaceCatalogBarcodes заменяет все штрихкоды карточки новым списком
+func replaceCatalogBarcodes(tx dbtx, catalogID, companyID string, barcodes []string) error {
+	if _, err := tx.Exec(`DELETE FROM catalog_barcodes WHERE catalog_id = $1`, catalogID); err != nil {
+		return fmt.Errorf("failed to delete catalog item barcodes: %w", err)
+	}
+	return addCatalogBarcodes(tx, catalogID, companyID, barcodes)
+}
+
+// ensureCatalogBarcode выдаёт карточке без штрихкодов внутренний EAN-13: префикс компании, номер из её счётчика
+// и контрольная цифра. Номера, уже занятые введёнными вручную кодами, пропускаются
+func ensureCatalogBarcode(tx dbtx, catalogID, companyID string) error {
+	var exists bool
+	err := tx.Get(&exists, `SELECT EXISTS (SELECT 1 FROM catalog_barcodes WHERE catalog_id = $1)`, catalogID)
+	if err != nil {
+		return fmt.Errorf("failed to check catalog item barcodes: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	var prefix string
+	err = tx.Get(&prefix, `SELECT COALESCE((SELECT barcode_prefix FROM company_settings WHERE company_id = $1), $2)`,
+		companyID, entity.DefaultBarcodePrefix)
+	if err != nil {
+		return fmt.Errorf("failed to fetch barcode prefix: %w", err)
+	}
+
+	for {
+		var number int64
+		err = tx.Get(&number, `
+			INSERT INTO barcode_sequences (company_id, last_number) VALUES ($1, 1)
+			ON CONFLICT (company_id) DO UPDATE SET last_number = barcode_sequences.last_number + 1
+			RETURNING last_number`,
+			companyID)
+		if err != nil {
+			return fmt.Errorf("failed to allocate internal barcode: %w", err)
+		}
+		if number > 999999999 {
+			return errors.New("internal barcode numbers are exhausted")
+		}
+
+		body := fmt.Sprintf("%s%09d", prefix, number)
+		barcode := body + string(entity.GTINCheckDigit(body))
+
+		var taken bool
+		err = tx.Get(&taken, `SELECT EXISTS (SELECT 1 FROM catalog_barcodes WHERE company_id = $1 AND barcode = $2)`, companyID, barcode)
+		if err != nil {
+			return fmt.Errorf("failed to check internal barcode: %w", err)
+		}
+		if !taken {
+			return addCatalogBarcodes(tx, catalogID, companyID, []string{barcode})
+		}
+	}
+}
+
+// isUniqueViolation ошибка нарушения уникального индекса
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// GetCatalogStock возвращает карточки компании с остатками и ценами по каждому филиалу
+func (p *productRepo) GetCatalogStock(in *pb.CatalogStockReq) (*pb.CatalogStockList, error) {
+	conditions := []string{"company_id = $1"}
+	args := []interface{}{in.CompanyId}
+	argIndex := 2
+
+	if in.CatalogId != "" {
+		conditions = append(conditions, fmt.Sprintf("id = $%d", argIndex))
+		args = append(args, in.CatalogId)
+		argIndex++
+	}
+	if name := strings.TrimSpace(in.Name); name != "" {
+		conditions = append(conditions, fmt.Sprintf("name ILIKE $%d", argIndex))
+		args = append(args, "%"+name+"%")
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, name, image_url, bill_format, company_id, COUNT(*) OVER () AS total
+		FROM catalog_items
+		WHERE %s
+		ORDER BY name, id`, strings.Join(conditions, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := p.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch catalog items: %w", err)
+	}
+	defer rows.Close()
+
+	var totalCount int64
+	var items []*pb.CatalogItem
+	byID := make(map[string]*pb.CatalogItem)
+	for rows.Next() {
+		item := &pb.CatalogItem{}
+		if err := rows.Scan(&item.Id, &item.Name, &item.ImageUrl, &item.BillFormat, &item.CompanyId, &totalCount); err != nil {
+			return nil, fmt.Errorf("failed to scan catalog item: %w", err)
+		}
+		items = append(items, item)
+		byID[item.Id] = item
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error while iterating catalog items: %w", err)
+	}
+
+	if len(items) == 0 {
+		return &pb.CatalogStockList{TotalCount: totalCount}, nil
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT catalog_id, branch_id, id, total_count, incoming_price, standard_price
+		FROM products
+		WHERE catalog_id IN (?)
+		ORDER BY branch_id`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare branch stock query: %w", err)
+	}
+
+	stockRows, err := p.db.Queryx(p.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch branch stock: %w", err)
+	}
+	defer stockRows.Close()
+
+	for stockRows.Next() {
+		var catalogID string
+		stock := &pb.BranchStock{}
+		if err := stockRows.Scan(&catalogID, &stock.BranchId, &stock.ProductId, &stock.TotalCount, &stock.IncomingPrice, &stock.StandardPrice); err != nil {
+			return nil, fmt.Errorf("failed to scan branch stock: %w", err)
+		}
+		item := byID[catalogID]
+		item.Branches = append(item.Branches, stock)
+		item.TotalCount += stock.TotalCount
+	}
+	if err := stockRows.Err(); err != nil {
+		return nil, fmt.Errorf("error while iterating branch stock: %w", err)
+	}
+
+	return &pb.CatalogStockList{
+		Items:      items,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// GetBranchProducts возвращает строки товаров филиала по карточкам каталога: catalog_id -> id строки.
+// Карточки без строки в филиале в ответ не попадают
+func (p *productQuantity) GetBranchProducts(companyID, branchID string, catalogIDs []string) (map[string]string, error) {
+	rows, err := p.db.Queryx(`
+		SELECT DISTINCT ON (catalog_id) catalog_id, id
+		FROM products
+		WHERE company_id = $1 AND branch_id = $2 AND catalog_id = ANY($3::UUID[])
+		ORDER BY catalog_id, created_at, id
+	`, companyID, branchID, pq.Array(catalogIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch branch products by catalog: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]string)
+	for rows.Next() {
+		var catalogID, productID string
+		if err := rows.Scan(&catalogID, &productID); err != nil {
+			return nil, fmt.Errorf("failed to scan branch product: %w", err)
+		}
+		res[catalogID] = productID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating branch products: %w", err)
+	}
+
+	return res, nil
+}
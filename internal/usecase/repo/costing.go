@@ -0,0 +1,602 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"fmt"
+	"github.com/lib/pq"
+	"sort"
+	"strings"
+)
+
+// costLayer слой себестоимости товара с остатком
+type costLayer struct {
+	ID        string
+	ProductID string
+	SourceID  string
+	UnitCost  float64
+	Remaining int64
+	CompanyID string
+	BranchID  string
+}
+
+// costedProduct себестоимость товара до и во время оценки движений
+type costedProduct struct {
+	CatalogID string
+	Cost      float64
+}
+
+// recostedProduct себестоимость и остаток товара при переоценке прихода
+type recostedProduct struct {
+	BranchID  string
+	CompanyID string
+	Cost      float64
+	Balance   int64
+}
+
+// documentCosts себестоимость единицы в движениях документа по карточкам каталога: приход и расход отдельно
+type documentCosts map[string]map[bool]float64
+
+// cost возвращает себестоимость единицы прихода (incoming) или расхода документа по карточке
+func (d documentCosts) cost(catalogID string, incoming bool) (float64, bool) {
+	c, ok := d[catalogID][incoming]
+	return c, ok
+}
+
+// costMovements оценивает движения товаров по себестоимости и пересчитывает incoming_price товаров.
+// Приход кладёт слой себестоимости, расход забирает слои: сначала принесённые самим документом, затем документом,
+// по партиям которого идёт изменение, затем от старых к новым. Слои ведутся при любом методе оценки компании.
+//
+// Приход, который возвращает ранее списанный документом товар (возврат продажи, отмена перемещения, приёмка
+// перемещения), идёт по себестоимости того списания. Иначе приход оценивается по себестоимости партий, без них -
+// по текущей себестоимости товара. Расход по FIFO идёт по себестоимости забранных слоёв, по средней - по текущей
+// средней, а сторно прихода документа (аннулирование закупки, возврат поставщику) - по себестоимости того прихода
+func costMovements(tx dbtx, movements []movement, info entity.MovementInfo) error {
+	if len(movements) == 0 {
+		return nil
+	}
+
+	var ids, companies []string
+	for _, m := range movements {
+		ids = append(ids, m.ProductID)
+		companies = append(companies, m.CompanyID)
+	}
+
+	products, err := loadProductCosts(tx, ids)
+	if err != nil {
+		return err
+	}
+	methods, err := loadCostingMethods(tx, companies)
+	if err != nil {
+		return err
+	}
+	layers, err := loadCostLayers(tx, ids, info)
+	if err != nil {
+		return err
+	}
+
+	var catalogIDs []string
+	for _, p := range products {
+		catalogIDs = append(catalogIDs, p.CatalogID)
+	}
+	// Себестоимость движений самого документа и документа, по партиям которого идёт изменение
+	bySource, err := loadDocumentCosts(tx, info.SourceID, catalogIDs)
+	if err != nil {
+		return err
+	}
+	byLotSource := bySource
+	if lotSource(info) != info.SourceID {
+		if byLotSource, err = loadDocumentCosts(tx, lotSource(info), catalogIDs); err != nil {
+			return err
+		}
+	}
+	reference := func(catalogID string, incoming bool) (float64, bool) {
+		if c, ok := bySource.cost(catalogID, incoming); ok {
+			return c, true
+		}
+		return byLotSource.cost(catalogID, incoming)
+	}
+
+	taken := make(map[string]int64)
+	var takenOrder []string
+	var added []costLayer
+	costs := make(map[string]float64)
+	var costOrder []string
+
+	for i := range movements {
+		m := &movements[i]
+		p, ok := products[m.ProductID]
+		if !ok {
+			return fmt.Errorf("failed to cost movement: product %s not found", m.ProductID)
+		}
+		fifo := methods[m.CompanyID] == entity.CostingFIFO
+		opening := m.Balance - m.Delta
+		newCost := p.Cost
+
+		switch {
+		case m.Delta > 0:
+			// Приход закупки всегда идёт по цене закупки, даже если раньше её уменьшали
+			var c float64
+			var ok bool
+			if info.Reason != entity.MovementPurchase {
+				c, ok = reference(p.CatalogID, false)
+			}
+			if !ok {
+				c, ok = lotsCost(m.Lots)
+			}
+			if !ok {
+				c = p.Cost
+			}
+			m.UnitCost = c
+
+			if qty := layerQuantity(layers[m.ProductID], m.Delta, m.Balance); qty > 0 {
+				l := costLayer{ProductID: m.ProductID, UnitCost: c, Remaining: qty, CompanyID: m.CompanyID, BranchID: m.BranchID}
+				added = append(added, l)
+				layers[m.ProductID] = append(layers[m.ProductID], &l)
+			}
+
+			if fifo {
+				newCost = layersCost(layers[m.ProductID], c)
+			} else {
+				newCost = averageCost(opening, p.Cost, m.Delta, c)
+			}
+
+		case m.Delta < 0:
+			value, short := consumeLayers(layers[m.ProductID], -m.Delta, func(layerID string, qty int64) {
+				if _, ok := taken[layerID]; !ok {
+					takenOrder = append(takenOrder, layerID)
+				}
+				taken[layerID] += qty
+			})
+
+			if fifo {
+				m.UnitCost = fifoIssueCost(value, short, -m.Delta, p.Cost)
+				newCost = layersCost(layers[m.ProductID], p.Cost)
+			} else {
+				c, ok := reference(p.CatalogID, true)
+				if !ok {
+					c = p.Cost
+				}
+				m.UnitCost = c
+				if c != p.Cost && m.Balance > 0 {
+					newCost = reversedAverage(opening, p.Cost, m.Delta, c)
+				}
+			}
+
+		default:
+			m.UnitCost = p.Cost
+		}
+
+		if newCost != p.Cost {
+			if _, ok := costs[m.ProductID]; !ok {
+				costOrder = append(costOrder, m.ProductID)
+			}
+			costs[m.ProductID] = newCost
+		}
+		p.Cost = newCost
+		products[m.ProductID] = p
+	}
+
+	for _, l := range added {
+		if err := insertCostLayer(tx, l, info); err != nil {
+			return err
+		}
+	}
+	if err := takeCostLayers(tx, takenOrder, taken); err != nil {
+		return err
+	}
+
+	return updateProductCosts(tx, costOrder, costs)
+}
+
+// RecostPurchase переоценивает приход закупки info.SourceID по новой себестоимости единицы costs по товарам: слои
+// и партии закупки и incoming_price товаров. Журнал движения не меняется: переоценка пишется в него движением
+// без изменения количества по новой себестоимости. Переоценивается только то, что ещё лежит на складе:
+// себестоимость уже проданного остаётся прежней
+func (p *productQuantity) RecostPurchase(costs map[string]float64, info entity.MovementInfo) error {
+	if len(costs) == 0 {
+		return nil
+	}
+	purchaseID := info.SourceID
+
+	ids := make([]string, 0, len(costs))
+	for id := range costs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	rows, err := p.db.Queryx(`
+		SELECT id, branch_id, company_id, incoming_price, total_count
+		FROM products
+		WHERE id = ANY($1::UUID[])
+		ORDER BY id
+		FOR UPDATE
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to lock products for recosting: %w", err)
+	}
+	defer rows.Close()
+
+	products := make(map[string]recostedProduct)
+	var companies []string
+	for rows.Next() {
+		var id string
+		var pr recostedProduct
+		if err := rows.Scan(&id, &pr.BranchID, &pr.CompanyID, &pr.Cost, &pr.Balance); err != nil {
+			return fmt.Errorf("failed to scan product for recosting: %w", err)
+		}
+		products[id] = pr
+		companies = append(companies, pr.CompanyID)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating products for recosting: %w", err)
+	}
+
+	methods, err := loadCostingMethods(p.db, companies)
+	if err != nil {
+		return err
+	}
+	layers, err := loadCostLayers(p.db, ids, entity.MovementInfo{SourceID: purchaseID})
+	if err != nil {
+		return err
+	}
+
+	newCosts := make(map[string]float64)
+	var costOrder []string
+	revaluations := make([]movement, 0, len(ids))
+	for _, id := range ids {
+		pr, ok := products[id]
+		if !ok {
+			return fmt.Errorf("failed to recost purchase: product %s not found", id)
+		}
+
+		// Остаток слоёв закупки - приход, который ещё лежит на складе
+		var revalue float64
+		for _, l := range layers[id] {
+			if l.SourceID != purchaseID {
+				continue
+			}
+			revalue += float64(l.Remaining) * (costs[id] - l.UnitCost)
+			l.UnitCost = costs[id]
+		}
+
+		newCost := pr.Cost
+		if methods[pr.CompanyID] == entity.CostingFIFO {
+			newCost = layersCost(layers[id], pr.Cost)
+		} else {
+			newCost = revaluedAverage(pr.Balance, pr.Cost, revalue)
+		}
+		if newCost != pr.Cost {
+			costOrder = append(costOrder, id)
+			newCosts[id] = newCost
+		}
+		revaluations = append(revaluations, movement{
+			ProductID: id, BranchID: pr.BranchID, CompanyID: pr.CompanyID, Balance: pr.Balance, UnitCost: costs[id],
+		})
+	}
+
+	values := make([]float64, len(ids))
+	for i, id := range ids {
+		values[i] = costs[id]
+	}
+	revalued := `
+		FROM UNNEST($2::UUID[], $3::NUMERIC[]) AS c(id, cost)
+		WHERE t.source_id = $1 AND t.product_id = c.id`
+	queries := []struct{ name, query string }{
+		{"cost layers", `UPDATE cost_layers t SET unit_cost = c.cost` + revalued},
+		{"lots", `UPDATE product_lots t SET cost = c.cost` + revalued},
+	}
+	for _, q := range queries {
+		if _, err := p.db.Exec(q.query, purchaseID, pq.Array(ids), pq.Array(values)); err != nil {
+			return fmt.Errorf("failed to recost purchase %s: %w", q.name, err)
+		}
+	}
+
+	info.Reason = entity.MovementRevaluation
+	if err := insertRevaluations(p.db, revaluations, info); err != nil {
+		return err
+	}
+
+	return updateProductCosts(p.db, costOrder, newCosts)
+}
+
+// insertRevaluations записывает в журнал переоценку товаров: движение без изменения количества
+// с новой себестоимостью единицы
+func insertRevaluations(tx dbtx, revaluations []movement, info entity.MovementInfo) error {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(`
+		INSERT INTO inventory_movements (product_id, branch_id, company_id, delta, balance, reason, source_id, created_by, unit_cost) VALUES
+	`)
+
+	args := []interface{}{}
+	for i, m := range revaluations {
+		startIdx := i * 8
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, 0, $%d, $%d, NULLIF($%d, '')::UUID, NULLIF($%d, '')::UUID, $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8))
+
+		args = append(args, m.ProductID, m.BranchID, m.CompanyID, m.Balance, info.Reason, info.SourceID, info.CreatedBy, m.UnitCost)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert revaluation movements: %w", err)
+	}
+
+	return nil
+}
+
+// revaluedAverage средняя себестоимость после переоценки остатка на revalue. Без остатка средняя не меняется
+func revaluedAverage(balance int64, avg, revalue float64) float64 {
+	if balance <= 0 {
+		return avg
+	}
+	return max((float64(balance)*avg+revalue)/float64(balance), 0)
+}
+
+// layerQuantity количество прихода, которое получает слой: приход сначала покрывает проданное сверх остатка,
+// слой получает только то, что легло на склад сверх остатков слоёв
+func layerQuantity(layers []*costLayer, delta, balance int64) int64 {
+	var inLayers int64
+	for _, l := range layers {
+		inLayers += l.Remaining
+	}
+	return max(min(delta, balance-inLayers), 0)
+}
+
+// averageCost средняя себестоимость после прихода delta единиц по cost. Отрицательный остаток до прихода
+// в среднюю не входит
+func averageCost(opening int64, avg float64, delta int64, cost float64) float64 {
+	held := max(opening, 0)
+	return (float64(held)*avg + float64(delta)*cost) / float64(held+delta)
+}
+
+// reversedAverage средняя себестоимость после сторно прихода: расход delta (< 0) по цене того прихода cost
+// убирает её вклад из средней. Остаток после расхода должен быть положительным
+func reversedAverage(opening int64, avg float64, delta int64, cost float64) float64 {
+	return max((float64(opening)*avg+float64(delta)*cost)/float64(opening+delta), 0)
+}
+
+// consumeLayers забирает need единиц из слоёв по порядку и сообщает о каждом забранном слое.
+// Возвращает стоимость забранного и сколько не хватило слоёв
+func consumeLayers(layers []*costLayer, need int64, take func(layerID string, qty int64)) (float64, int64) {
+	var value float64
+	for _, l := range layers {
+		if need == 0 {
+			break
+		}
+		qty := min(l.Remaining, need)
+		if qty <= 0 {
+			continue
+		}
+		take(l.ID, qty)
+		l.Remaining -= qty
+		value += float64(qty) * l.UnitCost
+		need -= qty
+	}
+	return value, need
+}
+
+// fifoIssueCost себестоимость единицы расхода quantity по FIFO: забранное из слоёв идёт по их стоимости value,
+// списанное сверх слоёв (short) - по текущей себестоимости
+func fifoIssueCost(value float64, short, quantity int64, current float64) float64 {
+	return (value + float64(short)*current) / float64(quantity)
+}
+
+// lotsCost средневзвешенная себестоимость приходуемых партий
+func lotsCost(lots []entity.StockLot) (float64, bool) {
+	var value float64
+	var quantity int64
+	for _, l := range lots {
+		if l.Quantity <= 0 {
+			continue
+		}
+		value += float64(l.Quantity) * l.Cost
+		quantity += l.Quantity
+	}
+	if quantity == 0 {
+		return 0, false
+	}
+	return value / float64(quantity), true
+}
+
+// layersCost средневзвешенная себестоимость слоёв с остатком, без них - fallback
+func layersCost(layers []*costLayer, fallback float64) float64 {
+	var value float64
+	var quantity int64
+	for _, l := range layers {
+		value += float64(l.Remaining) * l.UnitCost
+		quantity += l.Remaining
+	}
+	if quantity == 0 {
+		return fallback
+	}
+	return value / float64(quantity)
+}
+
+// loadProductCosts получает текущую себестоимость и карточку каталога товаров
+func loadProductCosts(tx dbtx, productIDs []string) (map[string]costedProduct, error) {
+	rows, err := tx.Queryx(`SELECT id, catalog_id, incoming_price FROM products WHERE id = ANY($1::UUID[])`, pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product costs: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]costedProduct)
+	for rows.Next() {
+		var id string
+		var p costedProduct
+		if err := rows.Scan(&id, &p.CatalogID, &p.Cost); err != nil {
+			return nil, fmt.Errorf("failed to scan product cost: %w", err)
+		}
+		res[id] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product costs: %w", err)
+	}
+
+	return res, nil
+}
+
+// loadCostingMethods получает метод оценки себестоимости компаний. Компания без настроек считает по средней
+func loadCostingMethods(tx dbtx, companyIDs []string) (map[string]string, error) {
+	rows, err := tx.Queryx(`SELECT company_id, costing_method FROM company_settings WHERE company_id = ANY($1::UUID[])`,
+		pq.Array(companyIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch costing methods: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string]string)
+	for rows.Next() {
+		var companyID, method string
+		if err := rows.Scan(&companyID, &method); err != nil {
+			return nil, fmt.Errorf("failed to scan costing method: %w", err)
+		}
+		res[companyID] = method
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating costing methods: %w", err)
+	}
+
+	return res, nil
+}
+
+// loadCostLayers получает слои товаров с остатком в порядке расхода: слои документа изменения, слои документа,
+// по партиям которого идёт изменение, затем от старых к новым
+func loadCostLayers(tx dbtx, productIDs []string, info entity.MovementInfo) (map[string][]*costLayer, error) {
+	rows, err := tx.Queryx(`
+		SELECT id, product_id, COALESCE(source_id::TEXT, ''), unit_cost, remaining
+		FROM cost_layers
+		WHERE product_id = ANY($1::UUID[]) AND remaining > 0
+		ORDER BY product_id,
+			CASE WHEN source_id = NULLIF($2, '')::UUID THEN 0 WHEN source_id = NULLIF($3, '')::UUID THEN 1 ELSE 2 END,
+			created_at, id
+	`, pq.Array(productIDs), info.SourceID, lotSource(info))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cost layers: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]*costLayer)
+	for rows.Next() {
+		var l costLayer
+		if err := rows.Scan(&l.ID, &l.ProductID, &l.SourceID, &l.UnitCost, &l.Remaining); err != nil {
+			return nil, fmt.Errorf("failed to scan cost layer: %w", err)
+		}
+		res[l.ProductID] = append(res[l.ProductID], &l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cost layers: %w", err)
+	}
+
+	return res, nil
+}
+
+// loadDocumentCosts получает среднюю себестоимость единицы в уже записанных движениях документа по карточкам каталога,
+// приход и расход отдельно. Карточка связывает строки товара разных филиалов, например при перемещении.
+// Приход, переоценённый после записи (см. RecostPurchase), идёт по себестоимости последней переоценки
+func loadDocumentCosts(tx dbtx, sourceID string, catalogIDs []string) (documentCosts, error) {
+	res := make(documentCosts)
+	if sourceID == "" {
+		return res, nil
+	}
+
+	rows, err := tx.Queryx(`
+		WITH costs AS (
+			SELECT p.catalog_id, m.delta > 0 AS incoming, SUM(ABS(m.delta) * m.unit_cost) / SUM(ABS(m.delta)) AS cost
+			FROM inventory_movements m
+			JOIN products p ON p.id = m.product_id
+			WHERE m.source_id = $1 AND m.delta <> 0 AND m.unit_cost IS NOT NULL AND p.catalog_id = ANY($2::UUID[])
+			GROUP BY p.catalog_id, m.delta > 0
+		), revalued AS (
+			SELECT DISTINCT ON (p.catalog_id) p.catalog_id, m.unit_cost
+			FROM inventory_movements m
+			JOIN products p ON p.id = m.product_id
+			WHERE m.source_id = $1 AND m.reason = $3 AND m.unit_cost IS NOT NULL AND p.catalog_id = ANY($2::UUID[])
+			ORDER BY p.catalog_id, m.created_at DESC, m.id
+		)
+		SELECT c.catalog_id, c.incoming, CASE WHEN c.incoming THEN COALESCE(r.unit_cost, c.cost) ELSE c.cost END
+		FROM costs c
+		LEFT JOIN revalued r ON r.catalog_id = c.catalog_id
+	`, sourceID, pq.Array(catalogIDs), entity.MovementRevaluation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch document costs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var catalogID string
+		var incoming bool
+		var cost float64
+		if err := rows.Scan(&catalogID, &incoming, &cost); err != nil {
+			return nil, fmt.Errorf("failed to scan document cost: %w", err)
+		}
+		if res[catalogID] == nil {
+			res[catalogID] = make(map[bool]float64)
+		}
+		res[catalogID][incoming] = cost
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating document costs: %w", err)
+	}
+
+	return res, nil
+}
+
+// insertCostLayer кладёт слой себестоимости прихода
+func insertCostLayer(tx dbtx, l costLayer, info entity.MovementInfo) error {
+	_, err := tx.Exec(`
+		INSERT INTO cost_layers (product_id, unit_cost, quantity, remaining, source_type, source_id, company_id, branch_id)
+		VALUES ($1, $2, $3, $3, $4, NULLIF($5, '')::UUID, $6, $7)
+	`, l.ProductID, l.UnitCost, l.Remaining, info.Reason, info.SourceID, l.CompanyID, l.BranchID)
+	if err != nil {
+		return fmt.Errorf("failed to create cost layer for product %s: %w", l.ProductID, err)
+	}
+	return nil
+}
+
+// takeCostLayers уменьшает остатки забранных слоёв одним запросом
+func takeCostLayers(tx dbtx, order []string, taken map[string]int64) error {
+	if len(order) == 0 {
+		return nil
+	}
+
+	takes := make([]int64, len(order))
+	for i, id := range order {
+		takes[i] = taken[id]
+	}
+
+	_, err := tx.Exec(`
+		UPDATE cost_layers l SET remaining = l.remaining - t.take
+		FROM UNNEST($1::UUID[], $2::BIGINT[]) AS t(id, take)
+		WHERE l.id = t.id
+	`, pq.Array(order), pq.Array(takes))
+	if err != nil {
+		return fmt.Errorf("failed to update cost layers: %w", err)
+	}
+	return nil
+}
+
+// updateProductCosts записывает пересчитанную себестоимость товаров одним запросом
+func updateProductCosts(tx dbtx, order []string, costs map[string]float64) error {
+	if len(order) == 0 {
+		return nil
+	}
+
+	values := make([]float64, len(order))
+	for i, id := range order {
+		values[i] = costs[id]
+	}
+
+	_, err := tx.Exec(`
+		UPDATE products p SET incoming_price = c.cost
+		FROM UNNEST($1::UUID[], $2::NUMERIC[]) AS c(id, cost)
+		WHERE p.id = c.id
+	`, pq.Array(order), pq.Array(values))
+	if err != nil {
+		return fmt.Errorf("failed to update product costs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"os"
+	"testing"
+)
+
+// testTx открывает транзакцию в базе TEST_DATABASE_URL с применёнными миграциями и откатывает её после теста.
+// Без TEST_DATABASE_URL тест пропускается
+func testTx(t *testing.T) *sqlx.Tx {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sqlx.Connect("postgres", url)
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	tx, err := db.Beginx()
+	if err != nil {
+		t.Fatalf("failed to begin transaction: %v", err)
+	}
+	t.Cleanup(func() { _ = tx.Rollback() })
+	return tx
+}
+
+// testProduct заводит категорию, карточку и строку товара без остатка
+func testProduct(t *testing.T, tx *sqlx.Tx, companyID, branchID, userID string) string {
+	t.Helper()
+
+	var categoryID, catalogID, productID string
+	err := tx.Get(&categoryID, `INSERT INTO product_categories (name, branch_id, company_id, created_by) VALUES ('test', $1, $2, $3) RETURNING id`,
+		branchID, companyID, userID)
+	if err != nil {
+		t.Fatalf("failed to create category: %v", err)
+	}
+	err = tx.Get(&catalogID, `INSERT INTO catalog_items (name, bill_format, company_id, created_by) VALUES ('test', 'pcs', $1, $2) RETURNING id`,
+		companyID, userID)
+	if err != nil {
+		t.Fatalf("failed to create catalog item: %v", err)
+	}
+	err = tx.Get(&productID, `
+		INSERT INTO products (category_id, name, bill_format, incoming_price, standard_price, branch_id, company_id, created_by, catalog_id)
+		VALUES ($1, 'test', 'pcs', 0, 200, $2, $3, $4, $5) RETURNING id`,
+		categoryID, branchID, companyID, userID, catalogID)
+	if err != nil {
+		t.Fatalf("failed to create product: %v", err)
+	}
+	return productID
+}
+
+func TestRecostPurchaseDB(t *testing.T) {
+	tx := testTx(t)
+
+	const (
+		companyID  = "00000000-0000-0000-0000-0000000000c1"
+		branchID   = "00000000-0000-0000-0000-0000000000b1"
+		userID     = "00000000-0000-0000-0000-0000000000a1"
+		purchaseID = "00000000-0000-0000-0000-0000000000d1"
+	)
+	productID := testProduct(t, tx, companyID, branchID, userID)
+	repo := &productQuantity{db: tx}
+	info := entity.MovementInfo{Reason: entity.MovementPurchase, SourceID: purchaseID, CreatedBy: userID}
+
+	_, err := repo.AddProduct(&entity.CountProductReq{ID: productID, Count: 10, Movement: info, Lot: &entity.StockLot{Cost: 100}})
+	if err != nil {
+		t.Fatalf("AddProduct() error = %v", err)
+	}
+
+	// Журнал только дополняется, поэтому переоценка не должна трогать записанные движения
+	if err = repo.RecostPurchase(map[string]float64{productID: 120}, info); err != nil {
+		t.Fatalf("RecostPurchase() error = %v", err)
+	}
+
+	var cost float64
+	if err = tx.Get(&cost, `SELECT incoming_price FROM products WHERE id = $1`, productID); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(cost, 120) {
+		t.Errorf("incoming_price = %v, want 120", cost)
+	}
+	if err = tx.Get(&cost, `SELECT unit_cost FROM cost_layers WHERE source_id = $1 AND product_id = $2`, purchaseID, productID); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(cost, 120) {
+		t.Errorf("cost layer unit_cost = %v, want 120", cost)
+	}
+	if err = tx.Get(&cost, `SELECT unit_cost FROM inventory_movements WHERE source_id = $1 AND reason = $2 AND delta = 0`,
+		purchaseID, entity.MovementRevaluation); err != nil {
+		t.Fatalf("revaluation movement: %v", err)
+	}
+	if !almostEqual(cost, 120) {
+		t.Errorf("revaluation unit_cost = %v, want 120", cost)
+	}
+	if err = tx.Get(&cost, `SELECT unit_cost FROM inventory_movements WHERE source_id = $1 AND reason = $2 AND delta > 0`,
+		purchaseID, entity.MovementPurchase); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(cost, 100) {
+		t.Errorf("purchase movement unit_cost = %v, want it kept at 100", cost)
+	}
+
+	// Сторно прихода закупки идёт по себестоимости переоценки
+	if err = repo.RemoveProducts([]entity.SalesItem{{ProductID: productID, Quantity: 10}}, info); err != nil {
+		t.Fatalf("RemoveProducts() error = %v", err)
+	}
+	if err = tx.Get(&cost, `SELECT unit_cost FROM inventory_movements WHERE source_id = $1 AND reason = $2 AND delta < 0`,
+		purchaseID, entity.MovementPurchase); err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(cost, 120) {
+		t.Errorf("reversal unit_cost = %v, want 120", cost)
+	}
+}
@@ -0,0 +1,236 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestLayerQuantity(t *testing.T) {
+	tests := []struct {
+		name    string
+		layers  []*costLayer
+		delta   int64
+		balance int64
+		want    int64
+	}{
+		{name: "no stock before", delta: 10, balance: 10, want: 10},
+		{name: "on top of layers", layers: []*costLayer{{Remaining: 5}}, delta: 10, balance: 15, want: 10},
+		{name: "covers stock sold below zero first", delta: 10, balance: 6, want: 6},
+		{name: "all of it covers stock sold below zero", delta: 4, balance: -2, want: 0},
+		{name: "stock held outside layers", layers: []*costLayer{{Remaining: 3}}, delta: 5, balance: 20, want: 5},
+		{name: "layers above the balance", layers: []*costLayer{{Remaining: 8}}, delta: 2, balance: 8, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := layerQuantity(tt.layers, tt.delta, tt.balance); got != tt.want {
+				t.Errorf("layerQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageCost(t *testing.T) {
+	tests := []struct {
+		name    string
+		opening int64
+		avg     float64
+		delta   int64
+		cost    float64
+		want    float64
+	}{
+		{name: "first receipt", opening: 0, avg: 0, delta: 10, cost: 100, want: 100},
+		{name: "weighted by quantity", opening: 10, avg: 100, delta: 30, cost: 120, want: 115},
+		{name: "same cost", opening: 5, avg: 80, delta: 5, cost: 80, want: 80},
+		{name: "negative stock does not weigh in", opening: -4, avg: 100, delta: 10, cost: 130, want: 130},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := averageCost(tt.opening, tt.avg, tt.delta, tt.cost); !almostEqual(got, tt.want) {
+				t.Errorf("averageCost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReversedAverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		opening int64
+		avg     float64
+		delta   int64
+		cost    float64
+		want    float64
+	}{
+		{name: "reversal restores the average before the receipt", opening: 40, avg: 115, delta: -30, cost: 120, want: 100},
+		{name: "partial reversal", opening: 20, avg: 110, delta: -10, cost: 120, want: 100},
+		{name: "never below zero", opening: 10, avg: 10, delta: -5, cost: 100, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reversedAverage(tt.opening, tt.avg, tt.delta, tt.cost); !almostEqual(got, tt.want) {
+				t.Errorf("reversedAverage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRevaluedAverage(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance int64
+		avg     float64
+		revalue float64
+		want    float64
+	}{
+		{name: "price raised on part of the stock", balance: 20, avg: 100, revalue: 10 * 20, want: 110},
+		{name: "price lowered", balance: 10, avg: 100, revalue: -5 * 10, want: 95},
+		{name: "no stock", balance: 0, avg: 100, revalue: 50, want: 100},
+		{name: "never below zero", balance: 2, avg: 10, revalue: -100, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := revaluedAverage(tt.balance, tt.avg, tt.revalue); !almostEqual(got, tt.want) {
+				t.Errorf("revaluedAverage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConsumeLayers(t *testing.T) {
+	layers := func() []*costLayer {
+		return []*costLayer{
+			{ID: "old", UnitCost: 100, Remaining: 3},
+			{ID: "new", UnitCost: 120, Remaining: 5},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		need          int64
+		wantValue     float64
+		wantShort     int64
+		wantTaken     map[string]int64
+		wantRemaining []int64
+	}{
+		{
+			name:          "oldest layer first",
+			need:          2,
+			wantValue:     200,
+			wantTaken:     map[string]int64{"old": 2},
+			wantRemaining: []int64{1, 5},
+		},
+		{
+			name:          "across layers",
+			need:          5,
+			wantValue:     3*100 + 2*120,
+			wantTaken:     map[string]int64{"old": 3, "new": 2},
+			wantRemaining: []int64{0, 3},
+		},
+		{
+			name:          "more than the layers hold",
+			need:          10,
+			wantValue:     3*100 + 5*120,
+			wantShort:     2,
+			wantTaken:     map[string]int64{"old": 3, "new": 5},
+			wantRemaining: []int64{0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ls := layers()
+			taken := make(map[string]int64)
+			value, short := consumeLayers(ls, tt.need, func(layerID string, qty int64) { taken[layerID] += qty })
+			if !almostEqual(value, tt.wantValue) || short != tt.wantShort {
+				t.Errorf("consumeLayers() = %v, %d, want %v, %d", value, short, tt.wantValue, tt.wantShort)
+			}
+			if !reflect.DeepEqual(taken, tt.wantTaken) {
+				t.Errorf("consumeLayers() took %v, want %v", taken, tt.wantTaken)
+			}
+			for i, l := range ls {
+				if l.Remaining != tt.wantRemaining[i] {
+					t.Errorf("consumeLayers() left %d in layer %s, want %d", l.Remaining, l.ID, tt.wantRemaining[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFIFOIssueCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		short    int64
+		quantity int64
+		current  float64
+		want     float64
+	}{
+		{name: "all from layers", value: 540, quantity: 5, current: 130, want: 108},
+		{name: "part beyond the layers at the current cost", value: 300, short: 2, quantity: 5, current: 120, want: 108},
+		{name: "no layers", short: 4, quantity: 4, current: 90, want: 90},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fifoIssueCost(tt.value, tt.short, tt.quantity, tt.current); !almostEqual(got, tt.want) {
+				t.Errorf("fifoIssueCost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayersCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		layers   []*costLayer
+		fallback float64
+		want     float64
+	}{
+		{name: "weighted by remaining", layers: []*costLayer{{UnitCost: 100, Remaining: 1}, {UnitCost: 120, Remaining: 3}}, fallback: 50, want: 115},
+		{name: "used up layers do not count", layers: []*costLayer{{UnitCost: 100}, {UnitCost: 120, Remaining: 2}}, fallback: 50, want: 120},
+		{name: "no layers", fallback: 50, want: 50},
+		{name: "only used up layers", layers: []*costLayer{{UnitCost: 100}}, fallback: 50, want: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := layersCost(tt.layers, tt.fallback); !almostEqual(got, tt.want) {
+				t.Errorf("layersCost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLotsCost(t *testing.T) {
+	tests := []struct {
+		name   string
+		lots   []entity.StockLot
+		want   float64
+		wantOK bool
+	}{
+		{name: "one lot", lots: []entity.StockLot{{Cost: 90, Quantity: 4}}, want: 90, wantOK: true},
+		{name: "weighted by quantity", lots: []entity.StockLot{{Cost: 100, Quantity: 1}, {Cost: 120, Quantity: 3}}, want: 115, wantOK: true},
+		{name: "empty lots are skipped", lots: []entity.StockLot{{Cost: 500}, {Cost: 80, Quantity: 2}}, want: 80, wantOK: true},
+		{name: "no lots"},
+		{name: "only empty lots", lots: []entity.StockLot{{Cost: 500}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := lotsCost(tt.lots)
+			if ok != tt.wantOK || !almostEqual(got, tt.want) {
+				t.Errorf("lotsCost() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
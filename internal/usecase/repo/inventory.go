@@ -0,0 +1,503 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+// stockDelta изменение остатка одного товара. Lots - партии, которые приходуются вместе с изменением
+type stockDelta struct {
+	ProductID string
+	Delta     int64
+	Lots      []entity.StockLot
+}
+
+// movement строка журнала движения товаров
+type movement struct {
+	ProductID string
+	BranchID  string
+	CompanyID string
+	Delta     int64
+	Balance   int64
+
+	MinStock   int64
+	ReorderQty int64
+
+	// Lots партии прихода, UnitCost себестоимость единицы движения (см. costMovements)
+	Lots     []entity.StockLot
+	UnitCost float64
+}
+
+// crossedMinStock списание опустило остаток до порога, а до него остаток был выше порога
+func (m movement) crossedMinStock() bool {
+	return m.Delta < 0 && m.MinStock > 0 && m.Balance <= m.MinStock && m.Balance-m.Delta > m.MinStock
+}
+
+// changeStock применяет изменения остатков одним запросом и записывает их в журнал движения товаров.
+// Возвращает остаток каждого товара после изменения. Если списание опустило остаток до порога min_stock,
+// в той же транзакции создаётся оповещение о низком остатке. Остаток родителя вариантов не меняется:
+// продаются и закупаются сами варианты. Изменение оценивается по себестоимости (см. costMovements)
+// и раскладывается по партиям товара (см. moveLots).
+func changeStock(tx dbtx, deltas []stockDelta, info entity.MovementInfo) (map[string]int64, error) {
+	// Складываем повторяющиеся товары, иначе CASE учтёт только первое совпадение
+	merged := make(map[string]int64)
+	lots := make(map[string][]entity.StockLot)
+	var order []string
+	for _, d := range deltas {
+		if _, ok := merged[d.ProductID]; !ok {
+			order = append(order, d.ProductID)
+		}
+		merged[d.ProductID] += d.Delta
+		lots[d.ProductID] = append(lots[d.ProductID], d.Lots...)
+	}
+	if len(order) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString("UPDATE products SET total_count = total_count + CASE id ")
+
+	args := []interface{}{}
+	for i, id := range order {
+		queryBuilder.WriteString(fmt.Sprintf("WHEN $%d THEN $%d ", i*2+1, i*2+2))
+		args = append(args, id, merged[id])
+	}
+	queryBuilder.WriteString("ELSE 0 END WHERE id IN (")
+	for i := range order {
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("$%d", i*2+1))
+	}
+	queryBuilder.WriteString(") AND NOT is_parent RETURNING id, branch_id, company_id, total_count, min_stock, reorder_qty")
+
+	rows, err := tx.Queryx(queryBuilder.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update product stock: %w", err)
+	}
+	defer rows.Close()
+
+	balances := make(map[string]int64)
+	products := make(map[string]movement)
+	var movements, alerts []movement
+	for rows.Next() {
+		var m movement
+		if err := rows.Scan(&m.ProductID, &m.BranchID, &m.CompanyID, &m.Balance, &m.MinStock, &m.ReorderQty); err != nil {
+			return nil, fmt.Errorf("failed to scan updated products: %w", err)
+		}
+		if m.Balance < 0 && !info.AllowNegative {
+			return nil, fmt.Errorf("insufficient stock for product %s: balance would be %d", m.ProductID, m.Balance)
+		}
+		m.Delta = merged[m.ProductID]
+		m.Lots = lots[m.ProductID]
+		balances[m.ProductID] = m.Balance
+		products[m.ProductID] = m
+		movements = append(movements, m)
+		if m.crossedMinStock() {
+			alerts = append(alerts, m)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating updated products: %w", err)
+	}
+
+	// Проверяем, все ли товары обновились
+	for _, id := range order {
+		if _, ok := balances[id]; !ok {
+			return nil, fmt.Errorf("failed to update stock for product %s: not found or has variants", id)
+		}
+	}
+
+	if err := insertMovements(tx, movements, info); err != nil {
+		return nil, err
+	}
+	if err := insertStockAlerts(tx, alerts, info); err != nil {
+		return nil, err
+	}
+	if err := moveLots(tx, order, merged, lots, products, info); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// insertMovements записывает строки в журнал движения товаров вместе с их себестоимостью (см. costMovements)
+func insertMovements(tx dbtx, movements []movement, info entity.MovementInfo) error {
+	if len(movements) == 0 {
+		return nil
+	}
+	if err := costMovements(tx, movements, info); err != nil {
+		return err
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(`
+		INSERT INTO inventory_movements (product_id, branch_id, company_id, delta, balance, reason, source_id, created_by, unit_cost) VALUES
+	`)
+
+	args := []interface{}{}
+	for i, m := range movements {
+		startIdx := i * 9
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, NULLIF($%d, '')::UUID, NULLIF($%d, '')::UUID, $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8, startIdx+9))
+
+		args = append(args, m.ProductID, m.BranchID, m.CompanyID, m.Delta, m.Balance, info.Reason, info.SourceID, info.CreatedBy, m.UnitCost)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert inventory movements: %w", err)
+	}
+
+	return nil
+}
+
+// insertStockAlerts ставит в очередь оповещения о товарах, остаток которых опустился до порога
+func insertStockAlerts(tx dbtx, alerts []movement, info entity.MovementInfo) error {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(`
+		INSERT INTO stock_alerts (product_id, branch_id, company_id, balance, min_stock, reorder_qty, reason, source_id) VALUES
+	`)
+
+	args := []interface{}{}
+	for i, m := range alerts {
+		startIdx := i * 8
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, NULLIF($%d, '')::UUID)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8))
+
+		args = append(args, m.ProductID, m.BranchID, m.CompanyID, m.Balance, m.MinStock, m.ReorderQty, info.Reason, info.SourceID)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert stock alerts: %w", err)
+	}
+
+	return nil
+}
+
+// GetStockMovements возвращает журнал движения товаров с фильтрами
+func (p *productQuantity) GetStockMovements(in *pb.StockMovementFilter) (*pb.StockMovementList, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"m.company_id = $1", "m.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.ProductId != "" {
+		filters = append(filters, fmt.Sprintf("m.product_id = $%d", argIndex))
+		args = append(args, in.ProductId)
+		argIndex++
+	}
+	if in.Reason != "" {
+		filters = append(filters, fmt.Sprintf("m.reason = $%d", argIndex))
+		args = append(args, in.Reason)
+		argIndex++
+	}
+	if in.SourceId != "" {
+		filters = append(filters, fmt.Sprintf("m.source_id = $%d", argIndex))
+		args = append(args, in.SourceId)
+		argIndex++
+	}
+	if in.CreatedBy != "" {
+		filters = append(filters, fmt.Sprintf("m.created_by = $%d", argIndex))
+		args = append(args, in.CreatedBy)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(m.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(m.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			m.id, m.product_id, COALESCE(p.name, ''), m.delta, m.balance, m.reason,
+			COALESCE(m.source_id::TEXT, ''), COALESCE(m.created_by::TEXT, ''), m.created_at, m.company_id, m.branch_id,
+			COALESCE(m.unit_cost, 0), COUNT(*) OVER() AS total_count
+		FROM inventory_movements m
+		LEFT JOIN products p ON m.product_id = p.id
+		WHERE %s
+		ORDER BY m.created_at DESC`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := p.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stock movements: %w", err)
+	}
+	defer rows.Close()
+
+	var movements []*pb.StockMovement
+	var totalCount int64
+	for rows.Next() {
+		var m pb.StockMovement
+		if err := rows.Scan(
+			&m.Id,
+			&m.ProductId,
+			&m.ProductName,
+			&m.Delta,
+			&m.Balance,
+			&m.Reason,
+			&m.SourceId,
+			&m.CreatedBy,
+			&m.CreatedAt,
+			&m.CompanyId,
+			&m.BranchId,
+			&m.UnitCost,
+			&totalCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan stock movement: %w", err)
+		}
+		movements = append(movements, &m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock movements: %w", err)
+	}
+
+	return &pb.StockMovementList{
+		Movements:  movements,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// GetMovementCosts возвращает себестоимость единицы в движениях документа по товарам
+func (p *productQuantity) GetMovementCosts(sourceID string) (map[string]float64, error) {
+	rows, err := p.db.Queryx(`
+		SELECT product_id, SUM(delta * unit_cost) / SUM(delta)
+		FROM inventory_movements
+		WHERE source_id = $1 AND unit_cost IS NOT NULL
+		GROUP BY product_id
+		HAVING SUM(delta) <> 0
+	`, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch movement costs: %w", err)
+	}
+	defer rows.Close()
+
+	costs := make(map[string]float64)
+	for rows.Next() {
+		var productID string
+		var cost float64
+		if err := rows.Scan(&productID, &cost); err != nil {
+			return nil, fmt.Errorf("failed to scan movement cost: %w", err)
+		}
+		costs[productID] = cost
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating movement costs: %w", err)
+	}
+
+	return costs, nil
+}
+
+// CheckStock блокирует строки товаров до конца транзакции и возвращает позиции, которых не хватает на складе
+func (p *productQuantity) CheckStock(items []entity.SalesItem) ([]entity.StockShortage, error) {
+	requested := make(map[string]int64)
+	var order []string
+	for _, item := range items {
+		if _, ok := requested[item.ProductID]; !ok {
+			order = append(order, item.ProductID)
+		}
+		requested[item.ProductID] += item.Quantity
+	}
+	if len(order) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.In(`SELECT id, name, total_count, is_parent FROM products WHERE id IN (?) FOR UPDATE`, order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare stock check query: %w", err)
+	}
+
+	rows, err := p.db.Queryx(p.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check product stock: %w", err)
+	}
+	defer rows.Close()
+
+	type stock struct {
+		name     string
+		count    int64
+		isParent bool
+	}
+	stocks := make(map[string]stock)
+	for rows.Next() {
+		var id string
+		var st stock
+		if err := rows.Scan(&id, &st.name, &st.count, &st.isParent); err != nil {
+			return nil, fmt.Errorf("failed to scan product stock: %w", err)
+		}
+		stocks[id] = st
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product stock: %w", err)
+	}
+
+	var shortages []entity.StockShortage
+	for _, id := range order {
+		st, ok := stocks[id]
+		if !ok {
+			return nil, fmt.Errorf("product %s not found", id)
+		}
+		if st.isParent {
+			return nil, fmt.Errorf("product %s has variants: choose one of its variants", st.name)
+		}
+		if requested[id] > st.count {
+			shortages = append(shortages, entity.StockShortage{
+				ProductID: id,
+				Name:      st.name,
+				Requested: requested[id],
+				Available: st.count,
+			})
+		}
+	}
+
+	return shortages, nil
+}
+
+// GetUnitFactors возвращает для каждого товара его единицы с коэффициентом пересчёта в базовую единицу.
+// Имена единиц приводятся к нижнему регистру, базовая единица входит в список с коэффициентом 1
+func (p *productQuantity) GetUnitFactors(productIDs []string) (map[string]map[string]int64, error) {
+	if len(productIDs) == 0 {
+		return map[string]map[string]int64{}, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT p.id, c.base_unit, COALESCE(u.name, ''), COALESCE(u.factor, 0)
+		FROM products p
+		JOIN catalog_items c ON c.id = p.catalog_id
+		LEFT JOIN catalog_units u ON u.catalog_id = c.id
+		WHERE p.id IN (?)`, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare product units query: %w", err)
+	}
+
+	rows, err := p.db.Queryx(p.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product units: %w", err)
+	}
+	defer rows.Close()
+
+	factors := make(map[string]map[string]int64)
+	for rows.Next() {
+		var id, baseUnit, unit string
+		var factor int64
+		if err := rows.Scan(&id, &baseUnit, &unit, &factor); err != nil {
+			return nil, fmt.Errorf("failed to scan product unit: %w", err)
+		}
+		if factors[id] == nil {
+			factors[id] = map[string]int64{strings.ToLower(baseUnit): 1}
+		}
+		if unit != "" {
+			factors[id][strings.ToLower(unit)] = factor
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product units: %w", err)
+	}
+
+	return factors, nil
+}
+
+// RecordShortages сохраняет нехватку товара при продаже сверх остатка
+func (p *productQuantity) RecordShortages(lines []entity.StockShortage, info entity.MovementInfo) error {
+	query := `
+		INSERT INTO stock_shortages (product_id, sale_id, requested, available, shortage, created_by, branch_id, company_id)
+		SELECT id, NULLIF($2, '')::UUID, $3, $4, $5, NULLIF($6, '')::UUID, branch_id, company_id
+		FROM products WHERE id = $1
+	`
+
+	for _, l := range lines {
+		if _, err := p.db.Exec(query, l.ProductID, info.SourceID, l.Requested, l.Available, l.Missing(), info.CreatedBy); err != nil {
+			return fmt.Errorf("failed to record stock shortage for product %s: %w", l.ProductID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetLowStockProducts возвращает товары, остаток которых опустился до порога min_stock (без порога - до нуля),
+// вместе с последней зафиксированной нехваткой
+func (p *productQuantity) GetLowStockProducts(in *pb.LowStockFilter) (*pb.LowStockList, error) {
+	args := []interface{}{in.CompanyId, in.BranchId}
+
+	query := `
+		SELECT
+			p.id, p.name, p.image_url, p.category_id, p.total_count,
+			GREATEST(-p.total_count, 0) AS shortage, p.min_stock, p.reorder_qty,
+			COALESCE(TO_CHAR(s.last_shortage_at, 'YYYY-MM-DD HH24:MI:SS'), ''),
+			COUNT(*) OVER() AS total_count
+		FROM products p
+		LEFT JOIN (
+			SELECT product_id, MAX(created_at) AS last_shortage_at
+			FROM stock_shortages
+			WHERE company_id = $1 AND branch_id = $2
+			GROUP BY product_id
+		) s ON s.product_id = p.id
+		WHERE p.company_id = $1 AND p.branch_id = $2 AND NOT p.is_parent AND p.total_count <= p.min_stock
+		ORDER BY p.total_count - p.min_stock, p.name`
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += " LIMIT $3 OFFSET $4"
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := p.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch low stock products: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*pb.LowStockProduct
+	var totalCount int64
+	for rows.Next() {
+		var lp pb.LowStockProduct
+		if err := rows.Scan(
+			&lp.ProductId,
+			&lp.Name,
+			&lp.ImageUrl,
+			&lp.CategoryId,
+			&lp.TotalCount,
+			&lp.Shortage,
+			&lp.MinStock,
+			&lp.ReorderQty,
+			&lp.LastShortageAt,
+			&totalCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan low stock product: %w", err)
+		}
+		products = append(products, &lp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating low stock products: %w", err)
+	}
+
+	return &pb.LowStockList{
+		Products:   products,
+		TotalCount: totalCount,
+	}, nil
+}
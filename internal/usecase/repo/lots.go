@@ -0,0 +1,412 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"fmt"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// defaultExpiryHorizon горизонт отчёта по срокам годности, если он не задан, в днях
+const defaultExpiryHorizon = 30
+
+// stockLot партия товара с остатком и движением по ней от документа изменения
+type stockLot struct {
+	ID        string
+	ProductID string
+	Remaining int64
+	// BySource движение по партии от документа изменения, ByLotSource - от документа, по партиям которого оно идёт
+	BySource    int64
+	ByLotSource int64
+	// Expired срок годности партии уже истёк
+	Expired bool
+}
+
+// freshOnly продажа и перемещение не забирают товар из просроченных партий
+func freshOnly(info entity.MovementInfo) bool {
+	return info.Reason == entity.MovementSale || info.Reason == entity.MovementTransferOut
+}
+
+// lotSource документ, по партиям которого идёт изменение
+func lotSource(info entity.MovementInfo) string {
+	if info.LotSourceID != "" {
+		return info.LotSourceID
+	}
+	return info.SourceID
+}
+
+// moveLots раскладывает изменения остатков по партиям. Приход с партиями заводит новые партии.
+// Остальной приход возвращается в партии, из которых документ (или документ, по партиям которого идёт изменение)
+// раньше списал товар, сверх этого товар остаётся без партии. Расход сначала забирает товар из партий,
+// которые принёс сам документ, затем по FEFO: первой уходит партия с ближайшим сроком годности.
+// Продажа и перемещение пропускают просроченные партии; если остальной товар покрывают только они, изменение отклоняется.
+func moveLots(tx dbtx, order []string, merged map[string]int64, lots map[string][]entity.StockLot, products map[string]movement, info entity.MovementInfo) error {
+	changes := make(map[string]int64)
+	var changeOrder []string
+	change := func(lotID string, delta int64) {
+		if _, ok := changes[lotID]; !ok {
+			changeOrder = append(changeOrder, lotID)
+		}
+		changes[lotID] += delta
+	}
+
+	rest := make(map[string]int64, len(order))
+	var pending []string
+	for _, id := range order {
+		rest[id] = merged[id]
+		if len(lots[id]) > 0 {
+			m := products[id]
+			created, err := insertLots(tx, id, m.BranchID, m.CompanyID, lots[id], info)
+			if err != nil {
+				return err
+			}
+			rest[id] -= created
+		}
+		if rest[id] != 0 {
+			pending = append(pending, id)
+		}
+	}
+
+	if len(pending) > 0 {
+		byProduct, err := loadLots(tx, pending, info)
+		if err != nil {
+			return err
+		}
+
+		separateSource := lotSource(info) != info.SourceID
+		for _, id := range pending {
+			productLots := byProduct[id]
+			if d := rest[id]; d < 0 {
+				// Товар без партии - это остаток до изменения сверх остатков партий
+				unlotted := products[id].Balance - products[id].Delta
+				for _, l := range productLots {
+					unlotted -= l.Remaining
+				}
+
+				need := -d
+				need = takeLots(productLots, need, func(l *stockLot) int64 { return min(l.Remaining, l.BySource) }, change)
+				if separateSource {
+					need = takeLots(productLots, need, func(l *stockLot) int64 { return min(l.Remaining, l.ByLotSource) }, change)
+				}
+				need = takeLots(productLots, need, func(l *stockLot) int64 {
+					if l.Expired && freshOnly(info) {
+						return 0
+					}
+					return l.Remaining
+				}, change)
+
+				if need > max(unlotted, 0) && freshOnly(info) {
+					var expired int64
+					for _, l := range productLots {
+						if l.Expired {
+							expired += l.Remaining
+						}
+					}
+					if expired > 0 {
+						return fmt.Errorf("insufficient unexpired stock for product %s: %d units are left only in expired lots", id, min(need-max(unlotted, 0), expired))
+					}
+				}
+			} else {
+				put := putLots(productLots, d, func(l *stockLot) int64 { return -l.BySource }, change)
+				if separateSource {
+					putLots(productLots, put, func(l *stockLot) int64 { return -l.ByLotSource }, change)
+				}
+			}
+		}
+	}
+
+	return applyLotChanges(tx, changeOrder, changes, info)
+}
+
+// takeLots списывает товар из партий по порядку в пределах capacity каждой партии. Возвращает, сколько не хватило
+func takeLots(lots []*stockLot, need int64, capacity func(l *stockLot) int64, change func(lotID string, delta int64)) int64 {
+	for _, l := range lots {
+		if need <= 0 {
+			break
+		}
+		take := min(capacity(l), need)
+		if take <= 0 {
+			continue
+		}
+		l.Remaining -= take
+		l.BySource -= take
+		l.ByLotSource -= take
+		need -= take
+		change(l.ID, -take)
+	}
+	return need
+}
+
+// putLots возвращает товар в партии по порядку в пределах capacity каждой партии. Возвращает, сколько не поместилось
+func putLots(lots []*stockLot, put int64, capacity func(l *stockLot) int64, change func(lotID string, delta int64)) int64 {
+	for _, l := range lots {
+		if put <= 0 {
+			break
+		}
+		give := min(capacity(l), put)
+		if give <= 0 {
+			continue
+		}
+		l.Remaining += give
+		l.BySource += give
+		l.ByLotSource += give
+		put -= give
+		change(l.ID, give)
+	}
+	return put
+}
+
+// loadLots получает партии товаров в порядке FEFO: партии с остатком и партии, из которых документ списывал товар.
+// Строки товаров уже заблокированы изменением остатка, поэтому партии одного товара параллельно не меняются
+func loadLots(tx dbtx, productIDs []string, info entity.MovementInfo) (map[string][]*stockLot, error) {
+	rows, err := tx.Queryx(`
+		SELECT l.id, l.product_id, l.remaining,
+			COALESCE(SUM(m.delta) FILTER (WHERE m.source_id = NULLIF($2, '')::UUID), 0),
+			COALESCE(SUM(m.delta) FILTER (WHERE m.lot_source_id = NULLIF($3, '')::UUID), 0),
+			COALESCE(l.expires_at < CURRENT_DATE, FALSE)
+		FROM product_lots l
+		LEFT JOIN lot_movements m ON m.lot_id = l.id
+			AND (m.source_id = NULLIF($2, '')::UUID OR m.lot_source_id = NULLIF($3, '')::UUID)
+		WHERE l.product_id = ANY($1::UUID[])
+		GROUP BY l.id
+		HAVING l.remaining > 0 OR COUNT(m.id) > 0
+		ORDER BY l.product_id, l.expires_at NULLS LAST, l.created_at, l.id
+	`, pq.Array(productIDs), info.SourceID, lotSource(info))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product lots: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]*stockLot)
+	for rows.Next() {
+		var l stockLot
+		if err := rows.Scan(&l.ID, &l.ProductID, &l.Remaining, &l.BySource, &l.ByLotSource, &l.Expired); err != nil {
+			return nil, fmt.Errorf("failed to scan product lot: %w", err)
+		}
+		res[l.ProductID] = append(res[l.ProductID], &l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product lots: %w", err)
+	}
+
+	return res, nil
+}
+
+// insertLots заводит партии прихода товара и записывает приход в движение по партиям. Возвращает количество в партиях
+func insertLots(tx dbtx, productID, branchID, companyID string, lots []entity.StockLot, info entity.MovementInfo) (int64, error) {
+	var total int64
+	for _, lot := range lots {
+		if lot.Quantity <= 0 {
+			continue
+		}
+
+		var lotID string
+		err := tx.QueryRowx(`
+			INSERT INTO product_lots (product_id, lot_number, expires_at, cost, quantity, remaining, source_type, source_id, company_id, branch_id)
+			VALUES ($1, $2, NULLIF($3, '')::DATE, $4, $5, $5, $6, NULLIF($7, '')::UUID, $8, $9)
+			RETURNING id
+		`, productID, lot.LotNumber, lot.ExpiresAt, lot.Cost, lot.Quantity, info.Reason, info.SourceID, companyID, branchID).Scan(&lotID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create lot for product %s: %w", productID, err)
+		}
+
+		if err = insertLotMovements(tx, []string{lotID}, map[string]int64{lotID: lot.Quantity}, info); err != nil {
+			return 0, err
+		}
+		total += lot.Quantity
+	}
+
+	return total, nil
+}
+
+// applyLotChanges меняет остатки партий одним запросом и записывает движение по партиям
+func applyLotChanges(tx dbtx, order []string, changes map[string]int64, info entity.MovementInfo) error {
+	var ids []string
+	for _, id := range order {
+		if changes[id] != 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString("UPDATE product_lots SET remaining = remaining + CASE id ")
+
+	args := []interface{}{}
+	for i, id := range ids {
+		queryBuilder.WriteString(fmt.Sprintf("WHEN $%d::UUID THEN $%d::BIGINT ", i*2+1, i*2+2))
+		args = append(args, id, changes[id])
+	}
+	queryBuilder.WriteString("ELSE 0 END WHERE id IN (")
+	for i := range ids {
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("$%d::UUID", i*2+1))
+	}
+	queryBuilder.WriteString(")")
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to update product lots: %w", err)
+	}
+
+	return insertLotMovements(tx, ids, changes, info)
+}
+
+// insertLotMovements записывает строки движения по партиям
+func insertLotMovements(tx dbtx, ids []string, changes map[string]int64, info entity.MovementInfo) error {
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(`
+		INSERT INTO lot_movements (lot_id, delta, reason, source_id, lot_source_id, created_by) VALUES
+	`)
+
+	args := []interface{}{}
+	for i, id := range ids {
+		startIdx := i * 6
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, NULLIF($%d, '')::UUID, NULLIF($%d, '')::UUID, NULLIF($%d, '')::UUID)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6))
+
+		args = append(args, id, changes[id], info.Reason, info.SourceID, lotSource(info), info.CreatedBy)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert lot movements: %w", err)
+	}
+
+	return nil
+}
+
+// transferredLots возвращает партии, которые перемещение списало с товаров исходного филиала, в порядке FEFO
+func transferredLots(tx dbtx, transferID string, productIDs []string) (map[string][]entity.StockLot, error) {
+	rows, err := tx.Queryx(`
+		SELECT l.product_id, l.lot_number, COALESCE(l.expires_at::TEXT, ''), l.cost, -SUM(m.delta)
+		FROM lot_movements m
+		JOIN product_lots l ON l.id = m.lot_id
+		WHERE m.source_id = $1 AND l.product_id = ANY($2::UUID[])
+		GROUP BY l.id
+		HAVING SUM(m.delta) < 0
+		ORDER BY l.product_id, l.expires_at NULLS LAST, l.created_at, l.id
+	`, transferID, pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transferred lots: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[string][]entity.StockLot)
+	for rows.Next() {
+		var productID string
+		var lot entity.StockLot
+		if err := rows.Scan(&productID, &lot.LotNumber, &lot.ExpiresAt, &lot.Cost, &lot.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan transferred lot: %w", err)
+		}
+		res[productID] = append(res[productID], lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transferred lots: %w", err)
+	}
+
+	return res, nil
+}
+
+// pickLots забирает из партий перемещения количество позиции в порядке FEFO. Остаток сверх партий идёт без партии
+func pickLots(carried []entity.StockLot, quantity int64) []entity.StockLot {
+	var picked []entity.StockLot
+	for i := range carried {
+		if quantity <= 0 {
+			break
+		}
+		take := min(carried[i].Quantity, quantity)
+		if take <= 0 {
+			continue
+		}
+		lot := carried[i]
+		lot.Quantity = take
+		picked = append(picked, lot)
+		carried[i].Quantity -= take
+		quantity -= take
+	}
+	return picked
+}
+
+// GetExpiringStock возвращает партии филиала с остатком, срок годности которых истекает в пределах горизонта,
+// вместе с уже просроченными. Итоги считаются по всем найденным партиям, а не только по странице
+func (p *productQuantity) GetExpiringStock(in *pb.ExpiringStockReq) (*pb.ExpiringStockList, error) {
+	days := in.Days
+	if days <= 0 {
+		days = defaultExpiryHorizon
+	}
+
+	args := []interface{}{in.CompanyId, in.BranchId, days}
+	filters := []string{
+		"l.company_id = $1", "l.branch_id = $2", "l.remaining > 0",
+		"l.expires_at IS NOT NULL", "l.expires_at <= CURRENT_DATE + $3::INT",
+	}
+	if in.ProductId != "" {
+		args = append(args, in.ProductId)
+		filters = append(filters, fmt.Sprintf("l.product_id = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			l.id, l.product_id, p.name, l.lot_number, l.expires_at::TEXT, l.expires_at - CURRENT_DATE,
+			l.cost, l.quantity, l.remaining, ROUND(l.remaining * l.cost, 2),
+			l.source_type, COALESCE(l.source_id::TEXT, ''), l.branch_id, l.created_at,
+			COUNT(*) OVER(), SUM(l.remaining) OVER(), SUM(ROUND(l.remaining * l.cost, 2)) OVER(),
+			COALESCE(SUM(ROUND(l.remaining * l.cost, 2)) FILTER (WHERE l.expires_at < CURRENT_DATE) OVER(), 0)
+		FROM product_lots l
+		JOIN products p ON p.id = l.product_id
+		WHERE %s
+		ORDER BY l.expires_at, p.name, l.id`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := p.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch expiring stock: %w", err)
+	}
+	defer rows.Close()
+
+	res := &pb.ExpiringStockList{}
+	for rows.Next() {
+		var lot pb.ProductLot
+		if err := rows.Scan(
+			&lot.Id,
+			&lot.ProductId,
+			&lot.ProductName,
+			&lot.LotNumber,
+			&lot.ExpiresAt,
+			&lot.DaysLeft,
+			&lot.Cost,
+			&lot.Quantity,
+			&lot.Remaining,
+			&lot.Value,
+			&lot.SourceType,
+			&lot.SourceId,
+			&lot.BranchId,
+			&lot.CreatedAt,
+			&res.TotalCount,
+			&res.TotalQuantity,
+			&res.TotalValue,
+			&res.ExpiredValue,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan expiring lot: %w", err)
+		}
+		res.Lots = append(res.Lots, &lot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating expiring lots: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,260 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"reflect"
+	"testing"
+)
+
+// lotChanges collects the lot changes in the order they are made.
+type lotChanges struct {
+	order []string
+	delta map[string]int64
+}
+
+func (c *lotChanges) change(lotID string, delta int64) {
+	if c.delta == nil {
+		c.delta = make(map[string]int64)
+	}
+	if _, ok := c.delta[lotID]; !ok {
+		c.order = append(c.order, lotID)
+	}
+	c.delta[lotID] += delta
+}
+
+func remaining(lots []*stockLot) map[string]int64 {
+	res := make(map[string]int64, len(lots))
+	for _, l := range lots {
+		res[l.ID] = l.Remaining
+	}
+	return res
+}
+
+func TestTakeLots(t *testing.T) {
+	byRemaining := func(l *stockLot) int64 { return l.Remaining }
+	bySource := func(l *stockLot) int64 { return min(l.Remaining, l.BySource) }
+	fresh := func(l *stockLot) int64 {
+		if l.Expired {
+			return 0
+		}
+		return l.Remaining
+	}
+
+	tests := []struct {
+		name          string
+		lots          []*stockLot
+		need          int64
+		capacity      func(l *stockLot) int64
+		wantShort     int64
+		wantRemaining map[string]int64
+		wantChanges   map[string]int64
+	}{
+		{
+			name:          "first lot covers the need",
+			lots:          []*stockLot{{ID: "a", Remaining: 10}, {ID: "b", Remaining: 5}},
+			need:          4,
+			capacity:      byRemaining,
+			wantRemaining: map[string]int64{"a": 6, "b": 5},
+			wantChanges:   map[string]int64{"a": -4},
+		},
+		{
+			name:          "need spans lots in order",
+			lots:          []*stockLot{{ID: "a", Remaining: 3}, {ID: "b", Remaining: 5}, {ID: "c", Remaining: 5}},
+			need:          6,
+			capacity:      byRemaining,
+			wantRemaining: map[string]int64{"a": 0, "b": 2, "c": 5},
+			wantChanges:   map[string]int64{"a": -3, "b": -3},
+		},
+		{
+			name:          "lots run out",
+			lots:          []*stockLot{{ID: "a", Remaining: 3}, {ID: "b", Remaining: 2}},
+			need:          8,
+			capacity:      byRemaining,
+			wantShort:     3,
+			wantRemaining: map[string]int64{"a": 0, "b": 0},
+			wantChanges:   map[string]int64{"a": -3, "b": -2},
+		},
+		{
+			name:          "empty lots are skipped",
+			lots:          []*stockLot{{ID: "a"}, {ID: "b", Remaining: 5}},
+			need:          2,
+			capacity:      byRemaining,
+			wantRemaining: map[string]int64{"a": 0, "b": 3},
+			wantChanges:   map[string]int64{"b": -2},
+		},
+		{
+			name:          "only what the document brought",
+			lots:          []*stockLot{{ID: "a", Remaining: 10}, {ID: "b", Remaining: 10, BySource: 4}},
+			need:          6,
+			capacity:      bySource,
+			wantShort:     2,
+			wantRemaining: map[string]int64{"a": 10, "b": 6},
+			wantChanges:   map[string]int64{"b": -4},
+		},
+		{
+			name:          "expired lots are skipped",
+			lots:          []*stockLot{{ID: "a", Remaining: 5, Expired: true}, {ID: "b", Remaining: 5}},
+			need:          7,
+			capacity:      fresh,
+			wantShort:     2,
+			wantRemaining: map[string]int64{"a": 5, "b": 0},
+			wantChanges:   map[string]int64{"b": -5},
+		},
+		{
+			name:          "nothing to take",
+			lots:          []*stockLot{{ID: "a", Remaining: 5}},
+			capacity:      byRemaining,
+			wantRemaining: map[string]int64{"a": 5},
+			wantChanges:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes lotChanges
+			short := takeLots(tt.lots, tt.need, tt.capacity, changes.change)
+			if short != tt.wantShort {
+				t.Errorf("takeLots() = %d, want %d", short, tt.wantShort)
+			}
+			if got := remaining(tt.lots); !reflect.DeepEqual(got, tt.wantRemaining) {
+				t.Errorf("takeLots() remaining = %v, want %v", got, tt.wantRemaining)
+			}
+			if !reflect.DeepEqual(changes.delta, tt.wantChanges) {
+				t.Errorf("takeLots() changes = %v, want %v", changes.delta, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestPutLots(t *testing.T) {
+	bySource := func(l *stockLot) int64 { return -l.BySource }
+
+	tests := []struct {
+		name          string
+		lots          []*stockLot
+		put           int64
+		wantLeft      int64
+		wantRemaining map[string]int64
+		wantChanges   map[string]int64
+	}{
+		{
+			name:          "return goes back to the lot it was taken from",
+			lots:          []*stockLot{{ID: "a", Remaining: 10}, {ID: "b", Remaining: 2, BySource: -5}},
+			put:           3,
+			wantRemaining: map[string]int64{"a": 10, "b": 5},
+			wantChanges:   map[string]int64{"b": 3},
+		},
+		{
+			name:          "return spans lots in order",
+			lots:          []*stockLot{{ID: "a", BySource: -2}, {ID: "b", Remaining: 1, BySource: -4}},
+			put:           5,
+			wantRemaining: map[string]int64{"a": 2, "b": 4},
+			wantChanges:   map[string]int64{"a": 2, "b": 3},
+		},
+		{
+			name:          "more than was taken stays without a lot",
+			lots:          []*stockLot{{ID: "a", BySource: -2}},
+			put:           5,
+			wantLeft:      3,
+			wantRemaining: map[string]int64{"a": 2},
+			wantChanges:   map[string]int64{"a": 2},
+		},
+		{
+			name:          "lots the document brought are not topped up",
+			lots:          []*stockLot{{ID: "a", Remaining: 4, BySource: 4}},
+			put:           2,
+			wantLeft:      2,
+			wantRemaining: map[string]int64{"a": 4},
+			wantChanges:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes lotChanges
+			left := putLots(tt.lots, tt.put, bySource, changes.change)
+			if left != tt.wantLeft {
+				t.Errorf("putLots() = %d, want %d", left, tt.wantLeft)
+			}
+			if got := remaining(tt.lots); !reflect.DeepEqual(got, tt.wantRemaining) {
+				t.Errorf("putLots() remaining = %v, want %v", got, tt.wantRemaining)
+			}
+			if !reflect.DeepEqual(changes.delta, tt.wantChanges) {
+				t.Errorf("putLots() changes = %v, want %v", changes.delta, tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestPickLots(t *testing.T) {
+	carried := func() []entity.StockLot {
+		return []entity.StockLot{
+			{LotNumber: "L1", ExpiresAt: "2026-11-01", Cost: 100, Quantity: 3},
+			{LotNumber: "L2", ExpiresAt: "2027-01-01", Cost: 110, Quantity: 5},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		quantity    int64
+		want        []entity.StockLot
+		wantCarried []int64
+	}{
+		{
+			name:        "from the first lot",
+			quantity:    2,
+			want:        []entity.StockLot{{LotNumber: "L1", ExpiresAt: "2026-11-01", Cost: 100, Quantity: 2}},
+			wantCarried: []int64{1, 5},
+		},
+		{
+			name:     "across lots",
+			quantity: 6,
+			want: []entity.StockLot{
+				{LotNumber: "L1", ExpiresAt: "2026-11-01", Cost: 100, Quantity: 3},
+				{LotNumber: "L2", ExpiresAt: "2027-01-01", Cost: 110, Quantity: 3},
+			},
+			wantCarried: []int64{0, 2},
+		},
+		{
+			name:     "more than the lots carry",
+			quantity: 10,
+			want: []entity.StockLot{
+				{LotNumber: "L1", ExpiresAt: "2026-11-01", Cost: 100, Quantity: 3},
+				{LotNumber: "L2", ExpiresAt: "2027-01-01", Cost: 110, Quantity: 5},
+			},
+			wantCarried: []int64{0, 0},
+		},
+		{
+			name:        "nothing",
+			quantity:    0,
+			wantCarried: []int64{3, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lots := carried()
+			got := pickLots(lots, tt.quantity)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pickLots() = %+v, want %+v", got, tt.want)
+			}
+			for i, l := range lots {
+				if l.Quantity != tt.wantCarried[i] {
+					t.Errorf("pickLots() left %d in lot %s, want %d", l.Quantity, l.LotNumber, tt.wantCarried[i])
+				}
+			}
+		})
+	}
+
+	t.Run("lines of one transfer share its lots", func(t *testing.T) {
+		lots := carried()
+		first := pickLots(lots, 4)
+		second := pickLots(lots, 4)
+		if len(first) != 2 || first[0].Quantity != 3 || first[1].Quantity != 1 {
+			t.Errorf("first pickLots() = %+v", first)
+		}
+		if len(second) != 1 || second[0].LotNumber != "L2" || second[0].Quantity != 4 {
+			t.Errorf("second pickLots() = %+v", second)
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package repo
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+type paymentTypesRepo struct {
+	db dbtx
+}
+
+func NewPaymentTypesRepo(db *sqlx.DB) usecase.PaymentTypesRepo {
+	return &paymentTypesRepo{db: db}
+}
+
+// paymentTypeColumns колонки способа оплаты в порядке сканирования scanPaymentType
+const paymentTypeColumns = `id, name, currency, is_cash, active, is_default, company_id, TO_CHAR(created_at, 'YYYY-MM-DD HH24:MI:SS')`
+
+// paymentTypeID подзапрос, находящий способ оплаты компании по id или названию. Совпадение по id важнее совпадения
+// по названию, поэтому способ, названный как id другого способа, не подменяет его.
+// Клиенты до перехода на способы оплаты присылают 'uzs', 'usd' или 'card', которые совпадают с названиями засеянных способов.
+// CAST вместо :: потому, что подзапрос используется и в именованных запросах sqlx
+func paymentTypeID(companyArg, refArg string) string {
+	return fmt.Sprintf("(SELECT id FROM payment_types WHERE company_id = %s AND (CAST(id AS TEXT) = %s OR name = %s) "+
+		"ORDER BY CAST(id AS TEXT) = %s DESC LIMIT 1)", companyArg, refArg, refArg, refArg)
+}
+
+// rowScanner строка результата: *sqlx.Row или *sqlx.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPaymentType читает способ оплаты из колонок paymentTypeColumns
+func scanPaymentType(row rowScanner, t *pb.PaymentType) error {
+	return row.Scan(&t.Id, &t.Name, &t.Currency, &t.IsCash, &t.Active, &t.IsDefault, &t.CompanyId, &t.CreatedAt)
+}
+
+// defaultPaymentTypes способы оплаты, которые получает каждая компания; 'uzs' используется по умолчанию
+const defaultPaymentTypes = `(VALUES ('uzs', 'uzs', TRUE, TRUE), ('usd', 'usd', TRUE, FALSE), ('card', 'uzs', FALSE, FALSE))
+	AS t (name, currency, is_cash, is_default)`
+
+// seedPaymentTypes создаёт способы оплаты по умолчанию, если у компании их ещё нет
+func seedPaymentTypes(tx dbtx, companyID string) error {
+	query := fmt.Sprintf(`
+		INSERT INTO payment_types (name, currency, is_cash, is_default, company_id)
+		SELECT t.name, t.currency, t.is_cash, t.is_default, $1::UUID
+		FROM %s
+		WHERE NOT EXISTS (SELECT 1 FROM payment_types WHERE company_id = $1::UUID)
+		ON CONFLICT DO NOTHING
+	`, defaultPaymentTypes)
+	if _, err := tx.Exec(query, companyID); err != nil {
+		return fmt.Errorf("failed to create default payment types: %w", err)
+	}
+
+	return nil
+}
+
+// moveDefaultPaymentType снимает отметку по умолчанию с остальных способов компании перед тем, как её получит способ id
+func moveDefaultPaymentType(tx dbtx, companyID, id string) error {
+	query := `UPDATE payment_types SET is_default = FALSE WHERE company_id = $1 AND is_default AND id::TEXT <> $2`
+	if _, err := tx.Exec(query, companyID, id); err != nil {
+		return fmt.Errorf("failed to reset default payment type: %w", err)
+	}
+	return nil
+}
+
+// paymentTypeName подзапрос, возвращающий название способа оплаты по колонке payment_type_id
+func paymentTypeName(column string) string {
+	return fmt.Sprintf("COALESCE((SELECT name FROM payment_types WHERE id = %s), '')", column)
+}
+
+// CreatePaymentType создаёт способ оплаты компании. Способ, отмеченный по умолчанию, забирает отметку у прежнего
+func (r *paymentTypesRepo) CreatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error) {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if in.IsDefault {
+		if err = moveDefaultPaymentType(tx, in.CompanyId, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &pb.PaymentType{}
+	query := `
+		INSERT INTO payment_types (name, currency, is_cash, active, is_default, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + paymentTypeColumns
+	err = scanPaymentType(tx.QueryRowx(query, in.Name, in.Currency, in.IsCash, in.Active, in.IsDefault, in.CompanyId), res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment type: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return res, nil
+}
+
+// UpdatePaymentType обновляет способ оплаты. Документы ссылаются на него по id, поэтому переименование видно и в старых документах.
+// Отметку по умолчанию можно только передать другому способу: снять её, не отметив другой способ, нельзя
+func (r *paymentTypesRepo) UpdatePaymentType(in *pb.PaymentType) (*pb.PaymentType, error) {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if in.IsDefault {
+		if err = moveDefaultPaymentType(tx, in.CompanyId, in.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &pb.PaymentType{}
+	query := `
+		UPDATE payment_types SET name = $1, currency = $2, is_cash = $3, active = $4, is_default = is_default OR $5
+		WHERE id = $6 AND company_id = $7
+		RETURNING ` + paymentTypeColumns
+	err = scanPaymentType(tx.QueryRowx(query, in.Name, in.Currency, in.IsCash, in.Active, in.IsDefault, in.Id, in.CompanyId), res)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("payment type %s not found", in.Id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment type: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return res, nil
+}
+
+// GetPaymentType возвращает способ оплаты по id или названию, совпадение по id важнее.
+// Без id возвращается способ оплаты компании по умолчанию
+func (r *paymentTypesRepo) GetPaymentType(in *pb.PaymentTypeID) (*pb.PaymentType, error) {
+	res := &pb.PaymentType{}
+
+	query := `
+		SELECT ` + paymentTypeColumns + `
+		FROM payment_types
+		WHERE company_id = $1 AND (id::TEXT = $2 OR name = $2 OR ($2 = '' AND is_default))
+		ORDER BY id::TEXT = $2 DESC
+		LIMIT 1
+	`
+	err := scanPaymentType(r.db.QueryRowx(query, in.CompanyId, in.Id), res)
+	if errors.Is(err, sql.ErrNoRows) {
+		if in.Id == "" {
+			return nil, errors.New("company has no default payment type")
+		}
+		return nil, fmt.Errorf("payment type %q not found", in.Id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment type: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetListPaymentTypes возвращает способы оплаты компании
+func (r *paymentTypesRepo) GetListPaymentTypes(in *pb.PaymentTypeFilter) (*pb.PaymentTypeList, error) {
+	args := []interface{}{in.CompanyId}
+
+	query := `
+		SELECT ` + paymentTypeColumns + `
+		FROM payment_types
+		WHERE company_id = $1
+	`
+	if in.Name != "" {
+		args = append(args, "%"+in.Name+"%")
+		query += fmt.Sprintf(" AND name ILIKE $%d", len(args))
+	}
+	if in.ActiveOnly {
+		query += " AND active"
+	}
+	query += " ORDER BY created_at, name"
+
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment types: %w", err)
+	}
+	defer rows.Close()
+
+	var types []*pb.PaymentType
+	for rows.Next() {
+		var t pb.PaymentType
+		if err := scanPaymentType(rows, &t); err != nil {
+			return nil, fmt.Errorf("failed to scan payment type: %w", err)
+		}
+		types = append(types, &t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating payment types: %w", err)
+	}
+
+	return &pb.PaymentTypeList{
+		PaymentTypes: types,
+		TotalCount:   int64(len(types)),
+	}, nil
+}
+
+// DeletePaymentType удаляет способ оплаты. Способ, на который ссылаются документы, удалить нельзя — его нужно деактивировать.
+// Способ по умолчанию не удаляется
+func (r *paymentTypesRepo) DeletePaymentType(in *pb.PaymentTypeID) (*pb.Message, error) {
+	query := `DELETE FROM payment_types WHERE id = $1 AND company_id = $2 AND NOT is_default`
+
+	res, err := r.db.Exec(query, in.Id, in.CompanyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete payment type, deactivate it if it is used by documents: %w", err)
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return nil, fmt.Errorf("no records deleted")
+	}
+
+	return &pb.Message{Message: fmt.Sprintf("Deleted %d payment type(s)", rows)}, nil
+}
+
+// EnsureDefaultPaymentTypes создаёт способы оплаты по умолчанию, если у компании их ещё нет
+func (r *paymentTypesRepo) EnsureDefaultPaymentTypes(companyID string) error {
+	return seedPaymentTypes(r.db, companyID)
+}
@@ -0,0 +1,135 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+// Таблицы оплат документов и их внешний ключ
+const (
+	salePayments     = "sale_payments"
+	purchasePayments = "purchase_payments"
+)
+
+var paymentOwner = map[string]string{
+	salePayments:     "sale_id",
+	purchasePayments: "purchase_id",
+}
+
+// insertPayments сохраняет оплаты документа одним запросом
+func insertPayments(db dbtx, table, docID string, payments []entity.Payment, companyID, branchID string) error {
+	if len(payments) == 0 {
+		return nil
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(fmt.Sprintf(`
+		INSERT INTO %s (%s, amount, payment_type_id, currency, cash_flow_id, company_id, branch_id) VALUES
+	`, table, paymentOwner[table]))
+
+	args := []interface{}{}
+	for i, p := range payments {
+		startIdx := i * 7
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, %s, $%d, NULLIF($%d, '')::UUID, $%d, $%d)",
+			startIdx+1, startIdx+2, paymentTypeID(fmt.Sprintf("$%d", startIdx+6), fmt.Sprintf("$%d", startIdx+3)),
+			startIdx+4, startIdx+5, startIdx+6, startIdx+7))
+
+		args = append(args, docID, p.Amount, p.PaymentMethod, p.Currency, p.CashFlowID, companyID, branchID)
+	}
+
+	if _, err := db.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert payments: %w", err)
+	}
+
+	return nil
+}
+
+// getPayments возвращает оплаты документа в порядке их создания
+func getPayments(db dbtx, table, docID string) ([]*pb.SplitPayment, error) {
+	query := fmt.Sprintf(`
+		SELECT id, amount, %s, currency, COALESCE(cash_flow_id::TEXT, ''), created_at
+		FROM %s WHERE %s = $1
+		ORDER BY created_at, id
+	`, paymentTypeName("payment_type_id"), table, paymentOwner[table])
+
+	rows, err := db.Queryx(query, docID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query payments: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*pb.SplitPayment
+	for rows.Next() {
+		var p pb.SplitPayment
+		if err := rows.Scan(&p.Id, &p.Amount, &p.PaymentMethod, &p.Currency, &p.CashFlowId, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan payment: %w", err)
+		}
+		payments = append(payments, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating payments: %w", err)
+	}
+
+	return payments, nil
+}
+
+// AddSalePayments сохраняет оплаты продажи
+func (r *salesRepoImpl) AddSalePayments(saleID string, payments []entity.Payment, companyID, branchID string) error {
+	return insertPayments(r.db, salePayments, saleID, payments, companyID, branchID)
+}
+
+// AddPurchasePayments сохраняет оплаты закупки
+func (r *purchasesRepoImpl) AddPurchasePayments(purchaseID string, payments []entity.Payment, companyID, branchID string) error {
+	return insertPayments(r.db, purchasePayments, purchaseID, payments, companyID, branchID)
+}
+
+// reducePayments уменьшает оплаты документа на указанную сумму, начиная с последней оплаты.
+// Оплата, уменьшенная до нуля, удаляется
+func reducePayments(db dbtx, table, docID string, amount float64) error {
+	payments, err := getPayments(db, table, docID)
+	if err != nil {
+		return err
+	}
+
+	left := decimal.NewFromFloat(amount)
+	for i := len(payments) - 1; i >= 0 && left.IsPositive(); i-- {
+		paid := decimal.NewFromFloat(payments[i].Amount)
+		if paid.LessThanOrEqual(left) {
+			if _, err := db.Exec(fmt.Sprintf(`DELETE FROM %s WHERE id = $1`, table), payments[i].Id); err != nil {
+				return fmt.Errorf("failed to delete payment: %w", err)
+			}
+			left = left.Sub(paid)
+			continue
+		}
+
+		rest := paid.Sub(left).Round(2).InexactFloat64()
+		if _, err := db.Exec(fmt.Sprintf(`UPDATE %s SET amount = $1 WHERE id = $2`, table), rest, payments[i].Id); err != nil {
+			return fmt.Errorf("failed to reduce payment: %w", err)
+		}
+		left = decimal.Zero
+	}
+
+	if left.IsPositive() {
+		return fmt.Errorf("payments are %v short of the reduction", left.Round(2))
+	}
+
+	return nil
+}
+
+// ReduceSalePayments уменьшает оплаты продажи на указанную сумму
+func (r *salesRepoImpl) ReduceSalePayments(saleID string, amount float64) error {
+	return reducePayments(r.db, salePayments, saleID, amount)
+}
+
+// ReducePurchasePayments уменьшает оплаты закупки на указанную сумму
+func (r *purchasesRepoImpl) ReducePurchasePayments(purchaseID string, amount float64) error {
+	return reducePayments(r.db, purchasePayments, purchaseID, amount)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 	"strings"
 )
@@ -18,7 +19,7 @@ type productRepo struct {
 }
 
 type productQuantity struct {
-	db *sqlx.DB
+	db dbtx
 }
 
 func NewProductRepo(db *sqlx.DB) usecase.ProductsRepo {
@@ -201,23 +202,261 @@ func (p *productRepo) GetListProductCategory(in *pb.CategoryName) (*pb.CategoryL
 
 // ------------------- Product CRUD ----------------------------------------------------------------------------------
 
-func (p *productRepo) CreateProduct(in *pb.CreateProductRequest) (*pb.Product, error) {
+// productColumns колонки строки товара в порядке productDest для запросов, читающих products без алиаса.
+// Артикул, штрихкоды, значения варианта и единицы берутся из карточки, оси варианта - из карточки родителя
+const productColumns = `
+	id, category_id, name, image_url, bill_format, incoming_price, standard_price,
+	total_count, created_by, created_at, company_id, branch_id, catalog_id, min_stock, reorder_qty,
+	COALESCE((SELECT sku FROM catalog_items c WHERE c.id = products.catalog_id), ''),
+	ARRAY(SELECT barcode FROM catalog_barcodes b WHERE b.catalog_id = products.catalog_id ORDER BY b.created_at, b.barcode),
+	COALESCE(parent_id::TEXT, ''), price_override,
+	COALESCE((SELECT COALESCE(a.variant_axes, c.variant_axes)
+	          FROM catalog_items c LEFT JOIN catalog_items a ON a.id = c.parent_id
+	          WHERE c.id = products.catalog_id), '{}'),
+	COALESCE((SELECT variant_values FROM catalog_items c WHERE c.id = products.catalog_id), '{}'),
+	COALESCE((SELECT base_unit FROM catalog_items c WHERE c.id = products.catalog_id), ''),
+	COALESCE((SELECT JSON_AGG(JSON_BUILD_OBJECT('name', u.name, 'factor', u.factor) ORDER BY u.factor, u.name)
+	          FROM catalog_units u WHERE u.catalog_id = products.catalog_id), '[]'),
+	COALESCE((SELECT is_serialized FROM catalog_items c WHERE c.id = products.catalog_id), FALSE)`
+
+func productDest(p *pb.Product) []interface{} {
+	return []interface{}{&p.Id, &p.CategoryId, &p.Name, &p.ImageUrl, &p.BillFormat, &p.IncomingPrice, &p.StandardPrice,
+		&p.TotalCount, &p.CreatedBy, &p.CreatedAt, &p.CompanyId, &p.BranchId, &p.CatalogId, &p.MinStock, &p.ReorderQty,
+		&p.Sku, pq.Array(&p.Barcodes),
+		&p.ParentId, &p.PriceOverride,
+		pq.Array(&p.VariantAxes),
+		pq.Array(&p.VariantValues),
+		&p.BaseUnit, productUnits{&p.Units},
+		&p.IsSerialized}
+}
+
+// fetchProduct читает строку товара вместе с данными карточки
+func fetchProduct(tx dbtx, id string) (*pb.Product, error) {
 	var product pb.Product
+	if err := tx.QueryRowx(`SELECT `+productColumns+` FROM products WHERE id = $1`, id).Scan(productDest(&product)...); err != nil {
+		return nil, fmt.Errorf("failed to fetch product: %w", err)
+	}
+	return &product, nil
+}
 
-	query := `
-		INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, company_id, branch_id, created_by, total_count)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, 0))
-		RETURNING id, category_id, name, image_url, bill_format, incoming_price, standard_price, total_count, company_id, branch_id, created_by, created_at
-	`
-	err := p.db.QueryRowx(query, in.CategoryId, in.Name, in.ImageUrl, in.BillFormat, in.IncomingPrice, in.StandardPrice, in.CompanyId, in.BranchId, in.CreatedBy, in.TotalCount).
-		Scan(&product.Id, &product.CategoryId, &product.Name, &product.ImageUrl, &product.BillFormat, &product.IncomingPrice,
-			&product.StandardPrice, &product.TotalCount, &product.CompanyId, &product.BranchId, &product.CreatedBy, &product.CreatedAt)
+func (p *productRepo) CreateProduct(in *pb.CreateProductRequest) (*pb.Product, error) {
+	tx, err := p.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
+	// Без catalog_id создаётся новая карточка, иначе товар каталога заводится в этом филиале со своей ценой и остатком
+	card, err := resolveCatalogItem(tx, in.CatalogId, in.CompanyId, in.CreatedBy, catalogItem{
+		Name:       in.Name,
+		ImageURL:   in.ImageUrl,
+		BillFormat: in.BillFormat,
+		BaseUnit:   in.BaseUnit,
+		Serialized: in.IsSerialized,
+		Axes:       in.VariantAxes,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create product: %w", err)
+		return nil, err
 	}
 
-	return &product, nil
+	// Вариант каталога заводится в филиале под строкой своего родителя
+	var parentID string
+	var priceOverride bool
+	if card.ParentID != "" {
+		if parentID, err = ensureBranchParent(tx, card.ParentID, in.CategoryId, in.BranchId, in.CompanyId, in.CreatedBy); err != nil {
+			return nil, err
+		}
+		if priceOverride, err = inheritParentPrices(tx, parentID, in); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := insertProduct(tx, in, card, parentID, priceOverride)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := fetchProduct(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return product, nil
+}
+
+// insertProduct заводит строку карточки в филиале: артикул и штрихкоды карточки, внутренний штрихкод
+// и начальный остаток в журнале движения товаров. Родитель вариантов только группирует их,
+// поэтому остатка и штрихкодов у него нет
+func insertProduct(tx dbtx, in *pb.CreateProductRequest, card catalogItem, parentID string, priceOverride bool) (string, error) {
+	isParent := len(card.Axes) > 0
+	if isParent && in.TotalCount != 0 {
+		return "", errors.New("parent product cannot hold stock: stock is kept by its variants")
+	}
+	if isParent && len(in.Barcodes) > 0 {
+		return "", errors.New("parent product cannot have barcodes: barcodes belong to its variants")
+	}
+
+	var id string
+	err := tx.QueryRowx(`
+		INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, company_id, branch_id, created_by, total_count, catalog_id,
+			min_stock, reorder_qty, parent_id, is_parent, price_override)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, 0), $11, $12, $13, NULLIF($14, '')::UUID, $15, $16)
+		RETURNING id`,
+		in.CategoryId, card.Name, card.ImageURL, card.BillFormat, in.IncomingPrice, in.StandardPrice, in.CompanyId, in.BranchId, in.CreatedBy, in.TotalCount, card.ID,
+		in.MinStock, in.ReorderQty, parentID, isParent, priceOverride).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to create product: %w", err)
+	}
+
+	// Артикул и штрихкоды принадлежат карточке и видны во всех филиалах
+	if in.Sku != "" {
+		if err = setCatalogSKU(tx, card.ID, in.Sku); err != nil {
+			return "", err
+		}
+	}
+	if err = addCatalogBarcodes(tx, card.ID, in.CompanyId, in.Barcodes); err != nil {
+		return "", err
+	}
+	if len(in.Units) > 0 {
+		if err = setCatalogUnits(tx, card.ID, in.CompanyId, in.Units); err != nil {
+			return "", err
+		}
+	}
+	// Товар без штрихкода получает внутренний код компании
+	if !isParent {
+		if err = ensureCatalogBarcode(tx, card.ID, in.CompanyId); err != nil {
+			return "", err
+		}
+	}
+
+	// Начальный остаток тоже попадает в журнал движения товаров
+	if in.TotalCount != 0 {
+		err = insertMovements(tx, []movement{{
+			ProductID: id,
+			BranchID:  in.BranchId,
+			CompanyID: in.CompanyId,
+			Delta:     in.TotalCount,
+			Balance:   in.TotalCount,
+		}}, entity.MovementInfo{Reason: entity.MovementAdjustment, CreatedBy: in.CreatedBy})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+// CreateVariant заводит вариант родителя в филиале: карточку со значениями по осям родителя и строку со своим остатком
+// и штрихкодом. Без своей цены продажи вариант продаётся по цене родителя и следует за её изменениями
+func (p *productRepo) CreateVariant(in *pb.CreateVariantReq) (*pb.Product, error) {
+	tx, err := p.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var parent struct {
+		ID         string         `db:"id"`
+		CategoryID string         `db:"category_id"`
+		CatalogID  string         `db:"catalog_id"`
+		IsParent   bool           `db:"is_parent"`
+		Name       string         `db:"name"`
+		ImageURL   string         `db:"image_url"`
+		BillFormat string         `db:"bill_format"`
+		BaseUnit   string         `db:"base_unit"`
+		Serialized bool           `db:"is_serialized"`
+		Axes       pq.StringArray `db:"variant_axes"`
+	}
+	err = tx.Get(&parent, `
+		SELECT p.id, p.category_id, p.catalog_id, p.is_parent, c.name, c.image_url, c.bill_format, c.base_unit, c.is_serialized, c.variant_axes
+		FROM products p
+		JOIN catalog_items c ON c.id = p.catalog_id
+		WHERE p.id = $1 AND p.company_id = $2 AND p.branch_id = $3`,
+		in.ParentId, in.CompanyId, in.BranchId)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = fmt.Errorf("parent product %s not found in branch", in.ParentId)
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parent product: %w", err)
+	}
+	if !parent.IsParent {
+		err = fmt.Errorf("product %s has no variant axes", in.ParentId)
+		return nil, err
+	}
+	if len(in.VariantValues) != len(parent.Axes) {
+		err = fmt.Errorf("variant needs one value for each axis (%s), got %d", strings.Join(parent.Axes, ", "), len(in.VariantValues))
+		return nil, err
+	}
+
+	card, err := resolveCatalogItem(tx, "", in.CompanyId, in.CreatedBy, catalogItem{
+		Name:       parent.Name + " " + strings.Join(in.VariantValues, " / "),
+		ImageURL:   parent.ImageURL,
+		BillFormat: parent.BillFormat,
+		BaseUnit:   parent.BaseUnit,
+		Serialized: parent.Serialized,
+		ParentID:   parent.CatalogID,
+		Values:     in.VariantValues,
+	})
+	if isUniqueViolation(err) {
+		return nil, fmt.Errorf("variant %s already exists: add it to the branch by its catalog_id", strings.Join(in.VariantValues, " / "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Вариант считается и продаётся в тех же единицах, что и родитель
+	_, err = tx.Exec(`
+		INSERT INTO catalog_units (catalog_id, name, factor, company_id)
+		SELECT $1, name, factor, company_id FROM catalog_units WHERE catalog_id = $2`,
+		card.ID, parent.CatalogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy parent units: %w", err)
+	}
+
+	req := &pb.CreateProductRequest{
+		CategoryId:    parent.CategoryID,
+		IncomingPrice: in.IncomingPrice,
+		StandardPrice: in.StandardPrice,
+		CompanyId:     in.CompanyId,
+		BranchId:      in.BranchId,
+		CreatedBy:     in.CreatedBy,
+		TotalCount:    in.TotalCount,
+		Sku:           in.Sku,
+		Barcodes:      in.Barcodes,
+	}
+	priceOverride, err := inheritParentPrices(tx, parent.ID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := insertProduct(tx, req, card, parent.ID, priceOverride)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := fetchProduct(tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return product, nil
 }
 
 func (p *productRepo) CreateBulkProducts(in *pb.CreateBulkProductsRequest) (*pb.BulkCreateResponse, error) {
@@ -229,20 +468,41 @@ func (p *productRepo) CreateBulkProducts(in *pb.CreateBulkProductsRequest) (*pb.
 
 	// Prepare the query for inserting products
 	query := `
-        INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, company_id, branch_id, created_by, total_count)
-        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, 0))
-        RETURNING id, category_id, name, image_url, bill_format, incoming_price, standard_price, total_count, created_by, created_at
+        INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, company_id, branch_id, created_by, total_count, catalog_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, COALESCE($10, 0), $11)
+        RETURNING id
     `
 
 	var createdProducts []*pb.Product
+	var movements []movement
 
 	// Iterate over the list of products and insert each one
 	for _, productReq := range in.Products {
-		var product pb.Product
-		err := tx.QueryRowx(query, in.CategoryId, productReq.Name, "https://smartadmin.uz/static/media/gif2.aff05f0cb04b5d100ae4.png", productReq.BillFormat, productReq.IncomingPrice,
-			productReq.StandardPrice, in.CompanyId, in.BranchId, in.CreatedBy, productReq.TotalCount).
-			Scan(&product.Id, &product.CategoryId, &product.Name, &product.ImageUrl, &product.BillFormat, &product.IncomingPrice,
-				&product.StandardPrice, &product.TotalCount, &product.CreatedBy, &product.CreatedAt)
+		var id string
+		var product *pb.Product
+
+		// Every bulk product gets its own catalog item
+		card, err := resolveCatalogItem(tx, "", in.CompanyId, in.CreatedBy, catalogItem{
+			Name:       productReq.Name,
+			ImageURL:   "https://smartadmin.uz/static/media/gif2.aff05f0cb04b5d100ae4.png",
+			BillFormat: productReq.BillFormat,
+		})
+		if err == nil {
+			err = tx.QueryRowx(query, in.CategoryId, card.Name, card.ImageURL, card.BillFormat, productReq.IncomingPrice,
+				productReq.StandardPrice, in.CompanyId, in.BranchId, in.CreatedBy, productReq.TotalCount, card.ID).Scan(&id)
+		}
+		if err == nil && productReq.Sku != "" {
+			err = setCatalogSKU(tx, card.ID, productReq.Sku)
+		}
+		if err == nil {
+			err = addCatalogBarcodes(tx, card.ID, in.CompanyId, productReq.Barcodes)
+		}
+		if err == nil {
+			err = ensureCatalogBarcode(tx, card.ID, in.CompanyId)
+		}
+		if err == nil {
+			product, err = fetchProduct(tx, id)
+		}
 
 		if err != nil {
 			// Roll back the transaction on error
@@ -253,7 +513,26 @@ func (p *productRepo) CreateBulkProducts(in *pb.CreateBulkProductsRequest) (*pb.
 		}
 
 		// Add the successfully created product to the response list
-		createdProducts = append(createdProducts, &product)
+		createdProducts = append(createdProducts, product)
+
+		if product.TotalCount != 0 {
+			movements = append(movements, movement{
+				ProductID: product.Id,
+				BranchID:  in.BranchId,
+				CompanyID: in.CompanyId,
+				Delta:     product.TotalCount,
+				Balance:   product.TotalCount,
+			})
+		}
+	}
+
+	// Record the initial stock in the movements ledger
+	err = insertMovements(tx, movements, entity.MovementInfo{Reason: entity.MovementAdjustment, CreatedBy: in.CreatedBy})
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("failed to rollback transaction after error: %w", rollbackErr)
+		}
+		return nil, err
 	}
 
 	// Commit the transaction
@@ -298,7 +577,8 @@ func (p *productRepo) UpdateProduct(in *pb.UpdateProductRequest) (*pb.Product, e
 		argCounter++
 	}
 	if in.StandardPrice != 0 {
-		query += fmt.Sprintf("standard_price = $%d, ", argCounter)
+		// Своя цена отрывает вариант от цены родителя
+		query += fmt.Sprintf("standard_price = $%d, price_override = parent_id IS NOT NULL, ", argCounter)
 		args = append(args, in.StandardPrice)
 		argCounter++
 	}
@@ -319,11 +599,30 @@ func (p *productRepo) UpdateProduct(in *pb.UpdateProductRequest) (*pb.Product, e
 
 	// Updated query with branch_id check
 	query = query[:len(query)-2] + fmt.Sprintf(" WHERE id = $%d AND company_id = $%d AND branch_id = $%d "+
-		"RETURNING id, category_id, name, bill_format, incoming_price, standard_price, total_count, created_by, created_at, image_url",
+		"RETURNING id, category_id, name, bill_format, incoming_price, standard_price, total_count, created_by, created_at, image_url, catalog_id, "+
+		"min_stock, reorder_qty",
 		argCounter, argCounter+1, argCounter+2)
 	args = append(args, in.Id, in.CompanyId, in.BranchId)
 
-	err := p.db.QueryRowx(query, args...).Scan(
+	tx, err := p.db.Beginx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Запоминаем текущий остаток, чтобы записать ручное изменение в журнал
+	var oldCount int64
+	err = tx.Get(&oldCount, `SELECT total_count FROM products WHERE id = $1 AND company_id = $2 AND branch_id = $3 FOR UPDATE`,
+		in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update product: %w", err)
+	}
+
+	err = tx.QueryRowx(query, args...).Scan(
 		&product.Id,
 		&product.CategoryId,
 		&product.Name,
@@ -334,15 +633,121 @@ func (p *productRepo) UpdateProduct(in *pb.UpdateProductRequest) (*pb.Product, e
 		&product.CreatedBy,
 		&product.CreatedAt,
 		&product.ImageUrl,
+		&product.CatalogId,
+		&product.MinStock,
+		&product.ReorderQty,
 	)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to update product: %w", err)
 	}
 
+	// Название, картинка и формат чека общие для карточки, цены и остаток у каждого филиала свои
+	if in.Name != "" || in.BillFormat != "" || in.ImageUrl != "" {
+		if err = syncCatalogItem(tx, product.Id); err != nil {
+			return nil, err
+		}
+	}
+	if in.Sku != "" {
+		if err = setCatalogSKU(tx, product.CatalogId, in.Sku); err != nil {
+			return nil, err
+		}
+	}
+	if len(in.Barcodes) > 0 {
+		if err = replaceCatalogBarcodes(tx, product.CatalogId, in.CompanyId, in.Barcodes); err != nil {
+			return nil, err
+		}
+	}
+	// Базовая единица только называет шаг, в котором уже считается остаток, поэтому её можно переименовать
+	if in.BaseUnit != "" {
+		if _, err = tx.Exec(`UPDATE catalog_items SET base_unit = $1 WHERE id = $2`, in.BaseUnit, product.CatalogId); err != nil {
+			return nil, fmt.Errorf("failed to update base unit: %w", err)
+		}
+	}
+	if len(in.Units) > 0 {
+		if err = setCatalogUnits(tx, product.CatalogId, in.CompanyId, in.Units); err != nil {
+			return nil, err
+		}
+	}
+	// Варианты без своей цены продаются по цене родителя
+	if in.StandardPrice != 0 {
+		_, err = tx.Exec(`
+			UPDATE products v
+			SET standard_price = p.standard_price
+			FROM products p
+			WHERE p.id = $1 AND p.is_parent AND v.parent_id = p.id AND NOT v.price_override`,
+			product.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update variant prices: %w", err)
+		}
+	}
+
+	// Ручная цена закупки переоценивает остаток: слои себестоимости берут её, чтобы FIFO не вернул старую
+	if in.IncomingPrice != 0 {
+		_, err = tx.Exec(`UPDATE cost_layers SET unit_cost = $1 WHERE product_id = $2 AND remaining > 0`, in.IncomingPrice, product.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revalue cost layers: %w", err)
+		}
+	}
+
+	if delta := product.TotalCount - oldCount; delta != 0 {
+		err = insertMovements(tx, []movement{{
+			ProductID: product.Id,
+			BranchID:  in.BranchId,
+			CompanyID: in.CompanyId,
+			Delta:     delta,
+			Balance:   product.TotalCount,
+		}}, entity.MovementInfo{Reason: entity.MovementAdjustment})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if product, err = fetchProduct(tx, product.Id); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return product, nil
 }
 
+// GetProductByBarcode находит товар филиала по штрихкоду или артикулу его карточки.
+// Совпадение по штрихкоду важнее совпадения по артикулу
+func (p *productRepo) GetProductByBarcode(in *pb.GetProductByBarcodeReq) (*pb.Product, error) {
+	var id string
+	err := p.db.Get(&id, `
+		SELECT p.id
+		FROM products p
+		JOIN catalog_items c ON c.id = p.catalog_id
+		LEFT JOIN catalog_barcodes b ON b.catalog_id = c.id AND b.barcode = $1
+		WHERE p.company_id = $2 AND p.branch_id = $3 AND (b.id IS NOT NULL OR c.sku = $1)
+		ORDER BY b.id IS NULL
+		LIMIT 1`,
+		in.Barcode, in.CompanyId, in.BranchId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("product with barcode %s not found in branch", in.Barcode)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find product by barcode: %w", err)
+	}
+
+	return p.GetProduct(&pb.GetProductRequest{Id: id, CompanyId: in.CompanyId, BranchId: in.BranchId})
+}
+
+// SetStockThresholds задаёт порог низкого остатка и количество для дозаказа строки товара филиала
+func (p *productRepo) SetStockThresholds(in *pb.StockThresholdsReq) (*pb.Product, error) {
+	_, err := p.db.Exec(`UPDATE products SET min_stock = $1, reorder_qty = $2 WHERE id = $3 AND company_id = $4 AND branch_id = $5`,
+		in.MinStock, in.ReorderQty, in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set stock thresholds: %w", err)
+	}
+
+	return p.GetProduct(&pb.GetProductRequest{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId})
+}
+
 func (p *productRepo) DeleteProduct(in *pb.GetProductRequest) (*pb.Message, error) {
 	query := `DELETE FROM products WHERE id = $1 AND company_id = $2`
 
@@ -364,22 +769,10 @@ func (p *productRepo) GetProduct(in *pb.GetProductRequest) (*pb.Product, error)
 	}
 
 	var res pb.Product
-	query := `SELECT id, category_id, name, image_url, bill_format, incoming_price, standard_price,
-          total_count, created_by, created_at, branch_id FROM products WHERE id = $1 AND company_id = $2 AND branch_id = $3`
+	query := `SELECT ` + productColumns + `
+          FROM products WHERE id = $1 AND company_id = $2 AND branch_id = $3`
 
-	err := p.db.QueryRowx(query, in.Id, in.CompanyId, in.BranchId).Scan(
-		&res.Id,
-		&res.CategoryId,
-		&res.Name,
-		&res.ImageUrl,
-		&res.BillFormat,
-		&res.IncomingPrice,
-		&res.StandardPrice,
-		&res.TotalCount,
-		&res.CreatedBy,
-		&res.CreatedAt,
-		&res.BranchId, // Include branch_id in the result scan
-	)
+	err := p.db.QueryRowx(query, in.Id, in.CompanyId, in.BranchId).Scan(productDest(&res)...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get product: %w", err)
 	}
@@ -420,6 +813,34 @@ func (p *productRepo) GetProductList(in *pb.ProductFilter) (*pb.ProductList, err
 		args = append(args, in.CreatedAt)
 		argIndex++
 	}
+	// Фильтр по карточке каталога
+	if in.CatalogId != "" {
+		conditions = append(conditions, fmt.Sprintf("catalog_id = $%d", argIndex))
+		args = append(args, in.CatalogId)
+		argIndex++
+	}
+	// Фильтр по штрихкоду
+	if in.Barcode != "" {
+		conditions = append(conditions, fmt.Sprintf("catalog_id IN (SELECT catalog_id FROM catalog_barcodes WHERE barcode = $%d)", argIndex))
+		args = append(args, strings.TrimSpace(in.Barcode))
+		argIndex++
+	}
+	// Фильтр по артикулу
+	if in.Sku != "" {
+		conditions = append(conditions, fmt.Sprintf("catalog_id IN (SELECT id FROM catalog_items WHERE sku = $%d)", argIndex))
+		args = append(args, strings.TrimSpace(in.Sku))
+		argIndex++
+	}
+	// Фильтр по родителю вариантов
+	if in.ParentId != "" {
+		conditions = append(conditions, fmt.Sprintf("parent_id = $%d", argIndex))
+		args = append(args, in.ParentId)
+		argIndex++
+	}
+	// При группировке варианты выводятся под родителем, а не отдельными строками
+	if in.GroupVariants {
+		conditions = append(conditions, "parent_id IS NULL")
+	}
 	// Фильтр по минимальному количеству
 	if in.TotalCount > 0 {
 		conditions = append(conditions, fmt.Sprintf("total_count <= $%d", argIndex))
@@ -436,13 +857,11 @@ func (p *productRepo) GetProductList(in *pb.ProductFilter) (*pb.ProductList, err
 
 	// Формирование основного запроса
 	baseQuery := fmt.Sprintf(`
-		SELECT 
-			id, category_id, name, image_url, bill_format, incoming_price, 
-			standard_price, total_count, created_by, created_at, branch_id,
+		SELECT %s,
 			%s
 		FROM products
 		WHERE %s
-		ORDER BY created_at DESC`, countQuery, strings.Join(conditions, " AND "))
+		ORDER BY created_at DESC`, productColumns, countQuery, strings.Join(conditions, " AND "))
 
 	// Добавление пагинации
 	if in.Limit > 0 && in.Page > 0 {
@@ -460,20 +879,7 @@ func (p *productRepo) GetProductList(in *pb.ProductFilter) (*pb.ProductList, err
 	var totalCount int64
 	for rows.Next() {
 		var product pb.Product
-		if err := rows.Scan(
-			&product.Id,
-			&product.CategoryId,
-			&product.Name,
-			&product.ImageUrl,
-			&product.BillFormat,
-			&product.IncomingPrice,
-			&product.StandardPrice,
-			&product.TotalCount,
-			&product.CreatedBy,
-			&product.CreatedAt,
-			&product.BranchId,
-			&totalCount, // Сканируем результат подзапроса
-		); err != nil {
+		if err := rows.Scan(append(productDest(&product), &totalCount)...); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 		products = append(products, &product)
@@ -484,6 +890,12 @@ func (p *productRepo) GetProductList(in *pb.ProductFilter) (*pb.ProductList, err
 		return nil, fmt.Errorf("error while iterating rows: %w", err)
 	}
 
+	if in.GroupVariants {
+		if err := p.attachVariants(products); err != nil {
+			return nil, err
+		}
+	}
+
 	// Возврат результата
 	return &pb.ProductList{
 		Products:   products,
@@ -491,125 +903,139 @@ func (p *productRepo) GetProductList(in *pb.ProductFilter) (*pb.ProductList, err
 	}, nil
 }
 
+// attachVariants подкладывает под родителей списка их варианты и складывает остатки вариантов в остаток родителя
+func (p *productRepo) attachVariants(products []*pb.Product) error {
+	parents := make(map[string]*pb.Product)
+	var ids []string
+	for _, product := range products {
+		if product.ParentId == "" && len(product.VariantAxes) > 0 {
+			parents[product.Id] = product
+			ids = append(ids, product.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`SELECT `+productColumns+` FROM products WHERE parent_id IN (?) ORDER BY created_at`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to prepare variants query: %w", err)
+	}
+
+	rows, err := p.db.Queryx(p.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to fetch variants: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var variant pb.Product
+		if err := rows.Scan(productDest(&variant)...); err != nil {
+			return fmt.Errorf("failed to scan variant: %w", err)
+		}
+		parent := parents[variant.ParentId]
+		parent.Variants = append(parent.Variants, &variant)
+		parent.TotalCount += variant.TotalCount
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error while iterating variants: %w", err)
+	}
+
+	return nil
+}
+
 // ------------------- End Product CRUD ------------------------------------------------------------------------
 
 //------------------- Product Quantity CRUD ------------------------------------------------------------------------
 
 func (p *productQuantity) AddProduct(in *entity.CountProductReq) (*entity.ProductNumber, error) {
-	product := &entity.ProductNumber{}
+	tx, err := beginTx(p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
 
-	query := `
-		UPDATE products
-	SET total_count = total_count + $1
-	WHERE id = $2
-	RETURNING id, total_count
-	`
+	delta := stockDelta{ProductID: in.ID, Delta: int64(in.Count)}
+	if in.Lot != nil {
+		lot := *in.Lot
+		lot.Quantity = int64(in.Count)
+		delta.Lots = []entity.StockLot{lot}
+	}
 
-	err := p.db.QueryRowx(query, in.Count, in.ID).
-		Scan(&product.ID, &product.TotalCount)
+	balances, err := changeStock(tx, []stockDelta{delta}, in.Movement)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add product stock: %w", err)
 	}
 
-	return product, nil
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &entity.ProductNumber{ID: in.ID, TotalCount: int(balances[in.ID])}, nil
 }
 
-func (s *productQuantity) RemoveProducts(soldProducts []entity.SalesItem) error {
+func (s *productQuantity) RemoveProducts(soldProducts []entity.SalesItem, info entity.MovementInfo) error {
 	if len(soldProducts) == 0 {
 		return nil
 	}
 
-	// Готовим SQL-запрос с `CASE WHEN`
-	var queryBuilder strings.Builder
-	queryBuilder.WriteString("UPDATE products SET total_count = total_count - CASE id ")
-
-	args := []interface{}{}
-	for i, item := range soldProducts {
-		queryBuilder.WriteString(fmt.Sprintf("WHEN $%d THEN $%d ", i*2+1, i*2+2))
-		args = append(args, item.ProductID, item.Quantity)
-	}
-	queryBuilder.WriteString("ELSE total_count END WHERE id IN (")
-	for i, _ := range soldProducts {
-		if i > 0 {
-			queryBuilder.WriteString(", ")
-		}
-		queryBuilder.WriteString(fmt.Sprintf("$%d", i*2+1))
+	deltas := make([]stockDelta, 0, len(soldProducts))
+	for _, item := range soldProducts {
+		deltas = append(deltas, stockDelta{ProductID: item.ProductID, Delta: -item.Quantity})
 	}
-	queryBuilder.WriteString(") RETURNING id, total_count")
 
-	// Выполняем запрос
-	rows, err := s.db.Queryx(queryBuilder.String(), args...)
-	if err != nil {
-		return fmt.Errorf("failed to update product stock: %w", err)
-	}
-	defer rows.Close()
+	return s.applyDeltas(deltas, info)
+}
 
-	// Проверяем, что все продукты обновились
-	updatedProducts := make(map[string]int)
-	for rows.Next() {
-		var id string
-		var count int
-		if err := rows.Scan(&id, &count); err != nil {
-			return fmt.Errorf("failed to scan updated products: %w", err)
-		}
-		updatedProducts[id] = count
+func (s *productQuantity) RemoveProductsPurchase(soldProducts []*pb.PurchaseItemResponse, info entity.MovementInfo) error {
+	if len(soldProducts) == 0 {
+		return nil
 	}
 
-	// Проверяем, все ли товары обновились
+	deltas := make([]stockDelta, 0, len(soldProducts))
 	for _, item := range soldProducts {
-		if _, ok := updatedProducts[item.ProductID]; !ok {
-			return fmt.Errorf("failed to update stock for product %s", item.ProductID)
-		}
+		deltas = append(deltas, stockDelta{ProductID: item.ProductId, Delta: -int64(item.Quantity)})
 	}
 
-	return nil
+	return s.applyDeltas(deltas, info)
 }
-func (s *productQuantity) RemoveProductsPurchase(soldProducts []*pb.PurchaseItemResponse) error {
-	if len(soldProducts) == 0 {
+
+// AdjustStock исправляет остатки на заданную разницу, например по итогам инвентаризации
+func (s *productQuantity) AdjustStock(adjustments []entity.StockAdjustment, info entity.MovementInfo) error {
+	if len(adjustments) == 0 {
 		return nil
 	}
 
-	// Готовим SQL-запрос с `CASE WHEN`
-	var queryBuilder strings.Builder
-	queryBuilder.WriteString("UPDATE products SET total_count = total_count - CASE id ")
-
-	args := []interface{}{}
-	for i, item := range soldProducts {
-		queryBuilder.WriteString(fmt.Sprintf("WHEN $%d THEN $%d ", i*2+1, i*2+2))
-		args = append(args, item.ProductId, item.Quantity)
+	deltas := make([]stockDelta, 0, len(adjustments))
+	for _, a := range adjustments {
+		deltas = append(deltas, stockDelta{ProductID: a.ProductID, Delta: a.Delta})
 	}
-	queryBuilder.WriteString("ELSE total_count END WHERE id IN (")
-	for i, _ := range soldProducts {
-		if i > 0 {
-			queryBuilder.WriteString(", ")
-		}
-		queryBuilder.WriteString(fmt.Sprintf("$%d", i*2+1))
-	}
-	queryBuilder.WriteString(") RETURNING id, total_count")
 
-	// Выполняем запрос
-	rows, err := s.db.Queryx(queryBuilder.String(), args...)
+	return s.applyDeltas(deltas, info)
+}
+
+// applyDeltas изменяет остатки и пишет журнал в одной транзакции
+func (s *productQuantity) applyDeltas(deltas []stockDelta, info entity.MovementInfo) (err error) {
+	tx, err := beginTx(s.db)
 	if err != nil {
-		return fmt.Errorf("failed to update product stock: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	defer rows.Close()
-
-	// Проверяем, что все продукты обновились
-	updatedProducts := make(map[string]int)
-	for rows.Next() {
-		var id string
-		var count int
-		if err := rows.Scan(&id, &count); err != nil {
-			return fmt.Errorf("failed to scan updated products: %w", err)
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
 		}
-		updatedProducts[id] = count
+	}()
+
+	if _, err = changeStock(tx, deltas, info); err != nil {
+		return err
 	}
 
-	// Проверяем, все ли товары обновились
-	for _, item := range soldProducts {
-		if _, ok := updatedProducts[item.ProductId]; !ok {
-			return fmt.Errorf("failed to update stock for product %s", item.ProductId)
-		}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	return nil
@@ -641,9 +1067,10 @@ func (p *productQuantity) ProductCountChecker(in *entity.CountProductReq) (bool,
 	return res, nil
 }
 
-func (p *productQuantity) TransferProducts(in *pb.TransferReq) error {
+// TransferOut списывает товары перемещения с исходного филиала при отправке
+func (p *productQuantity) TransferOut(in *pb.TransferReq, transferID string) (err error) {
 	// Начало транзакции
-	tx, err := p.db.Beginx()
+	tx, err := beginTx(p.db)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -659,13 +1086,7 @@ func (p *productQuantity) TransferProducts(in *pb.TransferReq) error {
 		}
 	}()
 
-	// Шаг 1: Проверить или создать категорию "transferred_from_branch" в целевом филиале
-	categoryID, err := p.ensureCategory(tx, "transferred_from_branch", in.ToBranchId, in.CompanyId, in.TransferredBy)
-	if err != nil {
-		return fmt.Errorf("failed to ensure category: %w", err)
-	}
-
-	// Шаг 2: Сначала получить информацию о всех продуктах, чтобы минимизировать запросы
+	// Шаг 1: Сначала получить информацию о всех продуктах, чтобы минимизировать запросы
 	productIDs := make([]string, len(in.Products))
 	for i, product := range in.Products {
 		productIDs[i] = product.ProductId
@@ -696,6 +1117,9 @@ func (p *productQuantity) TransferProducts(in *pb.TransferReq) error {
 		}
 		sourceProducts[id] = totalCount
 	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating source products: %w", err)
+	}
 
 	// Проверить, что у всех продуктов достаточно количества
 	for _, product := range in.Products {
@@ -708,74 +1132,148 @@ func (p *productQuantity) TransferProducts(in *pb.TransferReq) error {
 		}
 	}
 
-	// Шаг 3: Уменьшить количество всех продуктов в исходном филиале за один запрос
+	// Шаг 2: Уменьшить количество всех продуктов в исходном филиале за один запрос
+	outgoing := make([]stockDelta, 0, len(in.Products))
 	for _, product := range in.Products {
-		_, err = tx.Exec(`
-            UPDATE products
-            SET total_count = total_count - $1
-            WHERE id = $2 AND branch_id = $3`,
-			product.ProductQuantity, product.ProductId, in.FromBranchId)
-		if err != nil {
-			return fmt.Errorf("failed to reduce product quantity for ProductId=%s: %w", product.ProductId, err)
-		}
+		outgoing = append(outgoing, stockDelta{ProductID: product.ProductId, Delta: -product.ProductQuantity})
+	}
+	_, err = changeStock(tx, outgoing, entity.MovementInfo{
+		Reason:    entity.MovementTransferOut,
+		SourceID:  transferID,
+		CreatedBy: in.TransferredBy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reduce product quantity in source branch: %w", err)
 	}
 
-	// Шаг 4: Проверить наличие продуктов в целевом филиале и обновить или вставить их
-	targetProducts := make(map[string]bool) // ProductID -> ExistsInTargetBranch
-	query, args, err = sqlx.In(`
-        SELECT id
-        FROM products
-        WHERE id IN (?) AND branch_id = ?`, productIDs, in.ToBranchId)
+	return nil
+}
+
+// TransferIn приходует принятые товары перемещения в целевом филиале
+func (p *productQuantity) TransferIn(in *pb.TransferReq, transferID string) (err error) {
+	tx, err := beginTx(p.db)
 	if err != nil {
-		return fmt.Errorf("failed to prepare query for target products: %w", err)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	productIDs := make([]string, len(in.Products))
+	for i, product := range in.Products {
+		productIDs[i] = product.ProductId
 	}
-	query = tx.Rebind(query)
 
-	rows, err = tx.Queryx(query, args...)
+	// Шаг 1: Найти строку той же карточки каталога в целевом филиале
+	type transferTarget struct {
+		SourceID        string  `db:"source_id"`
+		CategoryName    string  `db:"category_name"`
+		TargetID        *string `db:"target_id"`
+		ParentCatalogID *string `db:"parent_catalog_id"`
+	}
+	var found []transferTarget
+	query, args, err := sqlx.In(`
+        SELECT s.id AS source_id, c.name AS category_name, t.id AS target_id, ci.parent_id AS parent_catalog_id
+        FROM products s
+        JOIN product_categories c ON c.id = s.category_id
+        JOIN catalog_items ci ON ci.id = s.catalog_id
+        LEFT JOIN products t ON t.catalog_id = s.catalog_id AND t.branch_id = ?
+        WHERE s.id IN (?) AND s.branch_id = ?`, in.ToBranchId, productIDs, in.FromBranchId)
 	if err != nil {
+		return fmt.Errorf("failed to prepare query for target products: %w", err)
+	}
+	if err = tx.Select(&found, tx.Rebind(query), args...); err != nil {
 		return fmt.Errorf("failed to fetch target products: %w", err)
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			return fmt.Errorf("failed to scan target product: %w", err)
-		}
-		targetProducts[id] = true
+	targets := make(map[string]transferTarget, len(found))
+	for _, t := range found {
+		targets[t.SourceID] = t
+	}
+
+	// Партии, которые перемещение увезло с исходного филиала, переезжают в целевой филиал
+	carried, err := transferredLots(tx, transferID, productIDs)
+	if err != nil {
+		return err
 	}
 
-	// Обновить или вставить продукты в целевом филиале
+	// Шаг 2: Обновить остаток существующей строки или завести карточку в целевом филиале
+	incomingInfo := entity.MovementInfo{
+		Reason:    entity.MovementTransferIn,
+		SourceID:  transferID,
+		CreatedBy: in.TransferredBy,
+	}
+	var incoming []stockDelta
+	var inserted []movement
 	for _, product := range in.Products {
-		if targetProducts[product.ProductId] {
-			// Продукт существует, обновить количество
-			_, err = tx.Exec(`
-                UPDATE products
-                SET total_count = total_count + $1
-                WHERE id = $2 AND branch_id = $3`,
-				product.ProductQuantity, product.ProductId, in.ToBranchId)
+		target, ok := targets[product.ProductId]
+		if !ok {
+			return fmt.Errorf("product %s not found in source branch", product.ProductId)
+		}
+		lots := pickLots(carried[product.ProductId], product.ProductQuantity)
+		if target.TargetID != nil {
+			incoming = append(incoming, stockDelta{ProductID: *target.TargetID, Delta: product.ProductQuantity, Lots: lots})
+			continue
+		}
+
+		// Категории у каждого филиала свои: товар попадает в категорию с тем же названием
+		categoryID, err := p.ensureCategory(tx, target.CategoryName, in.ToBranchId, in.CompanyId, in.TransferredBy)
+		if err != nil {
+			return fmt.Errorf("failed to ensure category: %w", err)
+		}
+
+		// Вариант попадает под строку своего родителя в целевом филиале
+		var parentID string
+		if target.ParentCatalogID != nil {
+			parentID, err = ensureBranchParent(tx, *target.ParentCatalogID, categoryID, in.ToBranchId, in.CompanyId, in.TransferredBy)
 			if err != nil {
-				return fmt.Errorf("failed to update product quantity in target branch for ProductId=%s: %w", product.ProductId, err)
+				return err
 			}
-		} else {
-			// Продукт не существует, вставить новый
-			_, err = tx.Exec(`
-                INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, total_count, branch_id, company_id, created_by, created_at)
-                SELECT $1, name, image_url, bill_format, incoming_price, standard_price, $2, $3, company_id, $4, NOW()
+		}
+
+		m := movement{Delta: product.ProductQuantity, Lots: lots}
+		err = tx.QueryRowx(`
+                INSERT INTO products (category_id, name, image_url, bill_format, incoming_price, standard_price, total_count, branch_id, company_id, created_by, created_at, catalog_id,
+                    parent_id, price_override)
+                SELECT $1, name, image_url, bill_format, incoming_price, standard_price, $2, $3, company_id, $4, NOW(), catalog_id,
+                    NULLIF($7, '')::UUID, price_override
                 FROM products
-                WHERE id = $5 AND branch_id = $6`,
-				categoryID, product.ProductQuantity, in.ToBranchId, in.TransferredBy, product.ProductId, in.FromBranchId)
-			if err != nil {
-				return fmt.Errorf("failed to insert product in target branch for ProductId=%s: %w", product.ProductId, err)
-			}
+                WHERE id = $5 AND branch_id = $6
+                RETURNING id, branch_id, company_id, total_count`,
+			categoryID, product.ProductQuantity, in.ToBranchId, in.TransferredBy, product.ProductId, in.FromBranchId, parentID).
+			Scan(&m.ProductID, &m.BranchID, &m.CompanyID, &m.Balance)
+		if err != nil {
+			return fmt.Errorf("failed to insert product in target branch for ProductId=%s: %w", product.ProductId, err)
+		}
+		if _, err = insertLots(tx, m.ProductID, m.BranchID, m.CompanyID, lots, incomingInfo); err != nil {
+			return err
 		}
+		inserted = append(inserted, m)
+		// Повторная позиция той же карточки попадёт уже в созданную строку
+		target.TargetID = &m.ProductID
+		targets[product.ProductId] = target
+	}
+
+	if _, err = changeStock(tx, incoming, incomingInfo); err != nil {
+		return fmt.Errorf("failed to update product quantity in target branch: %w", err)
+	}
+	if err = insertMovements(tx, inserted, incomingInfo); err != nil {
+		return err
 	}
 
 	return nil
 }
 
 // ensureCategory проверяет существование категории, создаёт её при отсутствии.
-func (p *productQuantity) ensureCategory(tx *sqlx.Tx, name string, branchID, companyID, createdBy string) (string, error) {
+func (p *productQuantity) ensureCategory(tx dbtx, name string, branchID, companyID, createdBy string) (string, error) {
 	var categoryID string
 	err := tx.Get(&categoryID, `
         SELECT id
@@ -805,7 +1303,7 @@ func (r *productRepo) GetProductDashboard(in *pb.GetProductsDashboardReq) (*enti
 	const sqlDashboard = `
     WITH stats AS (
       SELECT
-        COUNT(*) AS product_items,
+        COUNT(*) FILTER (WHERE parent_id IS NULL) AS product_items,
         COALESCE(SUM(total_count), 0) AS product_units
       FROM products
       WHERE company_id=$1 AND branch_id=$2
@@ -9,12 +9,13 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"strings"
 	"time"
 )
 
 type purchasesRepoImpl struct {
-	db *sqlx.DB
+	db dbtx
 }
 
 func NewPurchasesRepo(db *sqlx.DB) usecase.PurchasesRepo {
@@ -23,7 +24,7 @@ func NewPurchasesRepo(db *sqlx.DB) usecase.PurchasesRepo {
 
 // CreatePurchase создает новую закупку с товарами
 func (r *purchasesRepoImpl) CreatePurchase(in *entity.PurchaseRequest) (*pb.PurchaseResponse, error) {
-	tx, err := r.db.Beginx()
+	tx, err := beginTx(r.db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
@@ -34,24 +35,18 @@ func (r *purchasesRepoImpl) CreatePurchase(in *entity.PurchaseRequest) (*pb.Purc
 	}()
 
 	purchase := &pb.PurchaseResponse{}
-	query := `
-		INSERT INTO purchases (supplier_id, purchased_by, total_cost, payment_method, description, company_id, branch_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at
-	`
-	err = tx.QueryRowx(query, in.SupplierID, in.PurchasedBy, in.TotalCost, in.PaymentMethod, in.Description, in.CompanyID, in.BranchID).
-		Scan(&purchase.Id, &purchase.CreatedAt)
+	query := fmt.Sprintf(`
+		INSERT INTO purchases (supplier_id, purchased_by, total_cost, payment_type_id, description, company_id, branch_id, purchase_order_id)
+		VALUES ($1, $2, $3, %s, $5, $6, $7, NULLIF($8, '')::UUID) RETURNING id, %s, created_at
+	`, paymentTypeID("$6", "$4"), paymentTypeName("payment_type_id"))
+	err = tx.QueryRowx(query, in.SupplierID, in.PurchasedBy, in.TotalCost, in.PaymentMethod, in.Description, in.CompanyID, in.BranchID, in.PurchaseOrderID).
+		Scan(&purchase.Id, &purchase.PaymentMethod, &purchase.CreatedAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create purchase: %w", err)
 	}
 
-	itemQuery := `
-		INSERT INTO purchase_items (purchase_id, product_id, quantity, purchase_price, total_price, company_id, branch_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-	`
-	for _, item := range in.PurchaseItems {
-		if _, err := tx.Exec(itemQuery, purchase.Id, item.ProductID, item.Quantity, item.PurchasePrice, item.TotalPrice, in.CompanyID, in.BranchID); err != nil {
-			return nil, fmt.Errorf("failed to add purchase item: %w", err)
-		}
+	if err = insertPurchaseItems(tx, purchase.Id, in); err != nil {
+		return nil, err
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -61,12 +56,73 @@ func (r *purchasesRepoImpl) CreatePurchase(in *entity.PurchaseRequest) (*pb.Purc
 	purchase.SupplierId = in.SupplierID
 	purchase.PurchasedBy = in.PurchasedBy
 	purchase.TotalCost = in.TotalCost
-	purchase.PaymentMethod = in.PaymentMethod
 	purchase.Description = in.Description
+	purchase.Status = entity.DocumentCompleted
+	purchase.PurchaseOrderId = in.PurchaseOrderID
 
 	return purchase, nil
 }
 
+// insertPurchaseItems сохраняет позиции закупки
+func insertPurchaseItems(tx dbtx, purchaseID string, in *entity.PurchaseRequest) error {
+	itemQuery := `
+		INSERT INTO purchase_items (purchase_id, product_id, catalog_id, quantity, purchase_price, total_price, company_id, branch_id, unit, unit_quantity,
+			lot_number, expires_at, serials)
+		VALUES ($1, $2, (SELECT catalog_id FROM products WHERE id = $2), $3, $4, $5, $6, $7, NULLIF($8, ''), NULLIF($9::NUMERIC, 0),
+			NULLIF($10, ''), NULLIF($11, '')::DATE, $12)
+	`
+	for _, item := range in.PurchaseItems {
+		if _, err := tx.Exec(itemQuery, purchaseID, item.ProductID, item.Quantity, item.PurchasePrice, item.TotalPrice, in.CompanyID, in.BranchID,
+			item.Unit, item.UnitQuantity, item.LotNumber, item.ExpiresAt, pq.Array(item.Serials)); err != nil {
+			return fmt.Errorf("failed to add purchase item: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReplacePurchaseItems заменяет позиции закупки новым набором и пересчитывает её сумму
+func (r *purchasesRepoImpl) ReplacePurchaseItems(purchaseID string, in *entity.PurchaseRequest) error {
+	if len(in.PurchaseItems) == 0 {
+		return errors.New("cannot leave a purchase without items")
+	}
+
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.Exec(`
+		UPDATE purchases SET total_cost = $1
+		WHERE id = $2 AND company_id = $3 AND branch_id = $4 AND status <> 'voided'
+	`, in.TotalCost, purchaseID, in.CompanyID, in.BranchID)
+	if err != nil {
+		return fmt.Errorf("failed to update purchase total: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = errors.New("purchase not found or voided")
+		return err
+	}
+
+	if _, err = tx.Exec(`DELETE FROM purchase_items WHERE purchase_id = $1`, purchaseID); err != nil {
+		return fmt.Errorf("failed to delete purchase items: %w", err)
+	}
+
+	if err = insertPurchaseItems(tx, purchaseID, in); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // UpdatePurchase обновляет информацию о закупке
 func (r *purchasesRepoImpl) UpdatePurchase(in *pb.PurchaseUpdate) (*pb.PurchaseResponse, error) {
 	if in.Id == "" || in.CompanyId == "" || in.BranchId == "" {
@@ -88,7 +144,7 @@ func (r *purchasesRepoImpl) UpdatePurchase(in *pb.PurchaseUpdate) (*pb.PurchaseR
 		params["description"] = in.Description
 	}
 	if in.PaymentMethod != "" {
-		updates = append(updates, "payment_method = :payment_method")
+		updates = append(updates, "payment_type_id = "+paymentTypeID(":company_id", ":payment_method"))
 		params["payment_method"] = in.PaymentMethod
 	}
 
@@ -98,8 +154,8 @@ func (r *purchasesRepoImpl) UpdatePurchase(in *pb.PurchaseUpdate) (*pb.PurchaseR
 
 	query := `
 		UPDATE purchases SET ` + strings.Join(updates, ", ") + `
-		WHERE id = :id AND company_id = :company_id AND branch_id = :branch_id
-		RETURNING id, supplier_id, purchased_by, total_cost, description, payment_method, created_at
+		WHERE id = :id AND company_id = :company_id AND branch_id = :branch_id AND status <> 'voided'
+		RETURNING id, supplier_id, purchased_by, total_cost, description, ` + paymentTypeName("payment_type_id") + `, created_at
 	`
 	stmt, err := r.db.PrepareNamed(query)
 	if err != nil {
@@ -123,9 +179,14 @@ func (r *purchasesRepoImpl) GetPurchase(in *pb.PurchaseID) (*pb.PurchaseResponse
 	}
 
 	query := `
-        SELECT p.id, p.supplier_id, p.purchased_by, p.total_cost, p.payment_method, p.description, p.created_at,
-               i.id AS item_id, i.product_id, i.quantity, i.purchase_price, i.total_price, pd.name, pd.image_url
+        SELECT p.id, p.supplier_id, p.purchased_by, p.total_cost, pt.name, p.description, p.created_at,
+               p.status, COALESCE(p.voided_at::TEXT, ''), COALESCE(p.voided_by::TEXT, ''), p.void_reason,
+               COALESCE(p.purchase_order_id::TEXT, ''),
+               i.id AS item_id, i.product_id, i.quantity, i.purchase_price, i.total_price, pd.name, pd.image_url,
+               COALESCE(i.unit, ''), COALESCE(i.unit_quantity, 0), COALESCE(i.lot_number, ''), COALESCE(i.expires_at::TEXT, ''),
+               COALESCE(i.serials, '{}')
         FROM purchases p
+        JOIN payment_types pt ON pt.id = p.payment_type_id
         LEFT JOIN purchase_items i ON p.id = i.purchase_id
         LEFT JOIN products pd ON i.product_id = pd.id
         WHERE p.id = $1 AND p.company_id = $2 AND p.branch_id = $3
@@ -158,6 +219,11 @@ func (r *purchasesRepoImpl) GetPurchase(in *pb.PurchaseID) (*pb.PurchaseResponse
 			&purchase.PaymentMethod,
 			&purchase.Description,
 			&purchase.CreatedAt,
+			&purchase.Status,
+			&purchase.VoidedAt,
+			&purchase.VoidedBy,
+			&purchase.VoidReason,
+			&purchase.PurchaseOrderId,
 			&itemID,
 			&productID,
 			&quantity,
@@ -165,6 +231,11 @@ func (r *purchasesRepoImpl) GetPurchase(in *pb.PurchaseID) (*pb.PurchaseResponse
 			&totalPrice,
 			&productName,
 			&productImage,
+			&item.Unit,
+			&item.UnitQuantity,
+			&item.LotNumber,
+			&item.ExpiresAt,
+			pq.Array(&item.Serials),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan purchase data: %w", err)
@@ -200,6 +271,13 @@ func (r *purchasesRepoImpl) GetPurchase(in *pb.PurchaseID) (*pb.PurchaseResponse
 
 	purchase.Items = items
 
+	if purchase.Id != "" {
+		purchase.Payments, err = getPayments(r.db, purchasePayments, purchase.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return purchase, nil
 }
 
@@ -237,6 +315,10 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 		argIndex++
 	}
 
+	if !in.IncludeVoided {
+		filters = append(filters, notVoided("p"))
+	}
+
 	// Создаем копию args для countQuery (чтобы не учитывать LIMIT и OFFSET)
 	countArgs := make([]interface{}, len(args))
 	copy(countArgs, args)
@@ -258,9 +340,11 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 	// Основной SQL-запрос
 	query := fmt.Sprintf(`
 		WITH purchases_data AS (
-			SELECT p.id, p.supplier_id, p.purchased_by, p.total_cost, p.payment_method, 
-			       p.description, p.branch_id, p.company_id, p.created_at
+			SELECT p.id, p.supplier_id, p.purchased_by, p.total_cost, pt.name AS payment_method, 
+			       p.description, p.branch_id, p.company_id, p.created_at,
+			       p.status, p.voided_at, p.voided_by, p.void_reason, p.purchase_order_id
 			FROM purchases p
+			JOIN payment_types pt ON pt.id = p.payment_type_id
 			WHERE %s
 			ORDER BY p.created_at DESC
 			%s
@@ -268,8 +352,11 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 		SELECT 
 			pd.id, pd.supplier_id, pd.purchased_by, pd.total_cost, pd.payment_method, 
 			pd.description, pd.branch_id, pd.company_id, pd.created_at,
+			pd.status, COALESCE(pd.voided_at::TEXT, ''), COALESCE(pd.voided_by::TEXT, ''), pd.void_reason,
+			COALESCE(pd.purchase_order_id::TEXT, ''),
 			pi.id AS item_id, pi.product_id, pi.quantity, pi.purchase_price, pi.total_price,
-			pr.name AS product_name, pr.image_url
+			pr.name AS product_name, pr.image_url, COALESCE(pi.unit, ''), COALESCE(pi.unit_quantity, 0),
+			COALESCE(pi.lot_number, ''), COALESCE(pi.expires_at::TEXT, ''), COALESCE(pi.serials, '{}')
 		FROM purchases_data pd
 		LEFT JOIN purchase_items pi ON pi.purchase_id = pd.id
 		LEFT JOIN products pr ON pi.product_id = pr.id
@@ -301,6 +388,11 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 			&purchase.BranchId,
 			&purchase.CompanyId,
 			&purchase.CreatedAt,
+			&purchase.Status,
+			&purchase.VoidedAt,
+			&purchase.VoidedBy,
+			&purchase.VoidReason,
+			&purchase.PurchaseOrderId,
 			&itemID,
 			&item.ProductId,
 			&item.Quantity,
@@ -308,6 +400,11 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 			&item.TotalPrice,
 			&productName,
 			&productImage,
+			&item.Unit,
+			&item.UnitQuantity,
+			&item.LotNumber,
+			&item.ExpiresAt,
+			pq.Array(&item.Serials),
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
@@ -350,42 +447,40 @@ func (r *purchasesRepoImpl) GetPurchaseList(in *pb.FilterPurchase) (*pb.Purchase
 	}, nil
 }
 
-// DeletePurchase удаляет закупку и связанные товары
-func (r *purchasesRepoImpl) DeletePurchase(in *pb.PurchaseID) (*pb.Message, error) {
-	if in.Id == "" || in.CompanyId == "" || in.BranchId == "" {
-		return nil, errors.New("missing required fields")
+// LockPurchase блокирует закупку до конца транзакции, чтобы возвраты поставщику и изменения закупки шли по очереди
+func (r *purchasesRepoImpl) LockPurchase(purchaseID string) error {
+	var id string
+	if err := r.db.Get(&id, `SELECT id FROM purchases WHERE id = $1 FOR UPDATE`, purchaseID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("purchase not found")
+		}
+		return fmt.Errorf("failed to lock purchase: %w", err)
 	}
+	return nil
+}
 
-	tx, err := r.db.Beginx()
-	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
+// VoidPurchase аннулирует закупку, сохраняя её строки для истории
+func (r *purchasesRepoImpl) VoidPurchase(in *pb.VoidPurchaseReq) error {
+	if in.Id == "" || in.CompanyId == "" || in.BranchId == "" {
+		return errors.New("missing required fields")
 	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
 
-	_, err = tx.Exec(`DELETE FROM purchase_items WHERE purchase_id = $1 AND company_id = $2 AND branch_id = $3`, in.Id, in.CompanyId, in.BranchId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete purchase items: %w", err)
-	}
+	query := `
+		UPDATE purchases SET status = 'voided', voided_at = NOW(), voided_by = NULLIF($4, '')::UUID, void_reason = $5
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status <> 'voided'
+	`
 
-	result, err := tx.Exec(`DELETE FROM purchases WHERE id = $1 AND company_id = $2 AND branch_id = $3`, in.Id, in.CompanyId, in.BranchId)
+	result, err := r.db.Exec(query, in.Id, in.CompanyId, in.BranchId, in.VoidedBy, in.Reason)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete purchase: %w", err)
+		return fmt.Errorf("failed to void purchase: %w", err)
 	}
 
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected == 0 {
-		return nil, errors.New("purchase not found")
+		return errors.New("purchase not found or already voided")
 	}
 
-	if err = tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	return &pb.Message{Message: "Purchase deleted successfully"}, nil
+	return nil
 }
 
 // --------------------------------------------- Transfer Structs ----------------------------------------------------
@@ -393,7 +488,7 @@ func (r *purchasesRepoImpl) DeletePurchase(in *pb.PurchaseID) (*pb.Message, erro
 func (r purchasesRepoImpl) CreateTransfers(in *pb.TransferReq) (*pb.Transfer, error) {
 	transferID := uuid.New().String()
 
-	tx, err := r.db.Beginx()
+	tx, err := beginTx(r.db)
 	if err != nil {
 		return nil, err
 	}
@@ -419,10 +514,12 @@ func (r purchasesRepoImpl) CreateTransfers(in *pb.TransferReq) (*pb.Transfer, er
 	}
 
 	values := []interface{}{}
-	query := `INSERT INTO transfer_products (id, product_transfers_id, product_id, quantity) VALUES `
+	query := `INSERT INTO transfer_products (id, product_transfers_id, product_id, catalog_id, quantity, unit, unit_quantity, serials) VALUES `
 	for i, product := range in.Products {
-		query += fmt.Sprintf("($%d, $%d, $%d, $%d),", 4*i+1, 4*i+2, 4*i+3, 4*i+4)
-		values = append(values, uuid.New().String(), transferID, product.ProductId, product.ProductQuantity)
+		query += fmt.Sprintf("($%d, $%d, $%d, (SELECT catalog_id FROM products WHERE id = $%d), $%d, NULLIF($%d, ''), NULLIF($%d::NUMERIC, 0), $%d),",
+			7*i+1, 7*i+2, 7*i+3, 7*i+3, 7*i+4, 7*i+5, 7*i+6, 7*i+7)
+		values = append(values, uuid.New().String(), transferID, product.ProductId, product.ProductQuantity, product.Unit, product.UnitQuantity,
+			pq.Array(product.Serials))
 	}
 	query = query[:len(query)-1] // Удалить последнюю запятую
 
@@ -439,6 +536,7 @@ func (r purchasesRepoImpl) CreateTransfers(in *pb.TransferReq) (*pb.Transfer, er
 		Description:   in.Description,
 		CompanyId:     in.CompanyId,
 		CreatedAt:     time.Now().String(),
+		Status:        entity.TransferDraft,
 	}, nil
 }
 
@@ -451,10 +549,22 @@ func (r purchasesRepoImpl) GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 		Description   string    `db:"description"`
 		CreatedAt     time.Time `db:"created_at"`
 		CompanyId     string    `db:"company_id"`
+		Status        string    `db:"status"`
+		DispatchedAt  string    `db:"dispatched_at"`
+		ReceivedAt    string    `db:"received_at"`
+		ReceivedBy    string    `db:"received_by"`
+		CancelledAt   string    `db:"cancelled_at"`
+		CancelReason  string    `db:"cancel_reason"`
+		Shortfall     string    `db:"shortfall_adjustment_id"`
 	}
 
 	err := r.db.Get(&transfer, `
-		SELECT id, transferred_by, from_branch_id, to_branch_id, description, created_at, company_id
+		SELECT id, transferred_by, from_branch_id, to_branch_id, description, created_at, company_id, status,
+		       COALESCE(TO_CHAR(dispatched_at, 'YYYY-MM-DD HH24:MI:SS'), '') AS dispatched_at,
+		       COALESCE(TO_CHAR(received_at, 'YYYY-MM-DD HH24:MI:SS'), '') AS received_at,
+		       COALESCE(received_by::TEXT, '') AS received_by,
+		       COALESCE(TO_CHAR(cancelled_at, 'YYYY-MM-DD HH24:MI:SS'), '') AS cancelled_at,
+		       cancel_reason, COALESCE(shortfall_adjustment_id::TEXT, '') AS shortfall_adjustment_id
 		FROM transfers
 		WHERE company_id = $1 AND id = $2`,
 		in.CompanyId, in.Id,
@@ -467,11 +577,16 @@ func (r purchasesRepoImpl) GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 	}
 
 	var products []struct {
-		Id              string  `db:"id"`
-		ProductId       string  `db:"product_id"`
-		ProductQuantity int64   `db:"product_quantity"`
-		ProductName     string  `db:"product_name"`
-		ProductImage    *string `db:"product_image"` // null-значения в базе
+		Id               string         `db:"id"`
+		ProductId        string         `db:"product_id"`
+		ProductQuantity  int64          `db:"product_quantity"`
+		ProductName      string         `db:"product_name"`
+		ProductImage     *string        `db:"product_image"` // null-значения в базе
+		ReceivedQuantity *int64         `db:"received_quantity"`
+		DiscrepancyNote  string         `db:"discrepancy_note"`
+		Unit             string         `db:"unit"`
+		UnitQuantity     float64        `db:"unit_quantity"`
+		Serials          pq.StringArray `db:"serials"`
 	}
 
 	err = r.db.Select(&products, `
@@ -480,7 +595,12 @@ func (r purchasesRepoImpl) GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 			tp.product_id, 
 			tp.quantity AS product_quantity, 
 			p.name AS product_name, 
-			p.image_url AS product_image
+			p.image_url AS product_image,
+			tp.received_quantity,
+			tp.discrepancy_note,
+			COALESCE(tp.unit, '') AS unit,
+			COALESCE(tp.unit_quantity, 0) AS unit_quantity,
+			COALESCE(tp.serials, '{}') AS serials
 		FROM transfer_products tp
 		JOIN products p ON tp.product_id = p.id
 		WHERE tp.product_transfers_id = $1`,
@@ -498,6 +618,15 @@ func (r purchasesRepoImpl) GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 			ProductQuantity: product.ProductQuantity,
 			ProductName:     product.ProductName,
 			ProductImage:    getStringValue(product.ProductImage), // Обработка null-значений
+			DiscrepancyNote: product.DiscrepancyNote,
+			Unit:            product.Unit,
+			UnitQuantity:    product.UnitQuantity,
+			Serials:         product.Serials,
+		}
+		// Пока позиция не принята, расхождения нет
+		if product.ReceivedQuantity != nil {
+			transferProducts[i].ReceivedQuantity = *product.ReceivedQuantity
+			transferProducts[i].Discrepancy = product.ProductQuantity - *product.ReceivedQuantity
 		}
 	}
 
@@ -510,6 +639,14 @@ func (r purchasesRepoImpl) GetTransfers(in *pb.TransferID) (*pb.Transfer, error)
 		CreatedAt:     transfer.CreatedAt.Format("2006-01-02 15:04:05"), // Формат даты
 		CompanyId:     transfer.CompanyId,
 		Products:      transferProducts,
+		Status:        transfer.Status,
+		DispatchedAt:  transfer.DispatchedAt,
+		ReceivedAt:    transfer.ReceivedAt,
+		ReceivedBy:    transfer.ReceivedBy,
+		CancelledAt:   transfer.CancelledAt,
+		CancelReason:  transfer.CancelReason,
+
+		ShortfallAdjustmentId: transfer.Shortfall,
 	}, nil
 }
 
@@ -545,6 +682,12 @@ func (r purchasesRepoImpl) GetTransferList(in *pb.TransferFilter) (*pb.TransferL
 		argIndex++
 	}
 
+	if in.Status != "" {
+		filters = append(filters, fmt.Sprintf("t.status = $%d", argIndex))
+		args = append(args, in.Status)
+		argIndex++
+	}
+
 	whereClause := "WHERE " + strings.Join(filters, " AND ")
 
 	paginationClause := ""
@@ -564,6 +707,7 @@ func (r purchasesRepoImpl) GetTransferList(in *pb.TransferFilter) (*pb.TransferL
 				t.description,
 				t.created_at,
 				t.company_id,
+				t.status,
 				tp.id AS product_id,
 				tp.quantity AS product_quantity,
 				p.name AS product_name,
@@ -602,6 +746,7 @@ func (r purchasesRepoImpl) GetTransferList(in *pb.TransferFilter) (*pb.TransferL
 			Description     string  `db:"description"`
 			CreatedAt       string  `db:"created_at"`
 			CompanyID       string  `db:"company_id"`
+			Status          string  `db:"status"`
 			ProductID       *string `db:"product_id"`
 			ProductQuantity *int64  `db:"product_quantity"`
 			ProductName     *string `db:"product_name"`
@@ -623,6 +768,7 @@ func (r purchasesRepoImpl) GetTransferList(in *pb.TransferFilter) (*pb.TransferL
 				Description:   row.Description,
 				CreatedAt:     row.CreatedAt,
 				CompanyId:     row.CompanyID,
+				Status:        row.Status,
 				Products:      []*pb.TransfersProducts{},
 			}
 			transfersMap[row.TransferID] = transfer
@@ -654,3 +800,96 @@ func (r purchasesRepoImpl) GetTransferList(in *pb.TransferFilter) (*pb.TransferL
 		TotalCount: totalCount,
 	}, nil
 }
+
+// SetTransferStatus переводит перемещение в новое состояние, если оно находится в одном из допустимых
+func (r purchasesRepoImpl) SetTransferStatus(in *pb.TransferStatusReq, status string, from ...string) error {
+	query, args, err := sqlx.In(`
+		UPDATE transfers SET status = ?,
+			dispatched_at = CASE WHEN ? = 'dispatched' THEN NOW() ELSE dispatched_at END,
+			cancelled_at = CASE WHEN ? = 'cancelled' THEN NOW() ELSE cancelled_at END,
+			cancel_reason = CASE WHEN ? = 'cancelled' THEN ? ELSE cancel_reason END
+		WHERE id = ? AND company_id = ? AND status IN (?)
+	`, status, status, status, status, in.Reason, in.Id, in.CompanyId, from)
+	if err != nil {
+		return fmt.Errorf("failed to prepare transfer status query: %w", err)
+	}
+
+	result, err := r.db.Exec(r.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update transfer status: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("transfer not found or cannot become %s", status)
+	}
+
+	return nil
+}
+
+// SetTransferShortfall связывает перемещение с документом списания его недостачи
+func (r purchasesRepoImpl) SetTransferShortfall(transferID, adjustmentID string) error {
+	_, err := r.db.Exec(`UPDATE transfers SET shortfall_adjustment_id = $1 WHERE id = $2`, adjustmentID, transferID)
+	if err != nil {
+		return fmt.Errorf("failed to link transfer shortfall: %w", err)
+	}
+	return nil
+}
+
+// ReceiveTransferItems сохраняет принятое количество позиций перемещения. Позиции без явного количества
+// считаются принятыми полностью. Возвращает новое состояние перемещения
+func (r purchasesRepoImpl) ReceiveTransferItems(in *pb.ReceiveTransferReq, items []entity.ReceivedTransferItem) (string, error) {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	itemQuery := `
+		UPDATE transfer_products SET received_quantity = $1, discrepancy_note = $2, received_serials = $5
+		WHERE id = $3 AND product_transfers_id = $4 AND received_quantity IS NULL AND $1 <= quantity
+	`
+	for _, item := range items {
+		var result sql.Result
+		result, err = tx.Exec(itemQuery, item.Quantity, item.Note, item.TransferProductID, in.Id, pq.Array(item.Serials))
+		if err != nil {
+			return "", fmt.Errorf("failed to receive transfer line: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			err = fmt.Errorf("cannot receive %d of transfer line %s: unknown line or more than sent", item.Quantity, item.TransferProductID)
+			return "", err
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE transfer_products SET received_quantity = quantity, received_serials = serials
+		WHERE product_transfers_id = $1 AND received_quantity IS NULL`, in.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to receive remaining transfer lines: %w", err)
+	}
+
+	var status string
+	err = tx.QueryRowx(`
+		UPDATE transfers SET received_at = NOW(), received_by = NULLIF($3, '')::UUID,
+			status = CASE
+				WHEN EXISTS (SELECT 1 FROM transfer_products WHERE product_transfers_id = $1 AND received_quantity < quantity)
+				THEN 'partially_received' ELSE 'received' END
+		WHERE id = $1 AND company_id = $2 AND status IN ('dispatched', 'in_transit')
+		RETURNING status
+	`, in.Id, in.CompanyId, in.ReceivedBy).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = errors.New("transfer not found or not on its way")
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to update transfer status: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return status, nil
+}
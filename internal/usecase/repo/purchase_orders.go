@@ -0,0 +1,475 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+type purchaseOrdersRepoImpl struct {
+	db dbtx
+}
+
+func NewPurchaseOrdersRepo(db *sqlx.DB) usecase.PurchaseOrdersRepo {
+	return &purchaseOrdersRepoImpl{db: db}
+}
+
+// CreatePurchaseOrder создает черновик заказа поставщику с позициями
+func (r *purchaseOrdersRepoImpl) CreatePurchaseOrder(in *entity.PurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	if len(in.Items) == 0 {
+		return nil, errors.New("cannot create purchase order without items")
+	}
+
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var id string
+	query := `
+		INSERT INTO purchase_orders (supplier_id, ordered_by, expected_at, description, total_cost, company_id, branch_id)
+		VALUES ($1, $2, NULLIF($3, '')::DATE, $4, $5, $6, $7)
+		RETURNING id
+	`
+	err = tx.QueryRowx(query, in.SupplierID, in.OrderedBy, in.ExpectedAt, in.Description, in.TotalCost, in.CompanyID, in.BranchID).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create purchase order: %w", err)
+	}
+
+	if err = insertPurchaseOrderItems(tx, id, in); err != nil {
+		return nil, err
+	}
+
+	res, err := r.getPurchaseOrder(tx, &pb.PurchaseOrderID{Id: id, CompanyId: in.CompanyID, BranchId: in.BranchID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+// insertPurchaseOrderItems сохраняет позиции заказа поставщику
+func insertPurchaseOrderItems(tx dbtx, orderID string, in *entity.PurchaseOrderRequest) error {
+	var queryBuilder strings.Builder
+	args := []interface{}{}
+	queryBuilder.WriteString(`
+		INSERT INTO purchase_order_items (order_id, product_id, quantity, purchase_price, total_price, company_id, branch_id) VALUES
+	`)
+	for i, item := range in.Items {
+		startIdx := i * 7
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7))
+
+		args = append(args, orderID, item.ProductID, item.Quantity, item.PurchasePrice, item.TotalPrice, in.CompanyID, in.BranchID)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert purchase order items: %w", err)
+	}
+
+	return nil
+}
+
+// GetPurchaseOrder получает заказ поставщику с позициями по ID
+func (r *purchaseOrdersRepoImpl) GetPurchaseOrder(in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+	return r.getPurchaseOrder(r.db, in)
+}
+
+func (r *purchaseOrdersRepoImpl) getPurchaseOrder(db dbtx, in *pb.PurchaseOrderID) (*pb.PurchaseOrder, error) {
+	query := `
+		SELECT
+			o.id, o.supplier_id, o.ordered_by, o.status, COALESCE(TO_CHAR(o.expected_at, 'YYYY-MM-DD'), ''), o.description,
+			o.total_cost, o.company_id, o.branch_id, o.created_at, o.updated_at,
+			i.id, i.product_id, i.quantity, i.received_quantity, i.purchase_price, i.total_price, pd.name, pd.image_url
+		FROM purchase_orders o
+		LEFT JOIN purchase_order_items i ON o.id = i.order_id
+		LEFT JOIN products pd ON i.product_id = pd.id
+		WHERE o.id = $1 AND o.company_id = $2 AND o.branch_id = $3
+		ORDER BY i.id
+	`
+
+	rows, err := db.Queryx(query, in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query purchase order: %w", err)
+	}
+	defer rows.Close()
+
+	var res *pb.PurchaseOrder
+	for rows.Next() {
+		var order pb.PurchaseOrder
+		var itemID, productID, productName, productImage sql.NullString
+		var quantity, receivedQuantity sql.NullInt32
+		var purchasePrice, totalPrice sql.NullFloat64
+
+		err = rows.Scan(
+			&order.Id,
+			&order.SupplierId,
+			&order.OrderedBy,
+			&order.Status,
+			&order.ExpectedAt,
+			&order.Description,
+			&order.TotalCost,
+			&order.CompanyId,
+			&order.BranchId,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+			&itemID,
+			&productID,
+			&quantity,
+			&receivedQuantity,
+			&purchasePrice,
+			&totalPrice,
+			&productName,
+			&productImage,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order row: %w", err)
+		}
+
+		if res == nil {
+			res = &order
+		}
+		if itemID.Valid {
+			res.Items = append(res.Items, &pb.PurchaseOrderItem{
+				Id:               itemID.String,
+				OrderId:          res.Id,
+				ProductId:        productID.String,
+				Quantity:         quantity.Int32,
+				ReceivedQuantity: receivedQuantity.Int32,
+				PurchasePrice:    purchasePrice.Float64,
+				TotalPrice:       totalPrice.Float64,
+				ProductName:      productName.String,
+				ProductImage:     productImage.String,
+			})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over purchase order rows: %w", err)
+	}
+	if res == nil {
+		return nil, errors.New("purchase order not found")
+	}
+
+	return res, nil
+}
+
+// GetPurchaseOrderList получает список заказов поставщикам с фильтрами
+func (r *purchaseOrdersRepoImpl) GetPurchaseOrderList(in *pb.PurchaseOrderFilter) (*pb.PurchaseOrderList, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"o.company_id = $1", "o.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.SupplierId != "" {
+		filters = append(filters, fmt.Sprintf("o.supplier_id = $%d", argIndex))
+		args = append(args, in.SupplierId)
+		argIndex++
+	}
+	if in.Status != "" {
+		filters = append(filters, fmt.Sprintf("o.status = $%d", argIndex))
+		args = append(args, in.Status)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(o.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(o.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM purchase_orders o WHERE %s`, strings.Join(filters, " AND "))
+
+	var totalCount int64
+	if err := r.db.Get(&totalCount, countQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	mainQuery := fmt.Sprintf(`
+		SELECT
+			o.id, o.supplier_id, o.ordered_by, o.status, COALESCE(TO_CHAR(o.expected_at, 'YYYY-MM-DD'), ''), o.description,
+			o.total_cost, o.company_id, o.branch_id, o.created_at, o.updated_at,
+			COALESCE(JSON_AGG(
+				JSON_BUILD_OBJECT(
+					'id', i.id,
+					'order_id', i.order_id,
+					'product_id', i.product_id,
+					'quantity', i.quantity,
+					'received_quantity', i.received_quantity,
+					'purchase_price', i.purchase_price,
+					'total_price', i.total_price,
+					'product_name', pr.name,
+					'product_image', pr.image_url
+				)
+			) FILTER (WHERE i.id IS NOT NULL), '[]') AS items
+		FROM purchase_orders o
+		LEFT JOIN purchase_order_items i ON o.id = i.order_id
+		LEFT JOIN products pr ON i.product_id = pr.id
+		WHERE %s
+		GROUP BY o.id
+		ORDER BY o.created_at DESC`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		mainQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := r.db.Queryx(mainQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*pb.PurchaseOrder
+	for rows.Next() {
+		var order pb.PurchaseOrder
+		var itemsJSON string
+
+		err = rows.Scan(
+			&order.Id,
+			&order.SupplierId,
+			&order.OrderedBy,
+			&order.Status,
+			&order.ExpectedAt,
+			&order.Description,
+			&order.TotalCost,
+			&order.CompanyId,
+			&order.BranchId,
+			&order.CreatedAt,
+			&order.UpdatedAt,
+			&itemsJSON,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order row: %w", err)
+		}
+
+		var items []*pb.PurchaseOrderItem
+		if err := json.Unmarshal([]byte(itemsJSON), &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal purchase order items JSON: %w", err)
+		}
+		order.Items = items
+
+		orders = append(orders, &order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over purchase order rows: %w", err)
+	}
+
+	return &pb.PurchaseOrderList{
+		Orders:     orders,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// UpdatePurchaseOrder обновляет черновик заказа. Позиции заменяются, только если переданы
+func (r *purchaseOrdersRepoImpl) UpdatePurchaseOrder(id string, in *entity.PurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var setFields []string
+	args := []interface{}{id, in.CompanyID, in.BranchID}
+
+	if in.ExpectedAt != "" {
+		args = append(args, in.ExpectedAt)
+		setFields = append(setFields, fmt.Sprintf("expected_at = $%d::DATE", len(args)))
+	}
+	if in.Description != "" {
+		args = append(args, in.Description)
+		setFields = append(setFields, fmt.Sprintf("description = $%d", len(args)))
+	}
+	if len(in.Items) > 0 {
+		args = append(args, in.TotalCost)
+		setFields = append(setFields, fmt.Sprintf("total_cost = $%d", len(args)))
+	}
+	if len(setFields) == 0 {
+		err = errors.New("no fields to update")
+		return nil, err
+	}
+	setFields = append(setFields, "updated_at = NOW()")
+
+	query := fmt.Sprintf(`
+		UPDATE purchase_orders SET %s
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status = 'draft'
+	`, strings.Join(setFields, ", "))
+
+	result, err := tx.Exec(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update purchase order: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = errors.New("purchase order not found or no longer a draft")
+		return nil, err
+	}
+
+	if len(in.Items) > 0 {
+		if _, err = tx.Exec(`DELETE FROM purchase_order_items WHERE order_id = $1`, id); err != nil {
+			return nil, fmt.Errorf("failed to delete purchase order items: %w", err)
+		}
+		if err = insertPurchaseOrderItems(tx, id, in); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := r.getPurchaseOrder(tx, &pb.PurchaseOrderID{Id: id, CompanyId: in.CompanyID, BranchId: in.BranchID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetPurchaseOrderStatus переводит заказ в новое состояние, если он находится в одном из допустимых
+func (r *purchaseOrdersRepoImpl) SetPurchaseOrderStatus(in *pb.PurchaseOrderID, status string, from ...string) error {
+	query, args, err := sqlx.In(`
+		UPDATE purchase_orders SET status = ?, updated_at = NOW()
+		WHERE id = ? AND company_id = ? AND branch_id = ? AND status IN (?)
+	`, status, in.Id, in.CompanyId, in.BranchId, from)
+	if err != nil {
+		return fmt.Errorf("failed to prepare purchase order status query: %w", err)
+	}
+
+	result, err := r.db.Exec(r.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("purchase order not found or cannot become %s", status)
+	}
+
+	return nil
+}
+
+// ReceivePurchaseOrderItems увеличивает принятое количество позиций заказа и пересчитывает его состояние.
+// Принять больше заказанного нельзя; заказ должен быть отправлен поставщику
+func (r *purchaseOrdersRepoImpl) ReceivePurchaseOrderItems(in *pb.PurchaseOrderID, items []entity.ReceivedOrderItem) error {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	itemQuery := `
+		UPDATE purchase_order_items SET received_quantity = received_quantity + $1
+		WHERE id = $2 AND order_id = $3 AND received_quantity + $1 <= quantity
+	`
+	for _, item := range items {
+		result, err := tx.Exec(itemQuery, item.Quantity, item.OrderItemID, in.Id)
+		if err != nil {
+			return fmt.Errorf("failed to receive purchase order item: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return fmt.Errorf("cannot receive %d of purchase order item %s: more than outstanding", item.Quantity, item.OrderItemID)
+		}
+	}
+
+	result, err := tx.Exec(`
+		UPDATE purchase_orders SET updated_at = NOW(),
+			status = CASE
+				WHEN EXISTS (SELECT 1 FROM purchase_order_items WHERE order_id = $1 AND received_quantity < quantity)
+				THEN 'partially_received' ELSE 'received' END
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status IN ('sent', 'partially_received')
+	`, in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = errors.New("purchase order not found or not open for receiving")
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetOutstandingPurchaseOrders возвращает неполученный товар по открытым заказам в разрезе поставщиков
+func (r *purchaseOrdersRepoImpl) GetOutstandingPurchaseOrders(in *pb.OutstandingOrdersReq) (*pb.OutstandingOrdersList, error) {
+	args := []interface{}{in.CompanyId}
+	filters := []string{"o.company_id = $1", "o.status IN ('sent', 'partially_received')"}
+
+	if in.BranchId != "" {
+		args = append(args, in.BranchId)
+		filters = append(filters, fmt.Sprintf("o.branch_id = $%d", len(args)))
+	}
+	if in.SupplierId != "" {
+		args = append(args, in.SupplierId)
+		filters = append(filters, fmt.Sprintf("o.supplier_id = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			o.supplier_id,
+			COUNT(DISTINCT o.id),
+			COALESCE(SUM(i.quantity - i.received_quantity), 0),
+			COALESCE(SUM((i.quantity - i.received_quantity) * i.purchase_price), 0),
+			COUNT(DISTINCT o.id) FILTER (WHERE o.expected_at < CURRENT_DATE),
+			COALESCE(TO_CHAR(MIN(o.expected_at) FILTER (WHERE o.expected_at >= CURRENT_DATE), 'YYYY-MM-DD'), '')
+		FROM purchase_orders o
+		JOIN purchase_order_items i ON i.order_id = o.id
+		WHERE %s
+		GROUP BY o.supplier_id
+		ORDER BY 4 DESC`, strings.Join(filters, " AND "))
+
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch outstanding purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var suppliers []*pb.SupplierOutstanding
+	for rows.Next() {
+		var s pb.SupplierOutstanding
+		if err := rows.Scan(&s.SupplierId, &s.OrderCount, &s.OutstandingQuantity, &s.OutstandingValue, &s.OverdueCount, &s.NextExpectedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outstanding purchase orders: %w", err)
+		}
+		suppliers = append(suppliers, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating outstanding purchase orders: %w", err)
+	}
+
+	return &pb.OutstandingOrdersList{Suppliers: suppliers}, nil
+}
@@ -0,0 +1,432 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+)
+
+type returnsRepoImpl struct {
+	db dbtx
+}
+
+func NewReturnsRepo(db *sqlx.DB) usecase.ReturnedProductsRepo {
+	return &returnsRepoImpl{db: db}
+}
+
+// CreateReturnedProducts создает возврат и возвращённые позиции продажи
+func (r *returnsRepoImpl) CreateReturnedProducts(in *entity.ReturnRequest) (*pb.ReturnResponse, error) {
+	if len(in.Items) == 0 {
+		return nil, errors.New("cannot create return without items")
+	}
+
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res := &pb.ReturnResponse{}
+	query := fmt.Sprintf(`
+		INSERT INTO product_returns (sale_id, client_id, returned_by, total_refund, payment_type_id, reason, cash_flow_id, company_id, branch_id,
+		                             debt_reduction)
+		VALUES ($1, NULLIF($2, '')::UUID, $3, $4, %s, $6, NULLIF($7, '')::UUID, $8, $9, $10)
+		RETURNING id, %s, created_at
+	`, paymentTypeID("$8", "$5"), paymentTypeName("payment_type_id"))
+	err = tx.QueryRowx(query, in.SaleID, in.ClientID, in.ReturnedBy, in.TotalRefund, in.PaymentMethod, in.Reason, in.CashFlowID, in.CompanyID, in.BranchID,
+		in.DebtReduction).
+		Scan(&res.Id, &res.PaymentMethod, &res.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create return: %w", err)
+	}
+
+	var queryBuilder strings.Builder
+	args := []interface{}{}
+	queryBuilder.WriteString(`
+		INSERT INTO product_return_items (return_id, sale_item_id, product_id, quantity, refund_price, total_price, company_id, branch_id, serials) VALUES
+	`)
+	for i, item := range in.Items {
+		startIdx := i * 9
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8, startIdx+9))
+
+		args = append(args, res.Id, item.SaleItemID, item.ProductID, item.Quantity, item.RefundPrice, item.TotalPrice, in.CompanyID, in.BranchID,
+			pq.Array(item.Serials))
+	}
+
+	if _, err = tx.Exec(queryBuilder.String(), args...); err != nil {
+		return nil, fmt.Errorf("failed to insert return items: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, item := range in.Items {
+		res.Items = append(res.Items, &pb.ReturnItem{
+			ReturnId:    res.Id,
+			SaleItemId:  item.SaleItemID,
+			ProductId:   item.ProductID,
+			Quantity:    int32(item.Quantity),
+			RefundPrice: item.RefundPrice,
+			TotalPrice:  item.TotalPrice,
+			Serials:     item.Serials,
+		})
+	}
+
+	res.SaleId = in.SaleID
+	res.ClientId = in.ClientID
+	res.ReturnedBy = in.ReturnedBy
+	res.TotalRefund = in.TotalRefund
+	res.DebtReduction = in.DebtReduction
+	res.Reason = in.Reason
+	res.CashFlowId = in.CashFlowID
+	res.CompanyId = in.CompanyID
+	res.BranchId = in.BranchID
+	res.Status = entity.DocumentCompleted
+
+	return res, nil
+}
+
+// UpdateReturnedProducts обновляет причину возврата. Аннулированный возврат не меняется
+func (r *returnsRepoImpl) UpdateReturnedProducts(in *pb.ReturnUpdate) (*pb.ReturnResponse, error) {
+	if in.Reason == "" {
+		return nil, errors.New("no fields to update")
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE product_returns SET reason = $1
+		WHERE id = $2 AND company_id = $3 AND branch_id = $4 AND status <> 'voided'
+		RETURNING id, sale_id, COALESCE(client_id::TEXT, ''), returned_by, total_refund, debt_reduction, %s, reason,
+		          COALESCE(cash_flow_id::TEXT, ''), company_id, branch_id, created_at, status
+	`, paymentTypeName("payment_type_id"))
+
+	res := &pb.ReturnResponse{}
+	err := r.db.QueryRowx(query, in.Reason, in.Id, in.CompanyId, in.BranchId).
+		Scan(&res.Id, &res.SaleId, &res.ClientId, &res.ReturnedBy, &res.TotalRefund, &res.DebtReduction, &res.PaymentMethod, &res.Reason,
+			&res.CashFlowId, &res.CompanyId, &res.BranchId, &res.CreatedAt, &res.Status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("return not found or voided")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update return: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetReturnedProducts получает возврат с позициями по ID
+func (r *returnsRepoImpl) GetReturnedProducts(in *pb.ReturnID) (*pb.ReturnResponse, error) {
+	query := `
+		SELECT
+			r.id, r.sale_id, COALESCE(r.client_id::TEXT, ''), r.returned_by, r.total_refund, r.debt_reduction, pt.name, r.reason,
+			COALESCE(r.cash_flow_id::TEXT, ''), r.company_id, r.branch_id, r.created_at,
+			r.status, COALESCE(r.voided_at::TEXT, ''), COALESCE(r.voided_by::TEXT, ''), r.void_reason,
+			i.id, i.sale_item_id, i.product_id, i.quantity, i.refund_price, i.total_price, pd.name, pd.image_url,
+			COALESCE(i.serials, '{}')
+		FROM product_returns r
+		JOIN payment_types pt ON pt.id = r.payment_type_id
+		LEFT JOIN product_return_items i ON r.id = i.return_id
+		LEFT JOIN products pd ON i.product_id = pd.id
+		WHERE r.id = $1 AND r.company_id = $2 AND r.branch_id = $3
+	`
+
+	rows, err := r.db.Queryx(query, in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query return: %w", err)
+	}
+	defer rows.Close()
+
+	var res *pb.ReturnResponse
+	for rows.Next() {
+		var ret pb.ReturnResponse
+		var itemID, saleItemID, productID, productName, productImage sql.NullString
+		var quantity sql.NullInt32
+		var refundPrice, totalPrice sql.NullFloat64
+		var serials []string
+
+		err = rows.Scan(
+			&ret.Id,
+			&ret.SaleId,
+			&ret.ClientId,
+			&ret.ReturnedBy,
+			&ret.TotalRefund,
+			&ret.DebtReduction,
+			&ret.PaymentMethod,
+			&ret.Reason,
+			&ret.CashFlowId,
+			&ret.CompanyId,
+			&ret.BranchId,
+			&ret.CreatedAt,
+			&ret.Status,
+			&ret.VoidedAt,
+			&ret.VoidedBy,
+			&ret.VoidReason,
+			&itemID,
+			&saleItemID,
+			&productID,
+			&quantity,
+			&refundPrice,
+			&totalPrice,
+			&productName,
+			&productImage,
+			pq.Array(&serials),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan return row: %w", err)
+		}
+
+		if res == nil {
+			res = &ret
+		}
+		if itemID.Valid {
+			res.Items = append(res.Items, &pb.ReturnItem{
+				Id:           itemID.String,
+				ReturnId:     res.Id,
+				SaleItemId:   saleItemID.String,
+				ProductId:    productID.String,
+				Quantity:     quantity.Int32,
+				RefundPrice:  refundPrice.Float64,
+				TotalPrice:   totalPrice.Float64,
+				ProductName:  productName.String,
+				ProductImage: productImage.String,
+				Serials:      serials,
+			})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over return rows: %w", err)
+	}
+	if res == nil {
+		return nil, errors.New("return not found")
+	}
+
+	return res, nil
+}
+
+func (r *returnsRepoImpl) GetReturnedProductsList(in *pb.ReturnFilter) (*pb.ReturnList, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"r.company_id = $1", "r.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.SaleId != "" {
+		filters = append(filters, fmt.Sprintf("r.sale_id = $%d", argIndex))
+		args = append(args, in.SaleId)
+		argIndex++
+	}
+	if in.ClientId != "" {
+		filters = append(filters, fmt.Sprintf("r.client_id = $%d", argIndex))
+		args = append(args, in.ClientId)
+		argIndex++
+	}
+	if in.ReturnedBy != "" {
+		filters = append(filters, fmt.Sprintf("r.returned_by = $%d", argIndex))
+		args = append(args, in.ReturnedBy)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(r.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(r.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+	if !in.IncludeVoided {
+		filters = append(filters, notVoided("r"))
+	}
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM product_returns r WHERE %s`, strings.Join(filters, " AND "))
+
+	var totalCount int64
+	if err := r.db.Get(&totalCount, countQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	mainQuery := fmt.Sprintf(`
+		SELECT
+			r.id, r.sale_id, COALESCE(r.client_id::TEXT, ''), r.returned_by, r.total_refund, r.debt_reduction, pt.name, r.reason,
+			COALESCE(r.cash_flow_id::TEXT, ''), r.company_id, r.branch_id, r.created_at,
+			r.status, COALESCE(r.voided_at::TEXT, ''), COALESCE(r.voided_by::TEXT, ''), r.void_reason,
+			COALESCE(JSON_AGG(
+				JSON_BUILD_OBJECT(
+					'id', i.id,
+					'return_id', i.return_id,
+					'sale_item_id', i.sale_item_id,
+					'product_id', i.product_id,
+					'quantity', i.quantity,
+					'refund_price', i.refund_price,
+					'total_price', i.total_price,
+					'product_name', pr.name,
+					'product_image', pr.image_url,
+					'serials', COALESCE(i.serials, '{}')
+				)
+			) FILTER (WHERE i.id IS NOT NULL), '[]') AS items
+		FROM product_returns r
+		JOIN payment_types pt ON pt.id = r.payment_type_id
+		LEFT JOIN product_return_items i ON r.id = i.return_id
+		LEFT JOIN products pr ON i.product_id = pr.id
+		WHERE %s
+		GROUP BY r.id, pt.name
+		ORDER BY r.created_at DESC`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		mainQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := r.db.Queryx(mainQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch returns: %w", err)
+	}
+	defer rows.Close()
+
+	var returns []*pb.ReturnResponse
+	for rows.Next() {
+		var ret pb.ReturnResponse
+		var itemsJSON string
+
+		err = rows.Scan(
+			&ret.Id,
+			&ret.SaleId,
+			&ret.ClientId,
+			&ret.ReturnedBy,
+			&ret.TotalRefund,
+			&ret.DebtReduction,
+			&ret.PaymentMethod,
+			&ret.Reason,
+			&ret.CashFlowId,
+			&ret.CompanyId,
+			&ret.BranchId,
+			&ret.CreatedAt,
+			&ret.Status,
+			&ret.VoidedAt,
+			&ret.VoidedBy,
+			&ret.VoidReason,
+			&itemsJSON,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan return row: %w", err)
+		}
+
+		var items []*pb.ReturnItem
+		if err := json.Unmarshal([]byte(itemsJSON), &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal return items JSON: %w", err)
+		}
+		ret.Items = items
+
+		returns = append(returns, &ret)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over return rows: %w", err)
+	}
+
+	return &pb.ReturnList{
+		Returns:    returns,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// VoidReturn аннулирует возврат: строки возврата остаются, но больше не учитываются в возвращённом количестве и сумме
+func (r *returnsRepoImpl) VoidReturn(in *pb.VoidReturnReq) error {
+	query := `
+		UPDATE product_returns SET status = 'voided', voided_at = NOW(), voided_by = NULLIF($4, '')::UUID, void_reason = $5
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status <> 'voided'
+	`
+
+	result, err := r.db.Exec(query, in.Id, in.CompanyId, in.BranchId, in.VoidedBy, in.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to void return: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("return not found or already voided")
+	}
+
+	return nil
+}
+
+// HasReturns проверяет, есть ли у продажи возвраты, включая аннулированные: их позиции по-прежнему ссылаются на строки продажи
+func (r *returnsRepoImpl) HasReturns(saleID string) (bool, error) {
+	var exists bool
+	if err := r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM product_returns WHERE sale_id = $1)`, saleID); err != nil {
+		return false, fmt.Errorf("failed to check returns of sale: %w", err)
+	}
+	return exists, nil
+}
+
+// GetReturnedQuantities возвращает уже возвращённое количество по каждой позиции продажи без аннулированных возвратов
+func (r *returnsRepoImpl) GetReturnedQuantities(saleID string) (map[string]int64, error) {
+	query := `
+		SELECT i.sale_item_id, SUM(i.quantity)
+		FROM product_return_items i
+		JOIN product_returns r ON r.id = i.return_id
+		WHERE r.sale_id = $1 AND ` + notVoided("r") + `
+		GROUP BY i.sale_item_id
+	`
+
+	rows, err := r.db.Query(query, saleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query returned quantities: %w", err)
+	}
+	defer rows.Close()
+
+	returned := make(map[string]int64)
+	for rows.Next() {
+		var saleItemID string
+		var quantity int64
+		if err := rows.Scan(&saleItemID, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan returned quantity: %w", err)
+		}
+		returned[saleItemID] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over returned quantities: %w", err)
+	}
+
+	return returned, nil
+}
+
+// SetReturnCashFlow связывает возврат с расходом, которым проведён возврат денег
+func (r *returnsRepoImpl) SetReturnCashFlow(returnID, cashFlowID string) error {
+	if _, err := r.db.Exec(`UPDATE product_returns SET cash_flow_id = $1 WHERE id = $2`, cashFlowID, returnID); err != nil {
+		return fmt.Errorf("failed to link cash flow to return: %w", err)
+	}
+	return nil
+}
+
+// GetRefundedAmount возвращает сумму, уже выплаченную покупателю по возвратам продажи без аннулированных возвратов
+func (r *returnsRepoImpl) GetRefundedAmount(saleID string) (float64, error) {
+	var refunded float64
+	query := `SELECT COALESCE(SUM(total_refund), 0) FROM product_returns WHERE sale_id = $1 AND status <> 'voided'`
+	if err := r.db.Get(&refunded, query, saleID); err != nil {
+		return 0, fmt.Errorf("failed to get refunded amount: %w", err)
+	}
+	return refunded, nil
+}
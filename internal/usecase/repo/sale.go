@@ -4,17 +4,19 @@ import (
 	"crm-admin/internal/entity"
 	pb "crm-admin/internal/generated/products"
 	"crm-admin/internal/usecase"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"log"
 	"strings"
 	"time"
 )
 
 type salesRepoImpl struct {
-	db *sqlx.DB
+	db dbtx
 }
 
 func NewSalesRepo(db *sqlx.DB) usecase.SalesRepo {
@@ -30,6 +32,19 @@ type SaleUpdateParams struct {
 	PaymentMethod *string `db:"payment_method,omitempty"`
 }
 
+// notVoided условие, исключающее аннулированные документы таблицы с указанным псевдонимом
+func notVoided(alias string) string {
+	return alias + ".status <> 'voided'"
+}
+
+// voidedFilter дополнительное условие WHERE для статистики: аннулированные документы учитываются только по запросу
+func voidedFilter(alias string, includeVoided bool) string {
+	if includeVoided {
+		return ""
+	}
+	return " AND " + notVoided(alias)
+}
+
 // CreateSale создает новую продажу и соответствующие элементы продажи
 func (r *salesRepoImpl) CreateSale(in *entity.SalesTotal) (*pb.SaleResponse, error) {
 	if len(in.SoldProducts) == 0 {
@@ -37,7 +52,7 @@ func (r *salesRepoImpl) CreateSale(in *entity.SalesTotal) (*pb.SaleResponse, err
 	}
 
 	// Начинаем транзакцию сразу
-	tx, err := r.db.Beginx()
+	tx, err := beginTx(r.db)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -48,57 +63,131 @@ func (r *salesRepoImpl) CreateSale(in *entity.SalesTotal) (*pb.SaleResponse, err
 	}()
 
 	// Вставляем продажу и получаем ID
-	var saleID string
+	var saleID, paymentMethod string
 	var createdAt time.Time
-	query := `
-		INSERT INTO sales (company_id, branch_id, client_id, sold_by, total_sale_price, payment_method)
-		VALUES ($1, $2, $3, $4, $5, $6) 
-		RETURNING id, created_at
-	`
-	err = tx.QueryRowx(query, in.CompanyID, in.BranchID, in.ClientID, in.SoldBy, in.TotalSalePrice, in.PaymentMethod).
-		Scan(&saleID, &createdAt)
+	query := fmt.Sprintf(`
+		INSERT INTO sales (company_id, branch_id, client_id, sold_by, total_sale_price, payment_type_id, is_for_debt, paid_amount)
+		VALUES ($1, $2, NULLIF($3, '')::UUID, $4, $5, %s, $7, $8) 
+		RETURNING id, %s, created_at
+	`, paymentTypeID("$1", "$6"), paymentTypeName("payment_type_id"))
+	err = tx.QueryRowx(query, in.CompanyID, in.BranchID, in.ClientID, in.SoldBy, in.TotalSalePrice, in.PaymentMethod, in.IsForDebt, in.PaidAmount).
+		Scan(&saleID, &paymentMethod, &createdAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sale: %w", err)
 	}
 
-	// Готовим batch-вставку для sales_items
+	if err = insertSaleItems(tx, saleID, in); err != nil {
+		return nil, err
+	}
+
+	// Фиксируем транзакцию
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Возвращаем успешный результат
+	return &pb.SaleResponse{
+		Id:             saleID,
+		CreatedAt:      time.Now().String(),
+		TotalSalePrice: in.TotalSalePrice,
+		ClientId:       in.ClientID,
+		SoldBy:         in.SoldBy,
+		PaymentMethod:  paymentMethod,
+		IsForDebt:      in.IsForDebt,
+		PaidAmount:     in.PaidAmount,
+		Status:         entity.DocumentCompleted,
+	}, nil
+}
+
+// insertSaleItems сохраняет позиции продажи одним запросом
+func insertSaleItems(tx dbtx, saleID string, in *entity.SalesTotal) error {
 	var queryBuilder strings.Builder
 	args := []interface{}{}
 	queryBuilder.WriteString(`
-		INSERT INTO sales_items (company_id, branch_id, sale_id, product_id, quantity, sale_price, total_price) VALUES
+		INSERT INTO sales_items (company_id, branch_id, sale_id, product_id, catalog_id, quantity, sale_price, total_price, unit, unit_quantity, serials) VALUES
 	`)
 	for i, item := range in.SoldProducts {
-		item.SaleID = saleID
-		startIdx := i * 7 // Учитываем кол-во параметров на один элемент
+		startIdx := i * 10 // Учитываем кол-во параметров на один элемент
 
 		if i > 0 {
 			queryBuilder.WriteString(", ")
 		}
-		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7))
+		// Карточка каталога берётся из строки товара филиала
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, (SELECT catalog_id FROM products WHERE id = $%d), $%d, $%d, $%d, NULLIF($%d, ''), NULLIF($%d::NUMERIC, 0), $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8, startIdx+9, startIdx+10))
 
-		args = append(args, in.CompanyID, in.BranchID, saleID, item.ProductID, item.Quantity, item.SalePrice, item.TotalPrice)
+		args = append(args, in.CompanyID, in.BranchID, saleID, item.ProductID, item.Quantity, item.SalePrice, item.TotalPrice, item.Unit, item.UnitQuantity,
+			pq.Array(item.Serials))
 	}
 
-	// Выполняем batch-вставку
-	if _, err = tx.Exec(queryBuilder.String(), args...); err != nil {
-		return nil, fmt.Errorf("failed to insert sales items: %w", err)
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert sales items: %w", err)
 	}
 
-	// Фиксируем транзакцию
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	return nil
+}
+
+// ReplaceSaleItems заменяет позиции продажи новым набором и пересчитывает её сумму
+func (r *salesRepoImpl) ReplaceSaleItems(saleID string, in *entity.SalesTotal) error {
+	if len(in.SoldProducts) == 0 {
+		return errors.New("cannot leave a sale without sold products")
 	}
 
-	// Возвращаем успешный результат
-	return &pb.SaleResponse{
-		Id:             saleID,
-		CreatedAt:      time.Now().String(),
-		TotalSalePrice: in.TotalSalePrice,
-		ClientId:       in.ClientID,
-		SoldBy:         in.SoldBy,
-		PaymentMethod:  in.PaymentMethod,
-	}, nil
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result, err := tx.Exec(`
+		UPDATE sales SET total_sale_price = $1, paid_amount = $2
+		WHERE id = $3 AND company_id = $4 AND branch_id = $5 AND status <> 'voided'
+	`, in.TotalSalePrice, in.PaidAmount, saleID, in.CompanyID, in.BranchID)
+	if err != nil {
+		return fmt.Errorf("failed to update sale total: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = errors.New("sale not found or voided")
+		return err
+	}
+
+	if _, err = tx.Exec(`DELETE FROM sales_items WHERE sale_id = $1`, saleID); err != nil {
+		return fmt.Errorf("failed to delete sales items: %w", err)
+	}
+
+	if err = insertSaleItems(tx, saleID, in); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetSaleItemCosts запоминает в позициях продажи себестоимость проданного товара из её движений по складу.
+// После правки позиций движения продажи содержат и возврат на склад, поэтому берётся их итог по товару
+func (r *salesRepoImpl) SetSaleItemCosts(saleID string) error {
+	_, err := r.db.Exec(`
+		UPDATE sales_items i SET cost_price = c.cost
+		FROM (
+			SELECT product_id, SUM(-delta * unit_cost) / NULLIF(SUM(-delta), 0) AS cost
+			FROM inventory_movements
+			WHERE source_id = $1 AND reason = $2 AND unit_cost IS NOT NULL
+			GROUP BY product_id
+		) c
+		WHERE i.sale_id = $1 AND i.product_id = c.product_id
+	`, saleID, entity.MovementSale)
+	if err != nil {
+		return fmt.Errorf("failed to set sale item costs: %w", err)
+	}
+
+	return nil
 }
 
 // UpdateSale обновляет детали продажи
@@ -115,15 +204,15 @@ func (r *salesRepoImpl) UpdateSale(in *pb.SaleUpdate) (*pb.SaleResponse, error)
 		params = append(params, in.ClientId)
 	}
 	if in.PaymentMethod != "" {
-		updates = append(updates, fmt.Sprintf("payment_method = $%d", len(params)+1))
+		updates = append(updates, "payment_type_id = "+paymentTypeID("$2", fmt.Sprintf("$%d", len(params)+1)))
 		params = append(params, in.PaymentMethod)
 	}
 
 	query := fmt.Sprintf(`
 		UPDATE sales SET %s
-		WHERE id = $1 AND company_id = $2 AND branch_id = $3
-		RETURNING id, client_id, sold_by, total_sale_price, payment_method, created_at
-	`, strings.Join(updates, ", "))
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status <> 'voided'
+		RETURNING id, COALESCE(client_id::TEXT, ''), sold_by, total_sale_price, %s, created_at
+	`, strings.Join(updates, ", "), paymentTypeName("payment_type_id"))
 
 	sale := &pb.SaleResponse{}
 	err := r.db.QueryRow(query, params...).
@@ -139,9 +228,12 @@ func (r *salesRepoImpl) UpdateSale(in *pb.SaleUpdate) (*pb.SaleResponse, error)
 func (r *salesRepoImpl) GetSale(in *pb.SaleID) (*pb.SaleResponse, error) {
 	query := `
 		SELECT 
-			s.id, s.client_id, s.sold_by, s.total_sale_price, s.payment_method, s.created_at,
-			i.id AS item_id, i.product_id, i.quantity, i.sale_price, i.total_price, pd.name, pd.image_url
+			s.id, COALESCE(s.client_id::TEXT, ''), s.sold_by, s.total_sale_price, pt.name, s.is_for_debt, s.paid_amount, s.created_at,
+			s.status, COALESCE(s.voided_at::TEXT, ''), COALESCE(s.voided_by::TEXT, ''), s.void_reason,
+			i.id AS item_id, i.product_id, i.quantity, i.sale_price, i.total_price, pd.name, pd.image_url,
+			COALESCE(i.unit, ''), COALESCE(i.unit_quantity, 0), COALESCE(i.serials, '{}'), COALESCE(i.cost_price, 0)
 		FROM sales s
+		JOIN payment_types pt ON pt.id = s.payment_type_id
 		LEFT JOIN sales_items i ON s.id = i.sale_id
 		LEFT JOIN products pd ON i.product_id = pd.id
 		WHERE s.id = $1 AND s.company_id = $2 AND s.branch_id = $3
@@ -164,7 +256,13 @@ func (r *salesRepoImpl) GetSale(in *pb.SaleID) (*pb.SaleResponse, error) {
 			&sale.SoldBy,
 			&sale.TotalSalePrice,
 			&sale.PaymentMethod,
+			&sale.IsForDebt,
+			&sale.PaidAmount,
 			&sale.CreatedAt,
+			&sale.Status,
+			&sale.VoidedAt,
+			&sale.VoidedBy,
+			&sale.VoidReason,
 			&item.Id,
 			&item.ProductId,
 			&item.Quantity,
@@ -172,6 +270,10 @@ func (r *salesRepoImpl) GetSale(in *pb.SaleID) (*pb.SaleResponse, error) {
 			&item.TotalPrice,
 			&item.ProductName,
 			&item.ProductImage,
+			&item.Unit,
+			&item.UnitQuantity,
+			pq.Array(&item.Serials),
+			&item.CostPrice,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan sale row: %w", err)
@@ -186,6 +288,14 @@ func (r *salesRepoImpl) GetSale(in *pb.SaleID) (*pb.SaleResponse, error) {
 	}
 
 	sale.SoldProducts = soldProducts
+
+	if sale.Id != "" {
+		sale.Payments, err = getPayments(r.db, salePayments, sale.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return sale, nil
 }
 
@@ -225,6 +335,10 @@ func (r *salesRepoImpl) GetSaleList(in *pb.SaleFilter) (*pb.SaleList, error) {
 		argIndex++
 	}
 
+	if !in.IncludeVoided {
+		filters = append(filters, notVoided("s"))
+	}
+
 	// Подсчёт общего количества записей продаж
 	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
@@ -239,7 +353,8 @@ func (r *salesRepoImpl) GetSaleList(in *pb.SaleFilter) (*pb.SaleList, error) {
 	// Основной запрос с агрегированием данных
 	mainQuery := fmt.Sprintf(`
 		SELECT 
-			s.id AS sale_id, s.branch_id, s.client_id, s.sold_by, s.total_sale_price, s.payment_method, s.created_at,
+			s.id AS sale_id, s.branch_id, COALESCE(s.client_id::TEXT, '') AS client_id, s.sold_by, s.total_sale_price, pt.name AS payment_method, s.is_for_debt, s.paid_amount, s.created_at,
+			s.status, COALESCE(s.voided_at::TEXT, ''), COALESCE(s.voided_by::TEXT, ''), s.void_reason,
 			COALESCE(JSON_AGG(
 				JSON_BUILD_OBJECT(
 					'id', i.id,
@@ -248,14 +363,19 @@ func (r *salesRepoImpl) GetSaleList(in *pb.SaleFilter) (*pb.SaleList, error) {
 					'sale_price', i.sale_price,
 					'total_price', i.total_price,
 					'product_name', pr.name,
-					'product_image', pr.image_url
+					'product_image', pr.image_url,
+					'unit', COALESCE(i.unit, ''),
+					'unit_quantity', COALESCE(i.unit_quantity, 0),
+					'serials', COALESCE(i.serials, '{}'),
+					'cost_price', COALESCE(i.cost_price, 0)
 				)
 			) FILTER (WHERE i.id IS NOT NULL), '[]') AS sold_products
 		FROM sales s
+		JOIN payment_types pt ON pt.id = s.payment_type_id
 		LEFT JOIN sales_items i ON s.id = i.sale_id
 		LEFT JOIN products pr ON i.product_id = pr.id
 		WHERE %s
-		GROUP BY s.id
+		GROUP BY s.id, pt.name
 		ORDER BY s.created_at DESC`, strings.Join(filters, " AND "))
 
 	// Добавляем пагинацию
@@ -283,7 +403,13 @@ func (r *salesRepoImpl) GetSaleList(in *pb.SaleFilter) (*pb.SaleList, error) {
 			&sale.SoldBy,
 			&sale.TotalSalePrice,
 			&sale.PaymentMethod,
+			&sale.IsForDebt,
+			&sale.PaidAmount,
 			&sale.CreatedAt,
+			&sale.Status,
+			&sale.VoidedAt,
+			&sale.VoidedBy,
+			&sale.VoidReason,
 			&soldProductsJSON,
 		)
 		if err != nil {
@@ -307,41 +433,27 @@ func (r *salesRepoImpl) GetSaleList(in *pb.SaleFilter) (*pb.SaleList, error) {
 	}, nil
 }
 
-// DeleteSale удаляет продажу и связанные с ней элементы
-func (r *salesRepoImpl) DeleteSale(in *pb.SaleID) (*pb.Message, error) {
-	tx, err := r.db.Beginx()
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		}
-	}()
-
-	_, err = tx.Exec(`DELETE FROM sales_items WHERE sale_id = $1 AND company_id = $2 AND branch_id = $3`, in.Id, in.CompanyId, in.BranchId)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete sales items: %w", err)
-	}
+// VoidSale аннулирует продажу: строки продажи остаются, но больше не учитываются в списках и статистике
+func (r *salesRepoImpl) VoidSale(in *pb.VoidSaleReq) error {
+	query := `
+		UPDATE sales SET status = 'voided', voided_at = NOW(), voided_by = NULLIF($4, '')::UUID, void_reason = $5
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status <> 'voided'
+	`
 
-	result, err := tx.Exec(`DELETE FROM sales WHERE id = $1 AND company_id = $2 AND branch_id = $3`, in.Id, in.CompanyId, in.BranchId)
+	result, err := r.db.Exec(query, in.Id, in.CompanyId, in.BranchId, in.VoidedBy, in.Reason)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete sale: %w", err)
+		return fmt.Errorf("failed to void sale: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return nil, errors.New("sale not found")
+		return errors.New("sale not found or already voided")
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	return &pb.Message{Message: "Sale deleted successfully"}, nil
+	return nil
 }
 
 // GetSalesByDay получает данные о продажах, сгруппированные по дню и продукту
@@ -356,7 +468,7 @@ func (r *salesRepoImpl) GetSalesByDay(request *pb.MostSoldProductsRequest) ([]*p
 		FROM sales s
 		INNER JOIN sales_items si ON s.id = si.sale_id
 		INNER JOIN products p ON si.product_id = p.id
-		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.created_at BETWEEN $3 AND $4
+		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.created_at BETWEEN $3 AND $4` + voidedFilter("s", request.IncludeVoided) + `
 		GROUP BY day, p.name
 		ORDER BY day, total_quantity DESC
 	`
@@ -393,7 +505,7 @@ func (r *salesRepoImpl) GetTopClients(in *pb.GetTopEntitiesRequest) ([]*pb.TopEn
 	query := `
 		SELECT client_id, SUM(total_sale_price) AS total_sum 
 		FROM sales
-		WHERE company_id = $1 
+		WHERE company_id = $1 AND client_id IS NOT NULL` + voidedFilter("sales", in.IncludeVoided) + `
 		GROUP BY client_id  
 		ORDER BY total_sum DESC 
 		LIMIT $2
@@ -422,16 +534,24 @@ func (r *salesRepoImpl) GetTopClients(in *pb.GetTopEntitiesRequest) ([]*pb.TopEn
 	return entities, nil
 }
 
-// GetTopSuppliers получает топ поставщиков по общей сумме затрат
+// GetTopSuppliers получает топ поставщиков по общей сумме затрат за вычетом возвратов
 func (r *salesRepoImpl) GetTopSuppliers(in *pb.GetTopEntitiesRequest) ([]*pb.TopEntity, error) {
 	if in.Limit == 0 {
 		in.Limit = 10
 	}
 
+	// Возвраты поставщику уменьшают сумму закупок: и деньгами, и зачётом в счёт будущих закупок
 	query := `
-		SELECT supplier_id, SUM(total_cost) AS total_sum 
-		FROM purchases
-		WHERE company_id = $1 
+		SELECT supplier_id, SUM(amount) AS total_sum 
+		FROM (
+			SELECT supplier_id, total_cost AS amount
+			FROM purchases
+			WHERE company_id = $1` + voidedFilter("purchases", in.IncludeVoided) + `
+			UNION ALL
+			SELECT supplier_id, -total_refund
+			FROM supplier_returns
+			WHERE company_id = $1` + voidedFilter("supplier_returns", in.IncludeVoided) + `
+		) t
 		GROUP BY supplier_id  
 		ORDER BY total_sum DESC 
 		LIMIT $2
@@ -471,15 +591,16 @@ func (r *salesRepoImpl) GetSaleStatistics(in *pb.SaleStatisticsReq) (*pb.SaleSta
 	// SQL-запрос с группировкой по датам и валютам
 	query := `
         SELECT 
-            DATE_TRUNC($1, created_at) AS period,
-            payment_method,
-            SUM(total_sale_price) AS total_sales
-        FROM sales
+            DATE_TRUNC($1, s.created_at) AS period,
+            pt.name,
+            SUM(s.total_sale_price) AS total_sales
+        FROM sales s
+        JOIN payment_types pt ON pt.id = s.payment_type_id
         WHERE 
-            created_at BETWEEN $2 AND $3
-            AND company_id = $4
-            AND branch_id = $5
-        GROUP BY period, payment_method
+            s.created_at BETWEEN $2 AND $3
+            AND s.company_id = $4
+            AND s.branch_id = $5` + voidedFilter("s", in.IncludeVoided) + `
+        GROUP BY period, pt.name
         ORDER BY period;
     `
 
@@ -490,7 +611,7 @@ func (r *salesRepoImpl) GetSaleStatistics(in *pb.SaleStatisticsReq) (*pb.SaleSta
 	defer rows.Close()
 
 	// Хранилище для данных
-	dataMap := make(map[string]map[string]float64) // date -> payment_method -> total_sales
+	dataMap := make(map[string]map[string]float64) // date -> payment type -> total_sales
 	totalSum := 0.0
 
 	for rows.Next() {
@@ -530,3 +651,15 @@ func (r *salesRepoImpl) GetSaleStatistics(in *pb.SaleStatisticsReq) (*pb.SaleSta
 	result.Total = totalSum
 	return result, nil
 }
+
+// LockSale блокирует продажу до конца транзакции, чтобы возвраты и аннулирование продажи шли по очереди
+func (r *salesRepoImpl) LockSale(saleID string) error {
+	var id string
+	if err := r.db.Get(&id, `SELECT id FROM sales WHERE id = $1 FOR UPDATE`, saleID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("sale not found")
+		}
+		return fmt.Errorf("failed to lock sale: %w", err)
+	}
+	return nil
+}
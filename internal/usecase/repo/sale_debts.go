@@ -0,0 +1,163 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"crm-admin/internal/usecase"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// saleDebtLease время, на которое отправка арендует запрос к сервису долгов
+const saleDebtLease = 5 * time.Minute
+
+type saleDebtsRepoImpl struct {
+	db dbtx
+}
+
+func NewSaleDebtsRepo(db *sqlx.DB) usecase.SaleDebtsRepo {
+	return &saleDebtsRepoImpl{db: db}
+}
+
+// EnqueueSaleDebt ставит в очередь создание клиента и долга продажи
+func (r *saleDebtsRepoImpl) EnqueueSaleDebt(in entity.SaleDebt) error {
+	query := `
+		INSERT INTO sale_debts (sale_id, kind, client_id, client_name, client_phone, amount, currency_code, company_id)
+		VALUES ($1, 'create', $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.Exec(query, in.SaleID, in.ClientID, in.ClientName, in.ClientPhone, in.Amount, in.CurrencyCode, in.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue sale debt: %w", err)
+	}
+	return nil
+}
+
+// ReduceSaleDebt ставит в очередь уменьшение долга продажи на amount.
+// Продажа без долга, а также продажа, долг которой так и не был создан, пропускаются.
+func (r *saleDebtsRepoImpl) ReduceSaleDebt(saleID, sourceID, payType string, amount float64) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO sale_debts (sale_id, kind, source_id, amount, pay_type, company_id)
+		SELECT sale_id, 'reduce', $2, $3, $4, company_id
+		FROM sale_debts
+		WHERE sale_id = $1 AND kind = 'create' AND status IN ('pending', 'done') AND amount > 0`
+
+	if _, err := r.db.Exec(query, saleID, sourceID, amount, payType); err != nil {
+		return fmt.Errorf("failed to enqueue sale debt reduction: %w", err)
+	}
+	return nil
+}
+
+// VoidSaleDebt снимает долг аннулированной продажи: ещё не отправленные запросы отменяются,
+// а долг, который уже создан или создаётся прямо сейчас, уменьшается на всю сумму
+func (r *saleDebtsRepoImpl) VoidSaleDebt(saleID string) error {
+	cancelQuery := `
+		UPDATE sale_debts
+		SET status = 'cancelled', error = 'sale voided', processed_at = NOW()
+		WHERE sale_id = $1 AND status = 'pending' AND (claimed_until IS NULL OR claimed_until < NOW())`
+
+	if _, err := r.db.Exec(cancelQuery, saleID); err != nil {
+		return fmt.Errorf("failed to cancel sale debt requests: %w", err)
+	}
+
+	reduceQuery := `
+		INSERT INTO sale_debts (sale_id, kind, source_id, amount, pay_type, company_id)
+		SELECT sale_id, 'reduce', sale_id, amount, 'void', company_id
+		FROM sale_debts
+		WHERE sale_id = $1 AND kind = 'create' AND status IN ('pending', 'done') AND amount > 0`
+
+	if _, err := r.db.Exec(reduceQuery, saleID); err != nil {
+		return fmt.Errorf("failed to enqueue voided sale debt reduction: %w", err)
+	}
+	return nil
+}
+
+// ClaimSaleDebts забирает ожидающие запросы на отправку, увеличивает счётчик попыток и арендует их,
+// как ClaimStockAlerts. Уменьшение долга забирается только после того, как долг продажи создан,
+// и получает его debt_id. Непустой saleID ограничивает выборку одной продажей.
+func (r *saleDebtsRepoImpl) ClaimSaleDebts(limit int, saleID string) ([]entity.SaleDebt, error) {
+	var res []entity.SaleDebt
+
+	query := `
+		WITH claimed AS (
+			UPDATE sale_debts
+			SET attempts = attempts + 1, claimed_until = NOW() + $2 * INTERVAL '1 second'
+			WHERE id IN (
+				SELECT d.id FROM sale_debts d
+				WHERE d.status = 'pending' AND (d.claimed_until IS NULL OR d.claimed_until < NOW())
+				  AND ($3 = '' OR d.sale_id::TEXT = $3)
+				  AND (d.kind = 'create' OR EXISTS (
+					SELECT 1 FROM sale_debts c
+					WHERE c.sale_id = d.sale_id AND c.kind = 'create' AND c.status = 'done'
+				  ))
+				ORDER BY d.created_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, sale_id, kind, source_id, client_id, client_name, client_phone, amount, currency_code,
+				pay_type, debt_id, attempts, company_id, created_at
+		)
+		SELECT
+			c.id, c.sale_id, c.kind, COALESCE(c.source_id::TEXT, '') AS source_id, c.client_id, c.client_name,
+			c.client_phone, c.amount, c.currency_code, c.pay_type, COALESCE(NULLIF(c.debt_id, ''), cr.debt_id, '') AS debt_id,
+			c.attempts, c.company_id
+		FROM claimed c
+		LEFT JOIN sale_debts cr ON c.kind = 'reduce' AND cr.sale_id = c.sale_id AND cr.kind = 'create' AND cr.status = 'done'
+		ORDER BY c.created_at`
+
+	if err := r.db.Select(&res, query, limit, saleDebtLease.Seconds(), saleID); err != nil {
+		return nil, fmt.Errorf("failed to claim sale debts: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetSaleDebtClient записывает клиента, заведённого в сервисе долгов, в запрос, продажу и её возвраты
+func (r *saleDebtsRepoImpl) SetSaleDebtClient(id, saleID, clientID string) error {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(`UPDATE sale_debts SET client_id = $1 WHERE id = $2`, clientID, id); err != nil {
+		return fmt.Errorf("failed to update sale debt client: %w", err)
+	}
+	if _, err = tx.Exec(`UPDATE sales SET client_id = $1 WHERE id = $2`, clientID, saleID); err != nil {
+		return fmt.Errorf("failed to update sale client: %w", err)
+	}
+	if _, err = tx.Exec(`UPDATE product_returns SET client_id = $1 WHERE sale_id = $2 AND client_id IS NULL`, clientID, saleID); err != nil {
+		return fmt.Errorf("failed to update return client: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// SetSaleDebtStatus записывает результат отправки запроса.
+// Если долг продажи создать не удалось, ожидающие уменьшения этого долга отменяются.
+func (r *saleDebtsRepoImpl) SetSaleDebtStatus(id, status, debtID, errMsg string) error {
+	query := `
+		WITH updated AS (
+			UPDATE sale_debts
+			SET status = $1, debt_id = COALESCE(NULLIF($2, ''), debt_id), error = $3, claimed_until = NULL,
+				processed_at = CASE WHEN $1 = 'pending' THEN processed_at ELSE NOW() END
+			WHERE id = $4
+			RETURNING sale_id, kind, status
+		)
+		UPDATE sale_debts d
+		SET status = 'cancelled', error = 'sale debt was not created', processed_at = NOW()
+		FROM updated u
+		WHERE u.kind = 'create' AND u.status = 'failed'
+		  AND d.sale_id = u.sale_id AND d.kind = 'reduce' AND d.status = 'pending'`
+
+	if _, err := r.db.Exec(query, status, debtID, errMsg, id); err != nil {
+		return fmt.Errorf("failed to update sale debt status: %w", err)
+	}
+	return nil
+}
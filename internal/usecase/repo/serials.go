@@ -0,0 +1,333 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"sort"
+	"strings"
+)
+
+type serialsRepoImpl struct {
+	db dbtx
+}
+
+func NewSerialsRepo(db *sqlx.DB) usecase.SerialsRepo {
+	return &serialsRepoImpl{db: db}
+}
+
+// serialRow серийный номер, изменённый событием
+type serialRow struct {
+	ID        string
+	Serial    string
+	ProductID string
+	BranchID  string
+}
+
+// GetSerializedProducts возвращает товары из списка, которые ведутся по серийным номерам
+func (r *serialsRepoImpl) GetSerializedProducts(productIDs []string) (map[string]bool, error) {
+	res := make(map[string]bool)
+	if len(productIDs) == 0 {
+		return res, nil
+	}
+
+	rows, err := r.db.Queryx(`
+		SELECT p.id
+		FROM products p
+		JOIN catalog_items c ON c.id = p.catalog_id
+		WHERE p.id = ANY($1::UUID[]) AND c.is_serialized
+	`, pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch serialized products: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan serialized product: %w", err)
+		}
+		res[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating serialized products: %w", err)
+	}
+
+	return res, nil
+}
+
+// ReceiveSerials ставит серийные номера на склад товара. Новый номер создаётся, а проданная, возвращённая
+// поставщику или списанная единица той же карточки возвращается на склад той же строкой. Номер, который уже
+// на складе или в пути, принять нельзя
+func (r *serialsRepoImpl) ReceiveSerials(productID string, serials []string, info entity.MovementInfo) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	rows, err := r.db.Queryx(`
+		INSERT INTO product_serials (serial, catalog_id, product_id, status, company_id, branch_id)
+		SELECT s.serial, p.catalog_id, p.id, $3, p.company_id, p.branch_id
+		FROM products p, UNNEST($2::TEXT[]) AS s(serial)
+		WHERE p.id = $1
+		ON CONFLICT (company_id, serial) DO UPDATE
+			SET product_id = EXCLUDED.product_id, branch_id = EXCLUDED.branch_id, status = EXCLUDED.status, updated_at = NOW()
+			WHERE product_serials.catalog_id = EXCLUDED.catalog_id AND product_serials.status NOT IN ($3, $4)
+		RETURNING id, serial, product_id, branch_id
+	`, productID, pq.Array(serials), entity.SerialInStock, entity.SerialInTransit)
+	if err != nil {
+		return fmt.Errorf("failed to receive serials: %w", err)
+	}
+
+	changed, err := scanSerialRows(rows)
+	if err != nil {
+		return err
+	}
+	if missing := missingSerials(serials, changed); len(missing) > 0 {
+		return fmt.Errorf("serials already in stock or registered to another product: %s", strings.Join(missing, ", "))
+	}
+
+	return insertSerialMovements(r.db, changed, entity.SerialInStock, info)
+}
+
+// SetSerialStatus переводит серийные номера товара из состояния from в состояние to.
+// Все номера должны принадлежать товару и быть в состоянии from
+func (r *serialsRepoImpl) SetSerialStatus(productID string, serials []string, from, to string, info entity.MovementInfo) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	rows, err := r.db.Queryx(`
+		UPDATE product_serials
+		SET status = $4, updated_at = NOW()
+		WHERE product_id = $1 AND serial = ANY($2::TEXT[]) AND status = $3
+		RETURNING id, serial, product_id, branch_id
+	`, productID, pq.Array(serials), from, to)
+	if err != nil {
+		return fmt.Errorf("failed to update serials: %w", err)
+	}
+
+	changed, err := scanSerialRows(rows)
+	if err != nil {
+		return err
+	}
+	if missing := missingSerials(serials, changed); len(missing) > 0 {
+		return fmt.Errorf("serials of product %s are not %s: %s", productID, from, strings.Join(missing, ", "))
+	}
+
+	return insertSerialMovements(r.db, changed, to, info)
+}
+
+// MoveSerials переносит серийные номера в пути на строку той же карточки в целевом филиале и ставит их на склад
+func (r *serialsRepoImpl) MoveSerials(productID, toBranchID string, serials []string, info entity.MovementInfo) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	rows, err := r.db.Queryx(`
+		UPDATE product_serials ps
+		SET product_id = t.id, branch_id = t.branch_id, status = $4, updated_at = NOW()
+		FROM products s
+		JOIN products t ON t.catalog_id = s.catalog_id AND t.branch_id = $2
+		WHERE s.id = $1 AND ps.product_id = s.id AND ps.serial = ANY($3::TEXT[]) AND ps.status = $5
+		RETURNING ps.id, ps.serial, ps.product_id, ps.branch_id
+	`, productID, toBranchID, pq.Array(serials), entity.SerialInStock, entity.SerialInTransit)
+	if err != nil {
+		return fmt.Errorf("failed to move serials: %w", err)
+	}
+
+	changed, err := scanSerialRows(rows)
+	if err != nil {
+		return err
+	}
+	if missing := missingSerials(serials, changed); len(missing) > 0 {
+		return fmt.Errorf("serials of product %s are not in transit: %s", productID, strings.Join(missing, ", "))
+	}
+
+	return insertSerialMovements(r.db, changed, entity.SerialInStock, info)
+}
+
+// scanSerialRows читает изменённые серийные номера
+func scanSerialRows(rows *sqlx.Rows) ([]serialRow, error) {
+	defer rows.Close()
+
+	var res []serialRow
+	for rows.Next() {
+		var s serialRow
+		if err := rows.Scan(&s.ID, &s.Serial, &s.ProductID, &s.BranchID); err != nil {
+			return nil, fmt.Errorf("failed to scan serial: %w", err)
+		}
+		res = append(res, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating serials: %w", err)
+	}
+
+	return res, nil
+}
+
+// missingSerials возвращает запрошенные номера, которых нет среди изменённых
+func missingSerials(requested []string, changed []serialRow) []string {
+	done := make(map[string]bool, len(changed))
+	for _, s := range changed {
+		done[s.Serial] = true
+	}
+
+	var missing []string
+	for _, serial := range requested {
+		if !done[serial] {
+			missing = append(missing, serial)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
+
+// insertSerialMovements записывает события в историю серийных номеров
+func insertSerialMovements(tx dbtx, serials []serialRow, status string, info entity.MovementInfo) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(`
+		INSERT INTO serial_movements (serial_id, reason, status, source_id, product_id, branch_id, created_by) VALUES
+	`)
+
+	args := []interface{}{}
+	for i, s := range serials {
+		startIdx := i * 7
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, NULLIF($%d, '')::UUID, $%d, $%d, NULLIF($%d, '')::UUID)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7))
+
+		args = append(args, s.ID, info.Reason, status, info.SourceID, s.ProductID, s.BranchID, info.CreatedBy)
+	}
+
+	if _, err := tx.Exec(queryBuilder.String(), args...); err != nil {
+		return fmt.Errorf("failed to insert serial movements: %w", err)
+	}
+
+	return nil
+}
+
+// TraceSerial возвращает серийный номер с полной историей: закупка, перемещения, продажа и возвраты.
+// Для продажи и возврата указывается клиент, для закупки и возврата поставщику - поставщик
+func (r *serialsRepoImpl) TraceSerial(in *pb.TraceSerialReq) (*pb.SerialTrace, error) {
+	var serialID string
+	res := &pb.SerialTrace{}
+	err := r.db.QueryRowx(`
+		SELECT ps.id, ps.serial, ps.status, ps.product_id, COALESCE(p.name, ''), ps.branch_id
+		FROM product_serials ps
+		LEFT JOIN products p ON p.id = ps.product_id
+		WHERE ps.company_id = $1 AND ps.serial = $2
+	`, in.CompanyId, in.Serial).Scan(&serialID, &res.Serial, &res.Status, &res.ProductId, &res.ProductName, &res.BranchId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("serial %s not found", in.Serial)
+		}
+		return nil, fmt.Errorf("failed to fetch serial: %w", err)
+	}
+
+	rows, err := r.db.Queryx(`
+		SELECT
+			m.reason, m.status, COALESCE(m.source_id::TEXT, ''), m.product_id, COALESCE(p.name, ''), m.branch_id,
+			COALESCE(s.client_id::TEXT, pr.client_id::TEXT, ''),
+			COALESCE(pu.supplier_id::TEXT, sr.supplier_id::TEXT, ''),
+			COALESCE(m.created_by::TEXT, ''), m.created_at
+		FROM serial_movements m
+		LEFT JOIN products p ON p.id = m.product_id
+		LEFT JOIN sales s ON m.reason = 'sale' AND s.id = m.source_id
+		LEFT JOIN product_returns pr ON m.reason = 'return' AND pr.id = m.source_id
+		LEFT JOIN purchases pu ON m.reason = 'purchase' AND pu.id = m.source_id
+		LEFT JOIN supplier_returns sr ON m.reason = 'supplier_return' AND sr.id = m.source_id
+		WHERE m.serial_id = $1
+		ORDER BY m.created_at, m.id
+	`, serialID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch serial history: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e pb.SerialEvent
+		if err := rows.Scan(
+			&e.Reason,
+			&e.Status,
+			&e.SourceId,
+			&e.ProductId,
+			&e.ProductName,
+			&e.BranchId,
+			&e.ClientId,
+			&e.SupplierId,
+			&e.CreatedBy,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan serial event: %w", err)
+		}
+		res.Events = append(res.Events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating serial history: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetProductSerials возвращает серийные номера товаров филиала в заданном состоянии, по умолчанию - на складе
+func (r *serialsRepoImpl) GetProductSerials(in *pb.ProductSerialsReq) (*pb.ProductSerialList, error) {
+	status := in.Status
+	if status == "" {
+		status = entity.SerialInStock
+	}
+
+	args := []interface{}{in.CompanyId, status}
+	filters := []string{"company_id = $1", "status = $2"}
+	if in.BranchId != "" {
+		args = append(args, in.BranchId)
+		filters = append(filters, fmt.Sprintf("branch_id = $%d", len(args)))
+	}
+	if in.ProductId != "" {
+		args = append(args, in.ProductId)
+		filters = append(filters, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT serial, status, product_id, branch_id, updated_at, COUNT(*) OVER()
+		FROM product_serials
+		WHERE %s
+		ORDER BY serial`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)+1, len(args)+2)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch product serials: %w", err)
+	}
+	defer rows.Close()
+
+	res := &pb.ProductSerialList{}
+	for rows.Next() {
+		var s pb.ProductSerial
+		if err := rows.Scan(&s.Serial, &s.Status, &s.ProductId, &s.BranchId, &s.UpdatedAt, &res.TotalCount); err != nil {
+			return nil, fmt.Errorf("failed to scan product serial: %w", err)
+		}
+		res.Serials = append(res.Serials, &s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product serials: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+type companySettings struct {
+	db dbtx
+}
+
+func NewCompanySettings(db *sqlx.DB) usecase.SettingsRepo {
+	return &companySettings{db: db}
+}
+
+// GetCompanySettings возвращает настройки компании или значения по умолчанию, если они не заданы
+func (c *companySettings) GetCompanySettings(in *pb.CompanySettingsReq) (*pb.CompanySettings, error) {
+	res := &pb.CompanySettings{}
+
+	query := `
+		SELECT company_id, negative_stock_policy, COALESCE(TO_CHAR(updated_at, 'YYYY-MM-DD HH24:MI:SS'), ''), alert_phone, barcode_prefix,
+			costing_method
+		FROM company_settings WHERE company_id = $1
+	`
+	err := c.db.QueryRowx(query, in.CompanyId).Scan(&res.CompanyId, &res.NegativeStockPolicy, &res.UpdatedAt, &res.AlertPhone, &res.BarcodePrefix,
+		&res.CostingMethod)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &pb.CompanySettings{
+			CompanyId:           in.CompanyId,
+			NegativeStockPolicy: entity.NegativeStockStrict,
+			BarcodePrefix:       entity.DefaultBarcodePrefix,
+			CostingMethod:       entity.CostingAverage,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get company settings: %w", err)
+	}
+
+	return res, nil
+}
+
+// UpdateCompanySettings создаёт или обновляет настройки компании. Пустые префикс штрихкодов и метод оценки
+// себестоимости оставляют прежние. Компания, впервые записывающая настройки, получает способы оплаты по умолчанию
+func (c *companySettings) UpdateCompanySettings(in *pb.CompanySettings) (*pb.CompanySettings, error) {
+	tx, err := beginTx(c.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	res := &pb.CompanySettings{}
+
+	query := `
+		INSERT INTO company_settings (company_id, negative_stock_policy, alert_phone, barcode_prefix, costing_method, updated_at)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), $5), COALESCE(NULLIF($6, ''), $7), NOW())
+		ON CONFLICT (company_id) DO UPDATE SET negative_stock_policy = EXCLUDED.negative_stock_policy, alert_phone = EXCLUDED.alert_phone,
+			barcode_prefix = COALESCE(NULLIF($4, ''), company_settings.barcode_prefix),
+			costing_method = COALESCE(NULLIF($6, ''), company_settings.costing_method), updated_at = NOW()
+		RETURNING company_id, negative_stock_policy, TO_CHAR(updated_at, 'YYYY-MM-DD HH24:MI:SS'), alert_phone, barcode_prefix, costing_method
+	`
+	err = tx.QueryRowx(query, in.CompanyId, in.NegativeStockPolicy, in.AlertPhone, in.BarcodePrefix, entity.DefaultBarcodePrefix,
+		in.CostingMethod, entity.CostingAverage).
+		Scan(&res.CompanyId, &res.NegativeStockPolicy, &res.UpdatedAt, &res.AlertPhone, &res.BarcodePrefix, &res.CostingMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update company settings: %w", err)
+	}
+
+	if err = seedPaymentTypes(tx, in.CompanyId); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return res, nil
+}
@@ -45,11 +45,12 @@ func (s *statisticsRepo) TotalPriceOfProducts(req *products.StatisticReq) (*prod
 // TotalSoldProducts calculates the total revenue from sold products within a date range.
 func (s *statisticsRepo) TotalSoldProducts(req *products.StatisticReq) (*products.PriceProducts, error) {
 	query := `
-		SELECT s.payment_method AS money_type, COALESCE(SUM(si.total_price), 0) AS total_price
+		SELECT pt.name AS money_type, COALESCE(SUM(si.total_price), 0) AS total_price
 		FROM sales_items si
 		JOIN sales s ON si.sale_id = s.id
-		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.created_at BETWEEN $3 AND $4
-		GROUP BY s.payment_method;
+		JOIN payment_types pt ON pt.id = s.payment_type_id
+		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.created_at BETWEEN $3 AND $4` + voidedFilter("s", req.IncludeVoided) + `
+		GROUP BY pt.name;
 	`
 	type tempResult struct {
 		MoneyType  string          `db:"money_type"`
@@ -82,11 +83,12 @@ func (s *statisticsRepo) TotalSoldProducts(req *products.StatisticReq) (*product
 // TotalPurchaseProducts calculates the total expenditure on purchased products within a date range.
 func (s *statisticsRepo) TotalPurchaseProducts(req *products.StatisticReq) (*products.PriceProducts, error) {
 	query := `
-		SELECT p.payment_method AS money_type, COALESCE(SUM(pi.total_price), 0) AS total_price
+		SELECT pt.name AS money_type, COALESCE(SUM(pi.total_price), 0) AS total_price
 		FROM purchase_items pi
 		JOIN purchases p ON pi.purchase_id = p.id
-		WHERE p.company_id = $1 AND p.branch_id = $2 AND p.created_at BETWEEN $3 AND $4
-		GROUP BY p.payment_method;
+		JOIN payment_types pt ON pt.id = p.payment_type_id
+		WHERE p.company_id = $1 AND p.branch_id = $2 AND p.created_at BETWEEN $3 AND $4` + voidedFilter("p", req.IncludeVoided) + `
+		GROUP BY pt.name;
 	`
 	type tempResult struct {
 		MoneyType  string          `db:"money_type"`
@@ -130,7 +132,7 @@ func (s *statisticsRepo) GetClientDashboard(req *products.GetClientDashboardRequ
 			FROM sales_items
 			GROUP BY sale_id
 		) sub ON sub.sale_id = s.id
-		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.client_id = $3;
+		WHERE s.company_id = $1 AND s.branch_id = $2 AND s.client_id = $3` + voidedFilter("s", req.IncludeVoided) + `;
 	`
 
 	type dashboardRow struct {
@@ -0,0 +1,257 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"math"
+	"strings"
+)
+
+type stockAdjustmentsRepoImpl struct {
+	db dbtx
+}
+
+func NewStockAdjustmentsRepo(db *sqlx.DB) usecase.StockAdjustmentsRepo {
+	return &stockAdjustmentsRepoImpl{db: db}
+}
+
+// CreateStockAdjustment сохраняет корректировку и оценивает каждую позицию по текущей цене закупки товара филиала
+func (r *stockAdjustmentsRepoImpl) CreateStockAdjustment(in *entity.StockAdjustmentRequest) (*pb.StockAdjustment, error) {
+	if len(in.Items) == 0 {
+		return nil, errors.New("cannot create stock adjustment without items")
+	}
+
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var id string
+	err = tx.QueryRowx(`
+		INSERT INTO stock_adjustments (reason, description, created_by, company_id, branch_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, in.Reason, in.Description, in.CreatedBy, in.CompanyID, in.BranchID).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stock adjustment: %w", err)
+	}
+
+	for _, item := range in.Items {
+		var result sql.Result
+		result, err = tx.Exec(`
+			INSERT INTO stock_adjustment_items (adjustment_id, product_id, quantity, incoming_price, total_value, company_id, branch_id, serials)
+			SELECT $1, p.id, $2::INT, p.incoming_price, ROUND($2::INT * p.incoming_price, 2), p.company_id, p.branch_id, $6::TEXT[]
+			FROM products p
+			WHERE p.id = $3 AND p.company_id = $4 AND p.branch_id = $5
+		`, id, item.Delta, item.ProductID, in.CompanyID, in.BranchID, pq.Array(item.Serials))
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert stock adjustment item: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			err = fmt.Errorf("product %s not found in branch", item.ProductID)
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE stock_adjustments
+		SET write_off_value = (SELECT COALESCE(SUM(-total_value), 0) FROM stock_adjustment_items WHERE adjustment_id = $1 AND quantity < 0)
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update write-off value: %w", err)
+	}
+
+	res, err := (&stockAdjustmentsRepoImpl{db: tx.Tx}).GetStockAdjustment(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetStockAdjustment получает корректировку с позициями по ID
+func (r *stockAdjustmentsRepoImpl) GetStockAdjustment(id string) (*pb.StockAdjustment, error) {
+	query := `
+		SELECT
+			a.id, a.reason, a.description, a.write_off_value, COALESCE(a.cash_flow_id::TEXT, ''),
+			a.created_by, a.company_id, a.branch_id, a.created_at,
+			i.id, i.product_id, COALESCE(p.name, ''), i.quantity, i.incoming_price, i.total_value, COALESCE(i.serials, '{}')
+		FROM stock_adjustments a
+		JOIN stock_adjustment_items i ON i.adjustment_id = a.id
+		LEFT JOIN products p ON p.id = i.product_id
+		WHERE a.id = $1
+	`
+
+	rows, err := r.db.Queryx(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock adjustment: %w", err)
+	}
+	defer rows.Close()
+
+	var res *pb.StockAdjustment
+	for rows.Next() {
+		var adj pb.StockAdjustment
+		var item pb.StockAdjustmentItem
+
+		err = rows.Scan(
+			&adj.Id,
+			&adj.Reason,
+			&adj.Description,
+			&adj.WriteOffValue,
+			&adj.CashFlowId,
+			&adj.CreatedBy,
+			&adj.CompanyId,
+			&adj.BranchId,
+			&adj.CreatedAt,
+			&item.Id,
+			&item.ProductId,
+			&item.ProductName,
+			&item.Quantity,
+			&item.IncomingPrice,
+			&item.TotalValue,
+			pq.Array(&item.Serials),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stock adjustment row: %w", err)
+		}
+
+		if res == nil {
+			res = &adj
+		}
+		res.Items = append(res.Items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over stock adjustment rows: %w", err)
+	}
+	if res == nil {
+		return nil, errors.New("stock adjustment not found")
+	}
+
+	return res, nil
+}
+
+// ValueStockAdjustment оценивает позиции проведённой корректировки по себестоимости её движений по складу
+// и пересчитывает стоимость списания
+func (r *stockAdjustmentsRepoImpl) ValueStockAdjustment(id string) (*pb.StockAdjustment, error) {
+	_, err := r.db.Exec(`
+		UPDATE stock_adjustment_items i
+		SET incoming_price = c.cost, total_value = ROUND(i.quantity * c.cost, 2)
+		FROM (
+			SELECT product_id, SUM(delta * unit_cost) / NULLIF(SUM(delta), 0) AS cost
+			FROM inventory_movements
+			WHERE source_id = $1 AND unit_cost IS NOT NULL
+			GROUP BY product_id
+		) c
+		WHERE i.adjustment_id = $1 AND i.product_id = c.product_id AND c.cost IS NOT NULL
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to value stock adjustment items: %w", err)
+	}
+
+	_, err = r.db.Exec(`
+		UPDATE stock_adjustments
+		SET write_off_value = (SELECT COALESCE(SUM(-total_value), 0) FROM stock_adjustment_items WHERE adjustment_id = $1 AND quantity < 0)
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update write-off value: %w", err)
+	}
+
+	return r.GetStockAdjustment(id)
+}
+
+// SetStockAdjustmentCashFlow связывает корректировку с расходом на стоимость списания
+func (r *stockAdjustmentsRepoImpl) SetStockAdjustmentCashFlow(adjustmentID, cashFlowID string) error {
+	if _, err := r.db.Exec(`UPDATE stock_adjustments SET cash_flow_id = $1 WHERE id = $2`, cashFlowID, adjustmentID); err != nil {
+		return fmt.Errorf("failed to link cash flow to stock adjustment: %w", err)
+	}
+	return nil
+}
+
+// GetWriteOffReport сводит корректировки за период по кодам причин: сколько списано и сколько оприходовано
+func (r *stockAdjustmentsRepoImpl) GetWriteOffReport(in *pb.WriteOffReportReq) (*pb.WriteOffReport, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"a.company_id = $1", "a.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.Reason != "" {
+		filters = append(filters, fmt.Sprintf("a.reason = $%d", argIndex))
+		args = append(args, in.Reason)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(a.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(a.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			a.reason,
+			COUNT(DISTINCT a.id),
+			COALESCE(SUM(-i.quantity) FILTER (WHERE i.quantity < 0), 0),
+			COALESCE(SUM(-i.total_value) FILTER (WHERE i.quantity < 0), 0),
+			COALESCE(SUM(i.quantity) FILTER (WHERE i.quantity > 0), 0),
+			COALESCE(SUM(i.total_value) FILTER (WHERE i.quantity > 0), 0)
+		FROM stock_adjustments a
+		JOIN stock_adjustment_items i ON i.adjustment_id = a.id
+		WHERE %s
+		GROUP BY a.reason
+		ORDER BY 4 DESC, a.reason`, strings.Join(filters, " AND "))
+
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch write-off report: %w", err)
+	}
+	defer rows.Close()
+
+	res := &pb.WriteOffReport{}
+	for rows.Next() {
+		var row pb.WriteOffReasonRow
+		if err := rows.Scan(
+			&row.Reason,
+			&row.Adjustments,
+			&row.WrittenOffQuantity,
+			&row.WrittenOffValue,
+			&row.FoundQuantity,
+			&row.FoundValue,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan write-off report row: %w", err)
+		}
+		res.TotalWrittenOffValue += row.WrittenOffValue
+		res.TotalFoundValue += row.FoundValue
+		res.Reasons = append(res.Reasons, &row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over write-off report rows: %w", err)
+	}
+	res.TotalWrittenOffValue = math.Round(res.TotalWrittenOffValue*100) / 100
+	res.TotalFoundValue = math.Round(res.TotalFoundValue*100) / 100
+
+	return res, nil
+}
@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	"crm-admin/internal/usecase"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// stockAlertLease время, на которое отправка арендует оповещение
+const stockAlertLease = 5 * time.Minute
+
+type stockAlertsRepoImpl struct {
+	db dbtx
+}
+
+func NewStockAlertsRepo(db *sqlx.DB) usecase.StockAlertsRepo {
+	return &stockAlertsRepoImpl{db: db}
+}
+
+// ClaimStockAlerts забирает ожидающие оповещения на отправку, увеличивает счётчик попыток и арендует их
+// на время отправки. Арендованное оповещение не забирают ни другой экземпляр сервиса, ни следующий запуск,
+// пока идёт медленная отправка; аренда, брошенная упавшим экземпляром, истекает и оповещение уходит снова.
+func (r *stockAlertsRepoImpl) ClaimStockAlerts(limit int) ([]entity.StockAlert, error) {
+	var res []entity.StockAlert
+
+	query := `
+		WITH claimed AS (
+			UPDATE stock_alerts
+			SET attempts = attempts + 1, claimed_until = NOW() + $2 * INTERVAL '1 second'
+			WHERE id IN (
+				SELECT id FROM stock_alerts
+				WHERE status = 'pending' AND (claimed_until IS NULL OR claimed_until < NOW())
+				ORDER BY created_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, product_id, balance, min_stock, reorder_qty, attempts, branch_id, company_id
+		)
+		SELECT
+			c.id, c.product_id, COALESCE(p.name, '') AS product_name, COALESCE(ci.base_unit, '') AS base_unit,
+			c.balance, c.min_stock, c.reorder_qty, c.attempts, COALESCE(s.alert_phone, '') AS phone, c.branch_id, c.company_id
+		FROM claimed c
+		LEFT JOIN products p ON p.id = c.product_id
+		LEFT JOIN catalog_items ci ON ci.id = p.catalog_id
+		LEFT JOIN company_settings s ON s.company_id = c.company_id`
+
+	if err := r.db.Select(&res, query, limit, stockAlertLease.Seconds()); err != nil {
+		return nil, fmt.Errorf("failed to claim stock alerts: %w", err)
+	}
+
+	return res, nil
+}
+
+// SetStockAlertStatus записывает результат отправки оповещения
+func (r *stockAlertsRepoImpl) SetStockAlertStatus(id, status, errMsg string) error {
+	query := `
+		UPDATE stock_alerts
+		SET status = $1, error = $2, sent_at = CASE WHEN $1 = 'sent' THEN NOW() ELSE sent_at END, claimed_until = NULL
+		WHERE id = $3`
+
+	if _, err := r.db.Exec(query, status, errMsg, id); err != nil {
+		return fmt.Errorf("failed to update stock alert status: %w", err)
+	}
+	return nil
+}
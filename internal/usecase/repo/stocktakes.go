@@ -0,0 +1,285 @@
+package repo
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"math"
+	"strings"
+)
+
+type stocktakesRepoImpl struct {
+	db dbtx
+}
+
+func NewStocktakesRepo(db *sqlx.DB) usecase.StocktakesRepo {
+	return &stocktakesRepoImpl{db: db}
+}
+
+// stocktakeColumns колонки инвентаризации в порядке stocktakeDest
+const stocktakeColumns = `
+	s.id, s.status, COALESCE(s.category_id::TEXT, ''), s.description, s.started_by, COALESCE(s.closed_by::TEXT, ''),
+	COALESCE(TO_CHAR(s.closed_at, 'YYYY-MM-DD HH24:MI:SS'), ''), s.shrinkage_value, s.surplus_value,
+	COALESCE(s.cash_flow_id::TEXT, ''), s.company_id, s.branch_id, s.created_at,
+	(SELECT COUNT(*) FROM stocktake_items i WHERE i.stocktake_id = s.id AND i.counted_quantity IS NOT NULL),
+	(SELECT COUNT(*) FROM stocktake_items i WHERE i.stocktake_id = s.id)`
+
+func stocktakeDest(s *pb.Stocktake) []interface{} {
+	return []interface{}{&s.Id, &s.Status, &s.CategoryId, &s.Description, &s.StartedBy, &s.ClosedBy,
+		&s.ClosedAt, &s.ShrinkageValue, &s.SurplusValue,
+		&s.CashFlowId, &s.CompanyId, &s.BranchId, &s.CreatedAt,
+		&s.CountedLines, &s.TotalLines}
+}
+
+// CreateStocktake открывает инвентаризацию и фиксирует текущие остатки и цены закупки товаров филиала
+func (r *stocktakesRepoImpl) CreateStocktake(in *pb.StocktakeRequest) (*pb.Stocktake, error) {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var id string
+	err = tx.QueryRowx(`
+		INSERT INTO stocktakes (category_id, description, started_by, company_id, branch_id)
+		VALUES (NULLIF($1, '')::UUID, $2, $3, $4, $5)
+		RETURNING id
+	`, in.CategoryId, in.Description, in.StartedBy, in.CompanyId, in.BranchId).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stocktake: %w", err)
+	}
+
+	// Остатки блокируются, чтобы снимок не разошёлся с параллельной продажей.
+	// Серийный товар сверяется по номерам, а не пересчётом, поэтому в инвентаризацию не входит
+	_, err = tx.Exec(`
+		INSERT INTO stocktake_items (stocktake_id, product_id, expected_quantity, incoming_price, company_id, branch_id)
+		SELECT $1, p.id, p.total_count, p.incoming_price, p.company_id, p.branch_id
+		FROM products p
+		WHERE p.company_id = $2 AND p.branch_id = $3 AND NOT p.is_parent AND ($4 = '' OR p.category_id = NULLIF($4, '')::UUID)
+		  AND NOT EXISTS (SELECT 1 FROM catalog_items c WHERE c.id = p.catalog_id AND c.is_serialized)
+		ORDER BY p.id
+		FOR UPDATE OF p
+	`, id, in.CompanyId, in.BranchId, in.CategoryId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to freeze stocktake items: %w", err)
+	}
+
+	res := &pb.Stocktake{}
+	query := fmt.Sprintf(`SELECT %s FROM stocktakes s WHERE s.id = $1`, stocktakeColumns)
+	if err = tx.QueryRowx(query, id).Scan(stocktakeDest(res)...); err != nil {
+		return nil, fmt.Errorf("failed to fetch created stocktake: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetStocktake получает инвентаризацию со всеми позициями и расхождениями
+func (r *stocktakesRepoImpl) GetStocktake(in *pb.StocktakeID) (*pb.Stocktake, error) {
+	res := &pb.Stocktake{}
+	query := fmt.Sprintf(`SELECT %s FROM stocktakes s WHERE s.id = $1 AND s.company_id = $2 AND s.branch_id = $3`, stocktakeColumns)
+	err := r.db.QueryRowx(query, in.Id, in.CompanyId, in.BranchId).Scan(stocktakeDest(res)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("stocktake not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stocktake: %w", err)
+	}
+
+	rows, err := r.db.Queryx(`
+		SELECT i.id, i.product_id, COALESCE(p.name, ''), i.expected_quantity, i.counted_quantity, i.incoming_price
+		FROM stocktake_items i
+		LEFT JOIN products p ON p.id = i.product_id
+		WHERE i.stocktake_id = $1
+		ORDER BY p.name, i.product_id
+	`, in.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stocktake items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item pb.StocktakeItem
+		var counted sql.NullInt64
+		if err := rows.Scan(&item.Id, &item.ProductId, &item.ProductName, &item.ExpectedQuantity, &counted, &item.IncomingPrice); err != nil {
+			return nil, fmt.Errorf("failed to scan stocktake item: %w", err)
+		}
+		if counted.Valid {
+			item.Counted = true
+			item.CountedQuantity = counted.Int64
+			item.Variance = counted.Int64 - item.ExpectedQuantity
+			item.VarianceValue = math.Round(float64(item.Variance)*item.IncomingPrice*100) / 100
+		}
+		res.Items = append(res.Items, &item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over stocktake items: %w", err)
+	}
+
+	return res, nil
+}
+
+// GetStocktakeList получает список инвентаризаций с фильтрами, без позиций
+func (r *stocktakesRepoImpl) GetStocktakeList(in *pb.StocktakeFilter) (*pb.StocktakeList, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"s.company_id = $1", "s.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.Status != "" {
+		filters = append(filters, fmt.Sprintf("s.status = $%d", argIndex))
+		args = append(args, in.Status)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(s.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(s.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER() AS total_count
+		FROM stocktakes s
+		WHERE %s
+		ORDER BY s.created_at DESC`, stocktakeColumns, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := r.db.Queryx(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stocktakes: %w", err)
+	}
+	defer rows.Close()
+
+	var stocktakes []*pb.Stocktake
+	var totalCount int64
+	for rows.Next() {
+		var s pb.Stocktake
+		if err := rows.Scan(append(stocktakeDest(&s), &totalCount)...); err != nil {
+			return nil, fmt.Errorf("failed to scan stocktake row: %w", err)
+		}
+		stocktakes = append(stocktakes, &s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over stocktake rows: %w", err)
+	}
+
+	return &pb.StocktakeList{
+		Stocktakes: stocktakes,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// AddStocktakeCounts сохраняет проход подсчёта и добавляет его к посчитанному количеству или заменяет его.
+// Товар должен входить в инвентаризацию, а инвентаризация должна быть открыта
+func (r *stocktakesRepoImpl) AddStocktakeCounts(in *pb.SubmitStocktakeCountsReq) error {
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, item := range in.Items {
+		var result sql.Result
+		result, err = tx.Exec(`
+			UPDATE stocktake_items i
+			SET counted_quantity = CASE WHEN $3 THEN $4 ELSE COALESCE(i.counted_quantity, 0) + $4 END,
+			    counted_at       = NOW()
+			FROM stocktakes s
+			WHERE s.id = i.stocktake_id AND i.stocktake_id = $1 AND i.product_id = $2
+			  AND s.company_id = $5 AND s.branch_id = $6 AND s.status = 'open'
+		`, in.Id, item.ProductId, in.Replace, item.Quantity, in.CompanyId, in.BranchId)
+		if err != nil {
+			return fmt.Errorf("failed to update stocktake count: %w", err)
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			err = fmt.Errorf("product %s is not part of open stocktake %s", item.ProductId, in.Id)
+			return err
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO stocktake_counts (stocktake_id, product_id, quantity, replaced, counted_by)
+			VALUES ($1, $2, $3, $4, $5)
+		`, in.Id, item.ProductId, item.Quantity, in.Replace, in.CountedBy)
+		if err != nil {
+			return fmt.Errorf("failed to record stocktake count: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CloseStocktake закрывает открытую инвентаризацию и сохраняет стоимость недостачи и излишков
+func (r *stocktakesRepoImpl) CloseStocktake(in *pb.CloseStocktakeReq, shrinkage, surplus float64) error {
+	result, err := r.db.Exec(`
+		UPDATE stocktakes
+		SET status = 'closed', closed_by = $4, closed_at = NOW(), shrinkage_value = $5, surplus_value = $6
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status = 'open'
+	`, in.Id, in.CompanyId, in.BranchId, in.ClosedBy, shrinkage, surplus)
+	if err != nil {
+		return fmt.Errorf("failed to close stocktake: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return errors.New("stocktake not found or not open")
+	}
+
+	return nil
+}
+
+// SetStocktakeStatus переводит инвентаризацию в новое состояние, если она находится в одном из допустимых
+func (r *stocktakesRepoImpl) SetStocktakeStatus(in *pb.StocktakeID, status string, from ...string) error {
+	query, args, err := sqlx.In(`
+		UPDATE stocktakes SET status = ?
+		WHERE id = ? AND company_id = ? AND branch_id = ? AND status IN (?)
+	`, status, in.Id, in.CompanyId, in.BranchId, from)
+	if err != nil {
+		return fmt.Errorf("failed to prepare stocktake status query: %w", err)
+	}
+
+	result, err := r.db.Exec(r.db.Rebind(query), args...)
+	if err != nil {
+		return fmt.Errorf("failed to update stocktake status: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return fmt.Errorf("stocktake not found or cannot become %s", status)
+	}
+
+	return nil
+}
+
+// SetStocktakeCashFlow связывает инвентаризацию с расходом на сумму недостачи
+func (r *stocktakesRepoImpl) SetStocktakeCashFlow(stocktakeID, cashFlowID string) error {
+	if _, err := r.db.Exec(`UPDATE stocktakes SET cash_flow_id = $1 WHERE id = $2`, cashFlowID, stocktakeID); err != nil {
+		return fmt.Errorf("failed to link cash flow to stocktake: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+package repo
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+)
+
+type supplierReturnsRepoImpl struct {
+	db dbtx
+}
+
+func NewSupplierReturnsRepo(db *sqlx.DB) usecase.SupplierReturnsRepo {
+	return &supplierReturnsRepoImpl{db: db}
+}
+
+// CreateSupplierReturn создает возврат поставщику и возвращённые позиции закупки
+func (r *supplierReturnsRepoImpl) CreateSupplierReturn(in *entity.SupplierReturnRequest) (*pb.SupplierReturnResponse, error) {
+	if len(in.Items) == 0 {
+		return nil, errors.New("cannot create supplier return without items")
+	}
+
+	tx, err := beginTx(r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res := &pb.SupplierReturnResponse{}
+	query := fmt.Sprintf(`
+		INSERT INTO supplier_returns (purchase_id, supplier_id, returned_by, total_refund, settlement, payment_type_id, reason, company_id, branch_id)
+		VALUES ($1, $2, $3, $4, $5, %s, $7, $8, $9)
+		RETURNING id, %s, created_at
+	`, paymentTypeID("$8", "$6"), paymentTypeName("payment_type_id"))
+	err = tx.QueryRowx(query, in.PurchaseID, in.SupplierID, in.ReturnedBy, in.TotalRefund, in.Settlement, in.PaymentMethod, in.Reason, in.CompanyID, in.BranchID).
+		Scan(&res.Id, &res.PaymentMethod, &res.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create supplier return: %w", err)
+	}
+
+	var queryBuilder strings.Builder
+	args := []interface{}{}
+	queryBuilder.WriteString(`
+		INSERT INTO supplier_return_items (return_id, purchase_item_id, product_id, quantity, purchase_price, total_price, company_id, branch_id, serials) VALUES
+	`)
+	for i, item := range in.Items {
+		startIdx := i * 9
+
+		if i > 0 {
+			queryBuilder.WriteString(", ")
+		}
+		queryBuilder.WriteString(fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			startIdx+1, startIdx+2, startIdx+3, startIdx+4, startIdx+5, startIdx+6, startIdx+7, startIdx+8, startIdx+9))
+
+		args = append(args, res.Id, item.PurchaseItemID, item.ProductID, item.Quantity, item.PurchasePrice, item.TotalPrice, in.CompanyID, in.BranchID,
+			pq.Array(item.Serials))
+	}
+
+	if _, err = tx.Exec(queryBuilder.String(), args...); err != nil {
+		return nil, fmt.Errorf("failed to insert supplier return items: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, item := range in.Items {
+		res.Items = append(res.Items, &pb.SupplierReturnItem{
+			ReturnId:       res.Id,
+			PurchaseItemId: item.PurchaseItemID,
+			ProductId:      item.ProductID,
+			Quantity:       int32(item.Quantity),
+			PurchasePrice:  item.PurchasePrice,
+			TotalPrice:     item.TotalPrice,
+			Serials:        item.Serials,
+		})
+	}
+
+	res.PurchaseId = in.PurchaseID
+	res.SupplierId = in.SupplierID
+	res.ReturnedBy = in.ReturnedBy
+	res.TotalRefund = in.TotalRefund
+	res.Settlement = in.Settlement
+	res.Reason = in.Reason
+	res.CompanyId = in.CompanyID
+	res.BranchId = in.BranchID
+	res.Status = entity.DocumentCompleted
+
+	return res, nil
+}
+
+// GetSupplierReturn получает возврат поставщику с позициями по ID
+func (r *supplierReturnsRepoImpl) GetSupplierReturn(in *pb.SupplierReturnID) (*pb.SupplierReturnResponse, error) {
+	query := `
+		SELECT
+			r.id, r.purchase_id, r.supplier_id, r.returned_by, r.total_refund, r.settlement, pt.name, r.reason,
+			COALESCE(r.cash_flow_id::TEXT, ''), r.company_id, r.branch_id, r.created_at,
+			r.status, COALESCE(r.voided_at::TEXT, ''), COALESCE(r.voided_by::TEXT, ''), r.void_reason,
+			i.id, i.purchase_item_id, i.product_id, i.quantity, i.purchase_price, i.total_price, pd.name, pd.image_url,
+			COALESCE(i.serials, '{}')
+		FROM supplier_returns r
+		JOIN payment_types pt ON pt.id = r.payment_type_id
+		LEFT JOIN supplier_return_items i ON r.id = i.return_id
+		LEFT JOIN products pd ON i.product_id = pd.id
+		WHERE r.id = $1 AND r.company_id = $2 AND r.branch_id = $3
+	`
+
+	rows, err := r.db.Queryx(query, in.Id, in.CompanyId, in.BranchId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query supplier return: %w", err)
+	}
+	defer rows.Close()
+
+	var res *pb.SupplierReturnResponse
+	for rows.Next() {
+		var ret pb.SupplierReturnResponse
+		var itemID, purchaseItemID, productID, productName, productImage sql.NullString
+		var quantity sql.NullInt32
+		var purchasePrice, totalPrice sql.NullFloat64
+		var serials []string
+
+		err = rows.Scan(
+			&ret.Id,
+			&ret.PurchaseId,
+			&ret.SupplierId,
+			&ret.ReturnedBy,
+			&ret.TotalRefund,
+			&ret.Settlement,
+			&ret.PaymentMethod,
+			&ret.Reason,
+			&ret.CashFlowId,
+			&ret.CompanyId,
+			&ret.BranchId,
+			&ret.CreatedAt,
+			&ret.Status,
+			&ret.VoidedAt,
+			&ret.VoidedBy,
+			&ret.VoidReason,
+			&itemID,
+			&purchaseItemID,
+			&productID,
+			&quantity,
+			&purchasePrice,
+			&totalPrice,
+			&productName,
+			&productImage,
+			pq.Array(&serials),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan supplier return row: %w", err)
+		}
+
+		if res == nil {
+			res = &ret
+		}
+		if itemID.Valid {
+			res.Items = append(res.Items, &pb.SupplierReturnItem{
+				Id:             itemID.String,
+				ReturnId:       res.Id,
+				PurchaseItemId: purchaseItemID.String,
+				ProductId:      productID.String,
+				Quantity:       quantity.Int32,
+				PurchasePrice:  purchasePrice.Float64,
+				TotalPrice:     totalPrice.Float64,
+				ProductName:    productName.String,
+				ProductImage:   productImage.String,
+				Serials:        serials,
+			})
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over supplier return rows: %w", err)
+	}
+	if res == nil {
+		return nil, errors.New("supplier return not found")
+	}
+
+	return res, nil
+}
+
+// GetSupplierReturnList получает список возвратов поставщикам с фильтрами
+func (r *supplierReturnsRepoImpl) GetSupplierReturnList(in *pb.SupplierReturnFilter) (*pb.SupplierReturnList, error) {
+	var args []interface{}
+	argIndex := 3
+
+	filters := []string{"r.company_id = $1", "r.branch_id = $2"}
+	args = append(args, in.CompanyId, in.BranchId)
+
+	if in.PurchaseId != "" {
+		filters = append(filters, fmt.Sprintf("r.purchase_id = $%d", argIndex))
+		args = append(args, in.PurchaseId)
+		argIndex++
+	}
+	if in.SupplierId != "" {
+		filters = append(filters, fmt.Sprintf("r.supplier_id = $%d", argIndex))
+		args = append(args, in.SupplierId)
+		argIndex++
+	}
+	if in.ReturnedBy != "" {
+		filters = append(filters, fmt.Sprintf("r.returned_by = $%d", argIndex))
+		args = append(args, in.ReturnedBy)
+		argIndex++
+	}
+	if in.Settlement != "" {
+		filters = append(filters, fmt.Sprintf("r.settlement = $%d", argIndex))
+		args = append(args, in.Settlement)
+		argIndex++
+	}
+	if in.StartDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(r.created_at) >= DATE($%d)", argIndex))
+		args = append(args, in.StartDate)
+		argIndex++
+	}
+	if in.EndDate != "" {
+		filters = append(filters, fmt.Sprintf("DATE(r.created_at) <= DATE($%d)", argIndex))
+		args = append(args, in.EndDate)
+		argIndex++
+	}
+	if !in.IncludeVoided {
+		filters = append(filters, notVoided("r"))
+	}
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM supplier_returns r WHERE %s`, strings.Join(filters, " AND "))
+
+	var totalCount int64
+	if err := r.db.Get(&totalCount, countQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to get total count: %w", err)
+	}
+
+	mainQuery := fmt.Sprintf(`
+		SELECT
+			r.id, r.purchase_id, r.supplier_id, r.returned_by, r.total_refund, r.settlement, pt.name, r.reason,
+			COALESCE(r.cash_flow_id::TEXT, ''), r.company_id, r.branch_id, r.created_at,
+			r.status, COALESCE(r.voided_at::TEXT, ''), COALESCE(r.voided_by::TEXT, ''), r.void_reason,
+			COALESCE(JSON_AGG(
+				JSON_BUILD_OBJECT(
+					'id', i.id,
+					'return_id', i.return_id,
+					'purchase_item_id', i.purchase_item_id,
+					'product_id', i.product_id,
+					'quantity', i.quantity,
+					'purchase_price', i.purchase_price,
+					'total_price', i.total_price,
+					'product_name', pr.name,
+					'product_image', pr.image_url,
+					'serials', COALESCE(i.serials, '{}')
+				)
+			) FILTER (WHERE i.id IS NOT NULL), '[]') AS items
+		FROM supplier_returns r
+		JOIN payment_types pt ON pt.id = r.payment_type_id
+		LEFT JOIN supplier_return_items i ON r.id = i.return_id
+		LEFT JOIN products pr ON i.product_id = pr.id
+		WHERE %s
+		GROUP BY r.id, pt.name
+		ORDER BY r.created_at DESC`, strings.Join(filters, " AND "))
+
+	if in.Limit > 0 && in.Page > 0 {
+		mainQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+		args = append(args, in.Limit, (in.Page-1)*in.Limit)
+	}
+
+	rows, err := r.db.Queryx(mainQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch supplier returns: %w", err)
+	}
+	defer rows.Close()
+
+	var returns []*pb.SupplierReturnResponse
+	for rows.Next() {
+		var ret pb.SupplierReturnResponse
+		var itemsJSON string
+
+		err = rows.Scan(
+			&ret.Id,
+			&ret.PurchaseId,
+			&ret.SupplierId,
+			&ret.ReturnedBy,
+			&ret.TotalRefund,
+			&ret.Settlement,
+			&ret.PaymentMethod,
+			&ret.Reason,
+			&ret.CashFlowId,
+			&ret.CompanyId,
+			&ret.BranchId,
+			&ret.CreatedAt,
+			&ret.Status,
+			&ret.VoidedAt,
+			&ret.VoidedBy,
+			&ret.VoidReason,
+			&itemsJSON,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan supplier return row: %w", err)
+		}
+
+		var items []*pb.SupplierReturnItem
+		if err := json.Unmarshal([]byte(itemsJSON), &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal supplier return items JSON: %w", err)
+		}
+		ret.Items = items
+
+		returns = append(returns, &ret)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over supplier return rows: %w", err)
+	}
+
+	return &pb.SupplierReturnList{
+		Returns:    returns,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// VoidSupplierReturn аннулирует возврат поставщику: строки возврата остаются, но больше не учитываются в возвращённом количестве и сумме
+func (r *supplierReturnsRepoImpl) VoidSupplierReturn(in *pb.VoidSupplierReturnReq) error {
+	query := `
+		UPDATE supplier_returns SET status = 'voided', voided_at = NOW(), voided_by = NULLIF($4, '')::UUID, void_reason = $5
+		WHERE id = $1 AND company_id = $2 AND branch_id = $3 AND status <> 'voided'
+	`
+
+	result, err := r.db.Exec(query, in.Id, in.CompanyId, in.BranchId, in.VoidedBy, in.Reason)
+	if err != nil {
+		return fmt.Errorf("failed to void supplier return: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("supplier return not found or already voided")
+	}
+
+	return nil
+}
+
+// HasSupplierReturns проверяет, есть ли у закупки возвраты поставщику, включая аннулированные: их позиции по-прежнему ссылаются на строки закупки
+func (r *supplierReturnsRepoImpl) HasSupplierReturns(purchaseID string) (bool, error) {
+	var exists bool
+	if err := r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM supplier_returns WHERE purchase_id = $1)`, purchaseID); err != nil {
+		return false, fmt.Errorf("failed to check supplier returns of purchase: %w", err)
+	}
+	return exists, nil
+}
+
+// GetSupplierReturnedQuantities возвращает уже возвращённое поставщику количество по каждой позиции закупки без аннулированных возвратов
+func (r *supplierReturnsRepoImpl) GetSupplierReturnedQuantities(purchaseID string) (map[string]int64, error) {
+	query := `
+		SELECT i.purchase_item_id, SUM(i.quantity)
+		FROM supplier_return_items i
+		JOIN supplier_returns r ON r.id = i.return_id
+		WHERE r.purchase_id = $1 AND ` + notVoided("r") + `
+		GROUP BY i.purchase_item_id
+	`
+
+	rows, err := r.db.Query(query, purchaseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query supplier returned quantities: %w", err)
+	}
+	defer rows.Close()
+
+	returned := make(map[string]int64)
+	for rows.Next() {
+		var purchaseItemID string
+		var quantity int64
+		if err := rows.Scan(&purchaseItemID, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan supplier returned quantity: %w", err)
+		}
+		returned[purchaseItemID] = quantity
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over supplier returned quantities: %w", err)
+	}
+
+	return returned, nil
+}
+
+// SetSupplierReturnCashFlow связывает возврат поставщику с приходом, которым проведён возврат денег
+func (r *supplierReturnsRepoImpl) SetSupplierReturnCashFlow(returnID, cashFlowID string) error {
+	if _, err := r.db.Exec(`UPDATE supplier_returns SET cash_flow_id = $1 WHERE id = $2`, cashFlowID, returnID); err != nil {
+		return fmt.Errorf("failed to link cash flow to supplier return: %w", err)
+	}
+	return nil
+}
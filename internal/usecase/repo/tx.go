@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"crm-admin/internal/usecase"
+	"database/sql"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+)
+
+// dbtx общие методы *sqlx.DB и *sqlx.Tx, чтобы репозиторий мог работать как сам по себе, так и внутри unit of work
+type dbtx interface {
+	sqlx.Ext
+	Get(dest interface{}, query string, args ...interface{}) error
+	Select(dest interface{}, query string, args ...interface{}) error
+	QueryRow(query string, args ...interface{}) *sql.Row
+	PrepareNamed(query string) (*sqlx.NamedStmt, error)
+}
+
+// txScope транзакция одного метода репозитория.
+// Внутри unit of work Commit и Rollback ничего не делают: решение принимает внешняя транзакция.
+type txScope struct {
+	*sqlx.Tx
+	owned bool
+}
+
+func (t *txScope) Commit() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *txScope) Rollback() error {
+	if !t.owned {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+// beginTx начинает новую транзакцию или присоединяется к уже открытой
+func beginTx(db dbtx) (*txScope, error) {
+	switch d := db.(type) {
+	case *sqlx.Tx:
+		return &txScope{Tx: d}, nil
+	case *sqlx.DB:
+		tx, err := d.Beginx()
+		if err != nil {
+			return nil, err
+		}
+		return &txScope{Tx: tx, owned: true}, nil
+	default:
+		return nil, fmt.Errorf("unsupported database handle %T", db)
+	}
+}
+
+type unitOfWork struct {
+	db *sqlx.DB
+}
+
+func NewUnitOfWork(db *sqlx.DB) usecase.UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+// Do выполняет fn в одной транзакции: всё фиксируется вместе или откатывается при первой ошибке
+func (u *unitOfWork) Do(fn func(r *usecase.TxRepos) error) (err error) {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		} else if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	err = fn(&usecase.TxRepos{
+		Product:   &productQuantity{db: tx},
+		Sales:     &salesRepoImpl{db: tx},
+		Purchases: &purchasesRepoImpl{db: tx},
+		CashFlow:  &cashFlow{db: tx},
+		Returns:   &returnsRepoImpl{db: tx},
+		Settings:  &companySettings{db: tx},
+
+		PaymentTypes:    &paymentTypesRepo{db: tx},
+		SupplierReturns: &supplierReturnsRepoImpl{db: tx},
+		PurchaseOrders:  &purchaseOrdersRepoImpl{db: tx},
+		Stocktakes:      &stocktakesRepoImpl{db: tx},
+
+		StockAdjustments: &stockAdjustmentsRepoImpl{db: tx},
+		Serials:          &serialsRepoImpl{db: tx},
+		SaleDebts:        &saleDebtsRepoImpl{db: tx},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
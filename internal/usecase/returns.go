@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"math"
+)
+
+type ReturnsUseCase struct {
+	repo    ReturnedProductsRepo
+	sales   SalesRepo
+	product ProductQuantity
+	cash    CashFlowRepo
+	uow     UnitOfWork
+	debts   *SaleDebtsUseCase
+	log     *slog.Logger
+}
+
+func NewReturnsUseCase(repo ReturnedProductsRepo, sales SalesRepo, pr ProductQuantity, log *slog.Logger, cash CashFlowRepo, uow UnitOfWork, debts *SaleDebtsUseCase) *ReturnsUseCase {
+	return &ReturnsUseCase{
+		repo:    repo,
+		sales:   sales,
+		product: pr,
+		cash:    cash,
+		uow:     uow,
+		debts:   debts,
+		log:     log,
+	}
+}
+
+// CalculateReturn checks the requested lines against the sale and what was already returned,
+// and calculates the refund for every line. An empty item list returns everything still left on the sale.
+// A credit sale pays back no more than the client has paid; the rest of the return is taken off the debt.
+func (r *ReturnsUseCase) CalculateReturn(in *pb.ReturnRequest) (*entity.ReturnRequest, error) {
+	if in == nil {
+		return nil, errors.New("input return request is nil")
+	}
+	return calculateReturn(r.sales, r.repo, in)
+}
+
+// calculateReturn prices a return from the sale and the returns read through the given repositories.
+func calculateReturn(sales SalesRepo, returns ReturnedProductsRepo, in *pb.ReturnRequest) (*entity.ReturnRequest, error) {
+	sale, err := sales.GetSale(&pb.SaleID{Id: in.SaleId, CompanyId: in.CompanyId, BranchId: in.BranchId})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sale: %w", err)
+	}
+	if sale.Status == entity.DocumentVoided {
+		return nil, fmt.Errorf("sale %v is voided", in.SaleId)
+	}
+
+	returned, err := returns.GetReturnedQuantities(in.SaleId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching returned quantities: %w", err)
+	}
+
+	saleItems := make(map[string]*pb.SalesItem, len(sale.SoldProducts))
+	for _, item := range sale.SoldProducts {
+		saleItems[item.Id] = item
+	}
+
+	requested := make(map[string]int64)
+	serials := make(map[string][]string)
+	var order []string
+	if len(in.Items) == 0 {
+		for _, item := range sale.SoldProducts {
+			if left := int64(item.Quantity) - returned[item.Id]; left > 0 {
+				requested[item.Id] = left
+				order = append(order, item.Id)
+			}
+		}
+	}
+	for _, item := range in.Items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("invalid return quantity for sale item %v: must be positive", item.SaleItemId)
+		}
+		if _, ok := requested[item.SaleItemId]; !ok {
+			order = append(order, item.SaleItemId)
+		}
+		requested[item.SaleItemId] += int64(item.Quantity)
+		serials[item.SaleItemId] = append(serials[item.SaleItemId], item.Serials...)
+	}
+
+	if len(order) == 0 {
+		return nil, errors.New("nothing left to return for this sale")
+	}
+
+	var totalRefund decimal.Decimal
+	var items []entity.ReturnItem
+
+	for _, id := range order {
+		saleItem, ok := saleItems[id]
+		if !ok {
+			return nil, fmt.Errorf("sale item %v does not belong to sale %v", id, in.SaleId)
+		}
+
+		quantity := requested[id]
+		if left := int64(saleItem.Quantity) - returned[id]; quantity > left {
+			return nil, fmt.Errorf("cannot return %d of sale item %v: only %d left", quantity, id, left)
+		}
+
+		lineSerials, err := pickLineSerials(saleItem.ProductId, saleItem.Serials, "sale item "+id, quantity, returned[id], serials[id])
+		if err != nil {
+			return nil, err
+		}
+
+		totalItemPrice := decimal.NewFromInt(quantity).Mul(decimal.NewFromFloat(saleItem.SalePrice))
+		totalRefund = totalRefund.Add(totalItemPrice)
+
+		items = append(items, entity.ReturnItem{
+			SaleItemID:  id,
+			ProductID:   saleItem.ProductId,
+			Quantity:    quantity,
+			RefundPrice: saleItem.SalePrice,
+			TotalPrice:  math.Round(totalItemPrice.InexactFloat64()*100) / 100,
+			Serials:     lineSerials,
+		})
+	}
+
+	refund := totalRefund.Round(2)
+	var debtReduction decimal.Decimal
+	if sale.IsForDebt {
+		refunded, err := returns.GetRefundedAmount(in.SaleId)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching refunded amount: %w", err)
+		}
+
+		paidLeft := decimal.Max(decimal.NewFromFloat(sale.PaidAmount).Sub(decimal.NewFromFloat(refunded)), decimal.Zero)
+		debtReduction = decimal.Max(refund.Sub(paidLeft), decimal.Zero)
+		refund = refund.Sub(debtReduction)
+	}
+
+	paymentMethod := in.PaymentMethod
+	if paymentMethod == "" {
+		paymentMethod = sale.PaymentMethod
+	}
+
+	return &entity.ReturnRequest{
+		SaleID:        in.SaleId,
+		ClientID:      sale.ClientId,
+		ReturnedBy:    in.ReturnedBy,
+		TotalRefund:   refund.InexactFloat64(),
+		DebtReduction: debtReduction.InexactFloat64(),
+		PaymentMethod: paymentMethod,
+		Reason:        in.Reason,
+		CompanyID:     in.CompanyId,
+		BranchID:      in.BranchId,
+		Items:         items,
+	}, nil
+}
+
+// CreateReturn records a customer return, puts the goods back on stock and books the refund as an expense.
+// The part of a credit sale return that is not paid out reduces the client's debt after the return commits.
+func (r *ReturnsUseCase) CreateReturn(in *pb.ReturnRequest) (*pb.ReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("input return request is nil")
+	}
+
+	var req *entity.ReturnRequest
+	var res *pb.ReturnResponse
+	err := r.uow.Do(func(tx *TxRepos) error {
+		// The sale stays locked until the return commits, so two returns cannot both take what is left on it
+		if err := tx.Sales.LockSale(in.SaleId); err != nil {
+			return err
+		}
+
+		var err error
+		req, err = calculateReturn(tx.Sales, tx.Returns, in)
+		if err != nil {
+			return fmt.Errorf("error calculating return: %w", err)
+		}
+
+		res, err = tx.Returns.CreateReturnedProducts(req)
+		if err != nil {
+			return fmt.Errorf("error creating return: %w", err)
+		}
+
+		if req.TotalRefund > 0 {
+			cashFlow, err := tx.CashFlow.CreateExpense(&pb.CashFlowRequest{
+				UserId:        req.ReturnedBy,
+				Amount:        req.TotalRefund,
+				Description:   "Mahsulot qaytarildi",
+				PaymentMethod: req.PaymentMethod,
+				CompanyId:     req.CompanyID,
+				BranchId:      req.BranchID,
+				SourceType:    entity.CashSourceReturn,
+				SourceId:      res.Id,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating cash flow: %w", err)
+			}
+			if err = tx.Returns.SetReturnCashFlow(res.Id, cashFlow.Id); err != nil {
+				return fmt.Errorf("error linking refund to return: %w", err)
+			}
+			res.CashFlowId = cashFlow.Id
+		}
+
+		if err = tx.SaleDebts.ReduceSaleDebt(req.SaleID, res.Id, "return", req.DebtReduction); err != nil {
+			return fmt.Errorf("error queueing debt reduction: %w", err)
+		}
+
+		for _, item := range req.Items {
+			productQuantityReq := &entity.CountProductReq{
+				ID:    item.ProductID,
+				Count: int(item.Quantity),
+				Movement: entity.MovementInfo{
+					Reason:      entity.MovementReturn,
+					SourceID:    res.Id,
+					CreatedBy:   req.ReturnedBy,
+					LotSourceID: req.SaleID,
+				},
+			}
+
+			if _, err := tx.Product.AddProduct(productQuantityReq); err != nil {
+				return fmt.Errorf("error restoring product stock: %w", err)
+			}
+			err = tx.Serials.SetSerialStatus(item.ProductID, item.Serials, entity.SerialSold, entity.SerialInStock, productQuantityReq.Movement)
+			if err != nil {
+				return fmt.Errorf("error restoring returned serials: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		r.log.Error("Error creating return", "saleID", in.SaleId, "error", err)
+		return nil, err
+	}
+
+	if req.DebtReduction > 0 {
+		r.debts.SyncSale(req.SaleID)
+	}
+
+	return res, nil
+}
+
+// UpdateReturn updates the descriptive fields of a return.
+func (r *ReturnsUseCase) UpdateReturn(in *pb.ReturnUpdate) (*pb.ReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("input return update is nil")
+	}
+
+	res, err := r.repo.UpdateReturnedProducts(in)
+	if err != nil {
+		r.log.Error("Error updating return", "error", err)
+		return nil, fmt.Errorf("error updating return: %w", err)
+	}
+	return res, nil
+}
+
+// GetReturn retrieves a specific return by ID.
+func (r *ReturnsUseCase) GetReturn(in *pb.ReturnID) (*pb.ReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("return ID request is nil")
+	}
+
+	res, err := r.repo.GetReturnedProducts(in)
+	if err != nil {
+		r.log.Error("Error fetching return", "returnID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching return: %w", err)
+	}
+	return res, nil
+}
+
+// GetListReturns retrieves a list of returns based on filters.
+func (r *ReturnsUseCase) GetListReturns(in *pb.ReturnFilter) (*pb.ReturnList, error) {
+	if in == nil {
+		return nil, errors.New("return filter request is nil")
+	}
+
+	res, err := r.repo.GetReturnedProductsList(in)
+	if err != nil {
+		r.log.Error("Error fetching returns list", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching returns list: %w", err)
+	}
+	return res, nil
+}
+
+// VoidReturn cancels a return in one transaction: the goods leave the stock again, the refund is booked back
+// as income and the return rows are kept with the voided status for the audit history.
+func (r *ReturnsUseCase) VoidReturn(in *pb.VoidReturnReq) (*pb.ReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("void return request is nil")
+	}
+
+	returnID := &pb.ReturnID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.ReturnResponse
+	err := r.uow.Do(func(tx *TxRepos) error {
+		ret, err := tx.Returns.GetReturnedProducts(returnID)
+		if err != nil {
+			return fmt.Errorf("error fetching return: %w", err)
+		}
+		if ret.Status == entity.DocumentVoided {
+			return errors.New("return is already voided")
+		}
+		// The debts service cannot raise a debt again, so a return that reduced one stays
+		if ret.DebtReduction > 0 {
+			return errors.New("return reduced the client's debt and cannot be cancelled")
+		}
+		// What is left to return on the sale grows back, so new returns of the sale wait for the void
+		if err = tx.Sales.LockSale(ret.SaleId); err != nil {
+			return err
+		}
+
+		voidedBy := in.VoidedBy
+		if voidedBy == "" {
+			voidedBy = ret.ReturnedBy
+		}
+
+		var soldProducts []entity.SalesItem
+		for _, item := range ret.Items {
+			soldProducts = append(soldProducts, entity.SalesItem{
+				ProductID: item.ProductId,
+				Quantity:  int64(item.Quantity),
+			})
+		}
+
+		info := entity.MovementInfo{
+			Reason:      entity.MovementReturn,
+			SourceID:    in.Id,
+			CreatedBy:   voidedBy,
+			LotSourceID: ret.SaleId,
+		}
+		if err = tx.Product.RemoveProducts(soldProducts, info); err != nil {
+			return fmt.Errorf("error removing returned products from stock: %w", err)
+		}
+
+		// The returned serials go back to the client, so they have to be still in stock
+		for _, item := range ret.Items {
+			if err = tx.Serials.SetSerialStatus(item.ProductId, item.Serials, entity.SerialInStock, entity.SerialSold, info); err != nil {
+				return fmt.Errorf("error selling returned serials again: %w", err)
+			}
+		}
+
+		if err = tx.Returns.VoidReturn(in); err != nil {
+			return fmt.Errorf("error voiding return: %w", err)
+		}
+
+		_, err = tx.CashFlow.ReverseBySource(entity.CashSourceReturn, in.Id, voidedBy, fmt.Sprintf("Return %s voided", in.Id))
+		if err != nil {
+			return fmt.Errorf("error reversing cash flow: %w", err)
+		}
+
+		res, err = tx.Returns.GetReturnedProducts(returnID)
+		if err != nil {
+			return fmt.Errorf("error fetching voided return: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		r.log.Error("Error voiding return", "returnID", in.Id, "error", err)
+		return nil, err
+	}
+
+	r.log.Info("Voided return and reversed its cash flow", "returnID", in.Id)
+	return res, nil
+}
+
+// DeleteReturn is kept for old clients: the return is voided instead of being deleted.
+func (r *ReturnsUseCase) DeleteReturn(in *pb.ReturnID) (*pb.Message, error) {
+	if in == nil {
+		return nil, errors.New("return ID request is nil")
+	}
+
+	_, err := r.VoidReturn(&pb.VoidReturnReq{
+		Id:        in.Id,
+		CompanyId: in.CompanyId,
+		BranchId:  in.BranchId,
+		Reason:    "deleted",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Message{Message: "Return voided successfully"}, nil
+}
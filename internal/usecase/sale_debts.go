@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"crm-admin/internal/entity"
+	"crm-admin/internal/generated/debts"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+)
+
+const (
+	saleDebtBatch       = 50
+	saleDebtMaxAttempts = 5
+	saleDebtQueue       = 64
+	debtsTimeout        = 10 * time.Second
+)
+
+// SaleDebtsUseCase sends the debts service requests of sales.
+// The requests are written in the transaction of their document and sent only after it commits,
+// so a rolled back sale never leaves a debt behind and a committed one never loses it.
+type SaleDebtsUseCase struct {
+	repo   SaleDebtsRepo
+	debts  DebtsClient
+	log    *slog.Logger
+	queued chan string
+}
+
+func NewSaleDebtsUseCase(repo SaleDebtsRepo, debts DebtsClient, log *slog.Logger) *SaleDebtsUseCase {
+	return &SaleDebtsUseCase{
+		repo:   repo,
+		debts:  debts,
+		log:    log,
+		queued: make(chan string, saleDebtQueue),
+	}
+}
+
+// Run sends the pending requests every interval until ctx is cancelled.
+// In between it sends the requests of the sales queued by SyncSale and retries the ones that failed.
+func (s *SaleDebtsUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	if err := s.SyncPending(ctx); err != nil {
+		s.log.Error("Error syncing sale debts", "error", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.SyncPending(ctx); err != nil {
+				s.log.Error("Error syncing sale debts", "error", err)
+			}
+		case saleID := <-s.queued:
+			if err := s.sync(ctx, saleID); err != nil {
+				s.log.Error("Error syncing sale debts", "saleID", saleID, "error", err)
+			}
+		}
+	}
+}
+
+// SyncPending sends every pending request of every sale.
+func (s *SaleDebtsUseCase) SyncPending(ctx context.Context) error {
+	return s.sync(ctx, "")
+}
+
+// SyncSale asks Run to send the pending requests of one sale once its document has committed.
+// It never blocks the request: when the queue is full the sale waits for the next interval.
+func (s *SaleDebtsUseCase) SyncSale(saleID string) {
+	select {
+	case s.queued <- saleID:
+	default:
+	}
+}
+
+func (s *SaleDebtsUseCase) sync(ctx context.Context, saleID string) error {
+	for {
+		requests, err := s.repo.ClaimSaleDebts(saleDebtBatch, saleID)
+		if err != nil {
+			return fmt.Errorf("error claiming sale debts: %w", err)
+		}
+
+		retry := false
+		for _, request := range requests {
+			status, debtID, errMsg := s.send(ctx, request)
+			if err = s.repo.SetSaleDebtStatus(request.ID, status, debtID, errMsg); err != nil {
+				return fmt.Errorf("error updating sale debt %s: %w", request.ID, err)
+			}
+			retry = retry || status == entity.SaleDebtPending
+		}
+
+		// A created debt unlocks the reductions of its sale, so the claim goes on until nothing is left.
+		// Requests put back for a retry wait for the next run instead of being claimed again right away.
+		if len(requests) == 0 || retry || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// send delivers one request and returns its new status with the debt id and the error text, if any.
+func (s *SaleDebtsUseCase) send(ctx context.Context, request entity.SaleDebt) (string, string, string) {
+	debtID, err := s.deliver(ctx, &request)
+	if err == nil {
+		return entity.SaleDebtDone, debtID, ""
+	}
+
+	s.log.Error("Error sending sale debt", "requestID", request.ID, "saleID", request.SaleID, "kind", request.Kind,
+		"attempt", request.Attempts, "error", err)
+	if request.Attempts >= saleDebtMaxAttempts {
+		return entity.SaleDebtFailed, "", err.Error()
+	}
+	return entity.SaleDebtPending, "", err.Error()
+}
+
+func (s *SaleDebtsUseCase) deliver(ctx context.Context, request *entity.SaleDebt) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, debtsTimeout)
+	defer cancel()
+
+	if request.Kind == entity.SaleDebtReduce {
+		return request.DebtID, s.reduce(ctx, request)
+	}
+
+	// The client is stored as soon as it exists, so a retry does not register it twice
+	if request.ClientID == "" {
+		client, err := s.debts.AddClient(ctx, &debts.CreateClients{
+			Id:          request.CompanyID,
+			FullName:    request.ClientName,
+			PhoneNumber: request.ClientPhone,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error adding client: %w", err)
+		}
+		if err = s.repo.SetSaleDebtClient(request.ID, request.SaleID, client.Id); err != nil {
+			return "", fmt.Errorf("error saving client: %w", err)
+		}
+		request.ClientID = client.Id
+	}
+
+	if request.Amount <= 0 {
+		return "", nil
+	}
+
+	debt, err := s.debts.CreateDebts(ctx, &debts.DebtsRequest{
+		ClientId:     request.ClientID,
+		SaleId:       request.SaleID,
+		TotalAmount:  request.Amount,
+		CurrencyCode: request.CurrencyCode,
+		DebtType:     "debtor",
+		CompanyId:    request.CompanyID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating debt: %w", err)
+	}
+
+	s.log.Info("Created debt for credit sale", "saleID", request.SaleID, "debtID", debt.Id)
+	return debt.Id, nil
+}
+
+// reduce takes a return or a void off the debt, never more than is still owed.
+func (s *SaleDebtsUseCase) reduce(ctx context.Context, request *entity.SaleDebt) error {
+	debt, err := s.debts.GetDebts(ctx, &debts.DebtsID{Id: request.DebtID, CompanyId: request.CompanyID})
+	if err != nil {
+		return fmt.Errorf("error fetching debt: %w", err)
+	}
+
+	amount := math.Round(min(request.Amount, debt.BalanceOfDebt)*100) / 100
+	if amount <= 0 {
+		return nil
+	}
+
+	_, err = s.debts.PayDebts(ctx, &debts.PayDebtsReq{
+		DebtId:     request.DebtID,
+		PayType:    request.PayType,
+		PaidAmount: amount,
+		CompanyId:  request.CompanyID,
+	})
+	if err != nil {
+		return fmt.Errorf("error reducing debt: %w", err)
+	}
+
+	s.log.Info("Reduced debt of sale", "saleID", request.SaleID, "debtID", request.DebtID, "amount", amount, "reason", request.PayType)
+	return nil
+}
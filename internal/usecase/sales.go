@@ -9,27 +9,38 @@ import (
 	"log"
 	"log/slog"
 	"math"
-	"sync"
 )
 
 type SalesUseCase struct {
 	repo    SalesRepo
 	product ProductQuantity
 	cash    CashFlowRepo
+	returns ReturnedProductsRepo
+	uow     UnitOfWork
+	debts   *SaleDebtsUseCase
 	log     *slog.Logger
 }
 
-func NewSalesUseCase(repo SalesRepo, pr ProductQuantity, log *slog.Logger, cash CashFlowRepo) *SalesUseCase {
+func NewSalesUseCase(repo SalesRepo, pr ProductQuantity, log *slog.Logger, cash CashFlowRepo, returns ReturnedProductsRepo, uow UnitOfWork, debts *SaleDebtsUseCase) *SalesUseCase {
 	return &SalesUseCase{
 		repo:    repo,
 		product: pr,
 		cash:    cash,
+		returns: returns,
+		uow:     uow,
+		debts:   debts,
 		log:     log,
 	}
 }
 
 // CalculateTotalSales calculates the total sale price from the sale request.
 func (s *SalesUseCase) CalculateTotalSales(in *entity.SaleRequest) (*entity.SalesTotal, error) {
+	return s.calculateTotalSales(s.product, in)
+}
+
+// calculateTotalSales prices a sale reading catalog items and unit factors through the given repository,
+// so inside a unit of work the lookups run in its transaction.
+func (s *SalesUseCase) calculateTotalSales(products ProductQuantity, in *entity.SaleRequest) (*entity.SalesTotal, error) {
 	if in == nil {
 		return nil, errors.New("input sale request is nil")
 	}
@@ -38,20 +49,51 @@ func (s *SalesUseCase) CalculateTotalSales(in *entity.SaleRequest) (*entity.Sale
 		return nil, errors.New("sold products list is empty")
 	}
 
+	refs := make([]catalogRef, len(in.SoldProducts))
+	for i := range in.SoldProducts {
+		refs[i] = catalogRef{catalogID: in.SoldProducts[i].CatalogID, productID: &in.SoldProducts[i].ProductID}
+	}
+	if err := resolveCatalogProducts(products, in.CompanyID, in.BranchID, refs); err != nil {
+		return nil, err
+	}
+
 	var totalPrice decimal.Decimal
 	var soldProducts []entity.SalesItem
 
+	var unitProducts []string
 	for _, item := range in.SoldProducts {
-		if item.Quantity <= 0 {
-			s.log.Warn("Skipping item with non-positive quantity", "ProductID", item.ProductID)
-			continue
+		if item.Unit != "" {
+			unitProducts = append(unitProducts, item.ProductID)
 		}
+	}
+	factors, err := unitFactors(products, unitProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range in.SoldProducts {
 		if item.SalePrice < 0 {
 			return nil, fmt.Errorf("invalid sale price for product %v: cannot be negative", item.ProductID)
 		}
 
+		// A line entered in a unit is priced per that unit and stored in base units
 		quantity := decimal.NewFromFloat(float64(item.Quantity))
 		salePrice := decimal.NewFromFloat(item.SalePrice)
+		if item.Unit != "" {
+			item.Quantity = 0
+			if item.UnitQuantity > 0 {
+				quantity = decimal.NewFromFloat(item.UnitQuantity)
+				item.Quantity, item.SalePrice, err = toBaseUnits(factors, item.ProductID, item.Unit, item.UnitQuantity, item.SalePrice)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if item.Quantity <= 0 {
+			s.log.Warn("Skipping item with non-positive quantity", "ProductID", item.ProductID)
+			continue
+		}
+
 		totalItemPrice := quantity.Mul(salePrice)
 
 		totalPrice = totalPrice.Add(totalItemPrice)
@@ -60,27 +102,52 @@ func (s *SalesUseCase) CalculateTotalSales(in *entity.SaleRequest) (*entity.Sale
 		itemTotalPriceRounded := math.Round(totalItemPrice.InexactFloat64()*100) / 100
 
 		soldProducts = append(soldProducts, entity.SalesItem{
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			SalePrice:  item.SalePrice,
-			TotalPrice: itemTotalPriceRounded, // Округленная цена
+			ProductID:    item.ProductID,
+			Quantity:     item.Quantity,
+			SalePrice:    item.SalePrice,
+			TotalPrice:   itemTotalPriceRounded, // Округленная цена
+			Unit:         item.Unit,
+			UnitQuantity: item.UnitQuantity,
+			Serials:      item.Serials,
 		})
 	}
 
 	// Преобразуем и округляем totalPrice до двух знаков после запятой
 	totalSalePrice := math.Round(totalPrice.InexactFloat64()*100) / 100
 
+	// A regular sale is paid in full, a credit sale only up to the paid amount
+	paidAmount := totalSalePrice
+	if in.IsForDebt {
+		if in.PaidAmount < 0 || in.PaidAmount > totalSalePrice {
+			return nil, fmt.Errorf("invalid paid amount %v: must be between 0 and the sale total %v", in.PaidAmount, totalSalePrice)
+		}
+		paidAmount = math.Round(in.PaidAmount*100) / 100
+	}
+
+	payments, err := splitPayments(in.Payments, in.PaymentMethod, paidAmount)
+	if err != nil {
+		return nil, err
+	}
+	paymentMethod := in.PaymentMethod
+	if paymentMethod == "" && len(payments) > 0 {
+		paymentMethod = payments[0].PaymentMethod
+	}
+
 	return &entity.SalesTotal{
 		ClientID:       in.ClientID,
 		SoldBy:         in.SoldBy,
 		TotalSalePrice: totalSalePrice, // Округленная итоговая сумма
-		PaymentMethod:  in.PaymentMethod,
+		PaymentMethod:  paymentMethod,
+		IsForDebt:      in.IsForDebt,
+		PaidAmount:     paidAmount,
 		SoldProducts:   soldProducts,
+		Payments:       payments,
 		CompanyID:      in.CompanyID,
 		BranchID:       in.BranchID,
 	}, nil
 }
 
+// CreateSales stores the sale, takes the goods off stock and books the income in one transaction.
 func (s *SalesUseCase) CreateSales(in *entity.SaleRequest) (*pb.SaleResponse, error) {
 
 	total, err := s.CalculateTotalSales(in)
@@ -89,33 +156,134 @@ func (s *SalesUseCase) CreateSales(in *entity.SaleRequest) (*pb.SaleResponse, er
 		return nil, fmt.Errorf("error calculating total sale cost: %w", err)
 	}
 
-	err = s.product.RemoveProducts(in.SoldProducts)
-	if err != nil {
-		s.log.Error("Error removing product quantity", "error", err)
-		//return nil, fmt.Errorf("error removing product quantity: %w", err)
+	// A credit sale needs a client in the debts service; a new one is registered by name and phone
+	if total.IsForDebt && total.ClientID == "" && (in.ClientName == "" || in.ClientPhone == "") {
+		return nil, errors.New("credit sale requires client_id or client name and phone")
 	}
 
-	res, err := s.repo.CreateSale(total)
-	if err != nil {
-		s.log.Error("Error creating sale", "error", err)
-		return nil, fmt.Errorf("error creating sale: %w", err)
-	}
+	var res *pb.SaleResponse
+	err = s.uow.Do(func(r *TxRepos) error {
+		paymentType, err := resolvePaymentTypes(r.PaymentTypes, in.CompanyID, total.PaymentMethod, total.Payments)
+		if err != nil {
+			return fmt.Errorf("error resolving payment types: %w", err)
+		}
+		total.PaymentMethod = paymentType.Name
 
-	cashFlowRequest := &pb.CashFlowRequest{
-		UserId:        in.SoldBy,
-		Amount:        total.TotalSalePrice,
-		Description:   "Mahsulot Sotildi",
-		PaymentMethod: in.PaymentMethod,
-		CompanyId:     in.CompanyID,
-		BranchId:      in.BranchID,
-	}
+		settings, err := r.Settings.GetCompanySettings(&pb.CompanySettingsReq{CompanyId: in.CompanyID})
+		if err != nil {
+			return fmt.Errorf("error fetching company settings: %w", err)
+		}
 
-	cashFlow, err := s.cash.CreateIncome(cashFlowRequest)
+		// A serialized product is sold by picking one in-stock serial per unit
+		productIDs := make([]string, 0, len(total.SoldProducts))
+		for _, item := range total.SoldProducts {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		serialized, err := serializedProducts(r.Serials, productIDs)
+		if err != nil {
+			return err
+		}
+		for i, item := range total.SoldProducts {
+			total.SoldProducts[i].Serials, err = normalizeSerials(serialized, item.ProductID, item.Quantity, item.Serials)
+			if err != nil {
+				return err
+			}
+		}
+
+		// The product rows stay locked until the sale commits, so the check cannot go stale
+		shortages, err := r.Product.CheckStock(total.SoldProducts)
+		if err != nil {
+			return fmt.Errorf("error checking product stock: %w", err)
+		}
+		allowNegative := settings.NegativeStockPolicy == entity.NegativeStockWarning
+		if len(shortages) > 0 && !allowNegative {
+			return &entity.ShortageError{Lines: shortages}
+		}
+
+		res, err = r.Sales.CreateSale(total)
+		if err != nil {
+			return fmt.Errorf("error creating sale: %w", err)
+		}
+
+		info := entity.MovementInfo{
+			Reason:        entity.MovementSale,
+			SourceID:      res.Id,
+			CreatedBy:     in.SoldBy,
+			AllowNegative: allowNegative,
+		}
+		if err = r.Product.RemoveProducts(total.SoldProducts, info); err != nil {
+			return fmt.Errorf("error removing product quantity: %w", err)
+		}
+		if err = r.Sales.SetSaleItemCosts(res.Id); err != nil {
+			return fmt.Errorf("error setting sale item costs: %w", err)
+		}
+		for _, item := range total.SoldProducts {
+			if err = r.Serials.SetSerialStatus(item.ProductID, item.Serials, entity.SerialInStock, entity.SerialSold, info); err != nil {
+				return fmt.Errorf("error selling serials of product %s: %w", item.ProductID, err)
+			}
+		}
+
+		if len(shortages) > 0 {
+			if err = r.Product.RecordShortages(shortages, info); err != nil {
+				return fmt.Errorf("error recording stock shortages: %w", err)
+			}
+			s.log.Warn("Sale exceeds available stock", "saleID", res.Id, "shortages", shortages)
+		}
+
+		// Only the money actually received is income, one entry per payment; the rest becomes a debt
+		for i, payment := range total.Payments {
+			cashFlowRequest := &pb.CashFlowRequest{
+				UserId:        in.SoldBy,
+				Amount:        payment.Amount,
+				Description:   "Mahsulot Sotildi",
+				PaymentMethod: payment.PaymentMethod,
+				CompanyId:     in.CompanyID,
+				BranchId:      in.BranchID,
+				SourceType:    entity.CashSourceSale,
+				SourceId:      res.Id,
+			}
+
+			cashFlow, err := r.CashFlow.CreateIncome(cashFlowRequest)
+			if err != nil {
+				return fmt.Errorf("error creating cash flow: %w", err)
+			}
+			total.Payments[i].CashFlowID = cashFlow.Id
+
+			s.log.Info("Created cash flow record", "cashFlowID", cashFlow.Id)
+		}
+
+		if err = r.Sales.AddSalePayments(res.Id, total.Payments, in.CompanyID, in.BranchID); err != nil {
+			return fmt.Errorf("error saving sale payments: %w", err)
+		}
+		res.Payments = paymentsToPb(total.Payments)
+
+		// The client and the debt are created in the debts service only after the sale commits
+		unpaid := math.Round((total.TotalSalePrice-total.PaidAmount)*100) / 100
+		if total.IsForDebt && (unpaid > 0 || total.ClientID == "") {
+			err = r.SaleDebts.EnqueueSaleDebt(entity.SaleDebt{
+				SaleID:       res.Id,
+				ClientID:     total.ClientID,
+				ClientName:   in.ClientName,
+				ClientPhone:  in.ClientPhone,
+				Amount:       max(unpaid, 0),
+				CurrencyCode: paymentType.Currency,
+				CompanyID:    in.CompanyID,
+			})
+			if err != nil {
+				return fmt.Errorf("error queueing sale debt: %w", err)
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		s.log.Error("Error creating cash flow", "error", err)
+		s.log.Error("Error creating sale", "error", err)
+		return nil, err
 	}
 
-	s.log.Info("Created cash flow record", "cashFlowID", cashFlow.Id)
+	if total.IsForDebt {
+		s.debts.SyncSale(res.Id)
+	}
 
 	return res, nil
 }
@@ -162,76 +330,342 @@ func (s *SalesUseCase) GetListSales(req *pb.SaleFilter) (*pb.SaleList, error) {
 	return res, nil
 }
 
-// DeleteSales deletes a sale record and restores product stock.
-// DeleteSales deletes a sale record and restores product stock, as well as removes the corresponding cash flow entry.
-func (s *SalesUseCase) DeleteSales(req *pb.SaleID) (*pb.Message, error) {
-	if req == nil {
-		return nil, errors.New("sale ID request is nil")
+// UpdateSaleItems replaces the line set of a sale in one transaction. Stock is moved by the per-product
+// difference against the stored items and the change of the total is booked as one income entry of the sale.
+func (s *SalesUseCase) UpdateSaleItems(in *pb.UpdateSaleItemsReq) (*pb.SaleResponse, error) {
+	if in == nil {
+		return nil, errors.New("update sale items request is nil")
 	}
 
-	// Fetch the sale to be deleted
-	sale, err := s.repo.GetSale(req)
+	saleID := &pb.SaleID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.SaleResponse
+	err := s.uow.Do(func(r *TxRepos) error {
+		// Returns lock the sale too, so none can slip in while it changes
+		if err := r.Sales.LockSale(in.Id); err != nil {
+			return err
+		}
+
+		sale, err := r.Sales.GetSale(saleID)
+		if err != nil {
+			return fmt.Errorf("error fetching sale: %w", err)
+		}
+		if sale.Id == "" {
+			return errors.New("sale not found")
+		}
+		if sale.Status == entity.DocumentVoided {
+			return errors.New("sale is voided")
+		}
+		// The debts service cannot change an existing debt, so a credit sale is voided and entered again
+		if sale.IsForDebt {
+			return errors.New("credit sales cannot be edited: void the sale and enter it again")
+		}
+
+		// Returns point at the stored lines, which are about to be replaced; voided returns keep pointing at them too
+		hasReturns, err := r.Returns.HasReturns(in.Id)
+		if err != nil {
+			return fmt.Errorf("error fetching returns of sale: %w", err)
+		}
+		if hasReturns {
+			return errors.New("sale with returns cannot be edited: void the sale and enter it again")
+		}
+
+		refs := make([]catalogRef, 0, len(in.SoldProducts))
+		for _, item := range in.SoldProducts {
+			refs = append(refs, catalogRef{catalogID: item.CatalogId, productID: &item.ProductId})
+		}
+		if err = resolveCatalogProducts(r.Product, in.CompanyId, in.BranchId, refs); err != nil {
+			return err
+		}
+
+		productIDs := make([]string, 0, len(sale.SoldProducts)+len(in.SoldProducts))
+		for _, item := range sale.SoldProducts {
+			productIDs = append(productIDs, item.ProductId)
+		}
+		for _, item := range in.SoldProducts {
+			productIDs = append(productIDs, item.ProductId)
+		}
+		if err = rejectSerialized(r.Serials, productIDs, "sale"); err != nil {
+			return err
+		}
+
+		req := &entity.SaleRequest{
+			ClientID:      sale.ClientId,
+			SoldBy:        sale.SoldBy,
+			PaymentMethod: sale.PaymentMethod,
+			CompanyID:     in.CompanyId,
+			BranchID:      in.BranchId,
+		}
+		for _, item := range in.SoldProducts {
+			req.SoldProducts = append(req.SoldProducts, entity.SalesItem{
+				ProductID:    item.ProductId,
+				Quantity:     int64(item.Quantity),
+				SalePrice:    item.SalePrice,
+				Unit:         item.Unit,
+				UnitQuantity: item.UnitQuantity,
+			})
+		}
+
+		total, err := s.calculateTotalSales(r.Product, req)
+		if err != nil {
+			return fmt.Errorf("error calculating total sale cost: %w", err)
+		}
+		if len(total.SoldProducts) == 0 {
+			return errors.New("sold products list is empty")
+		}
+
+		// Per-product difference between the new and the stored lines
+		deltas := make(map[string]int64)
+		var order []string
+		addDelta := func(productID string, quantity int64) {
+			if _, ok := deltas[productID]; !ok {
+				order = append(order, productID)
+			}
+			deltas[productID] += quantity
+		}
+		for _, item := range total.SoldProducts {
+			addDelta(item.ProductID, item.Quantity)
+		}
+		for _, item := range sale.SoldProducts {
+			addDelta(item.ProductId, -int64(item.Quantity))
+		}
+
+		var taken, putBack []entity.SalesItem
+		for _, id := range order {
+			switch d := deltas[id]; {
+			case d > 0:
+				taken = append(taken, entity.SalesItem{ProductID: id, Quantity: d})
+			case d < 0:
+				putBack = append(putBack, entity.SalesItem{ProductID: id, Quantity: -d})
+			}
+		}
+
+		updatedBy := in.UpdatedBy
+		if updatedBy == "" {
+			updatedBy = sale.SoldBy
+		}
+
+		for _, item := range putBack {
+			_, err := r.Product.AddProduct(&entity.CountProductReq{
+				ID:    item.ProductID,
+				Count: int(item.Quantity),
+				Movement: entity.MovementInfo{
+					Reason:    entity.MovementSale,
+					SourceID:  in.Id,
+					CreatedBy: updatedBy,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error restoring product stock for product %s: %w", item.ProductID, err)
+			}
+		}
+
+		if len(taken) > 0 {
+			settings, err := r.Settings.GetCompanySettings(&pb.CompanySettingsReq{CompanyId: in.CompanyId})
+			if err != nil {
+				return fmt.Errorf("error fetching company settings: %w", err)
+			}
+
+			shortages, err := r.Product.CheckStock(taken)
+			if err != nil {
+				return fmt.Errorf("error checking product stock: %w", err)
+			}
+			allowNegative := settings.NegativeStockPolicy == entity.NegativeStockWarning
+			if len(shortages) > 0 && !allowNegative {
+				return &entity.ShortageError{Lines: shortages}
+			}
+
+			info := entity.MovementInfo{
+				Reason:        entity.MovementSale,
+				SourceID:      in.Id,
+				CreatedBy:     updatedBy,
+				AllowNegative: allowNegative,
+			}
+			if err = r.Product.RemoveProducts(taken, info); err != nil {
+				return fmt.Errorf("error removing product quantity: %w", err)
+			}
+			if len(shortages) > 0 {
+				if err = r.Product.RecordShortages(shortages, info); err != nil {
+					return fmt.Errorf("error recording stock shortages: %w", err)
+				}
+				s.log.Warn("Edited sale exceeds available stock", "saleID", in.Id, "shortages", shortages)
+			}
+		}
+
+		if err = r.Sales.ReplaceSaleItems(in.Id, total); err != nil {
+			return fmt.Errorf("error replacing sale items: %w", err)
+		}
+		if err = r.Sales.SetSaleItemCosts(in.Id); err != nil {
+			return fmt.Errorf("error setting sale item costs: %w", err)
+		}
+
+		// The difference is one signed income entry of the sale, so the income total stays exact
+		diff := decimal.NewFromFloat(total.PaidAmount).Sub(decimal.NewFromFloat(sale.PaidAmount)).Round(2)
+		if !diff.IsZero() {
+			paymentType, err := resolvePaymentTypes(r.PaymentTypes, in.CompanyId, sale.PaymentMethod, nil)
+			if err != nil {
+				return fmt.Errorf("error resolving payment types: %w", err)
+			}
+
+			cashFlow, err := r.CashFlow.CreateIncome(&pb.CashFlowRequest{
+				UserId:        updatedBy,
+				Amount:        diff.InexactFloat64(),
+				Description:   fmt.Sprintf("Sale %s items changed", in.Id),
+				PaymentMethod: paymentType.Name,
+				CompanyId:     in.CompanyId,
+				BranchId:      in.BranchId,
+				SourceType:    entity.CashSourceSale,
+				SourceId:      in.Id,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating cash flow: %w", err)
+			}
+
+			if diff.IsPositive() {
+				err = r.Sales.AddSalePayments(in.Id, []entity.Payment{{
+					Amount:        diff.InexactFloat64(),
+					PaymentMethod: paymentType.Name,
+					Currency:      paymentType.Currency,
+					CashFlowID:    cashFlow.Id,
+				}}, in.CompanyId, in.BranchId)
+			} else {
+				err = r.Sales.ReduceSalePayments(in.Id, diff.Neg().InexactFloat64())
+			}
+			if err != nil {
+				return fmt.Errorf("error adjusting sale payments: %w", err)
+			}
+		}
+
+		res, err = r.Sales.GetSale(saleID)
+		if err != nil {
+			return fmt.Errorf("error fetching updated sale: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		s.log.Error("Error fetching sale for deletion", "saleID", req.Id, "error", err)
-		return nil, fmt.Errorf("error fetching sale for deletion: %w", err)
+		s.log.Error("Error updating sale items", "saleID", in.Id, "error", err)
+		return nil, err
 	}
 
-	// Restore the product stock
-	var wg sync.WaitGroup
-	semaphore := make(chan struct{}, 10)
+	return res, nil
+}
+
+// VoidSale voids a sale in one transaction: the goods go back on stock, the income is reversed
+// and the sale rows are kept with the voided status for the audit history.
+func (s *SalesUseCase) VoidSale(in *pb.VoidSaleReq) (*pb.SaleResponse, error) {
+	if in == nil {
+		return nil, errors.New("void sale request is nil")
+	}
+
+	saleID := &pb.SaleID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.SaleResponse
+	err := s.uow.Do(func(r *TxRepos) error {
+		// Returns lock the sale too, so none can slip in while it changes
+		if err := r.Sales.LockSale(in.Id); err != nil {
+			return err
+		}
 
-	for _, item := range sale.SoldProducts {
-		wg.Add(1)
-		go func(item pb.SalesItem) {
-			defer wg.Done()
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
+		sale, err := r.Sales.GetSale(saleID)
+		if err != nil {
+			return fmt.Errorf("error fetching sale: %w", err)
+		}
+		if sale.Id == "" {
+			return errors.New("sale not found")
+		}
+		if sale.Status == entity.DocumentVoided {
+			return errors.New("sale is already voided")
+		}
 
-			// Restore product stock
+		// A sale with returns keeps its history; the returns have to be voided first
+		returned, err := r.Returns.GetReturnedQuantities(in.Id)
+		if err != nil {
+			return fmt.Errorf("error fetching returns of sale: %w", err)
+		}
+		if len(returned) > 0 {
+			return errors.New("sale has returns: void the returns first")
+		}
+
+		voidedBy := in.VoidedBy
+		if voidedBy == "" {
+			voidedBy = sale.SoldBy
+		}
+
+		// Restore the product stock and put the sold serials back in stock
+		for _, item := range sale.SoldProducts {
 			productQuantityReq := &entity.CountProductReq{
 				ID:    item.ProductId,
 				Count: int(item.Quantity),
+				Movement: entity.MovementInfo{
+					Reason:    entity.MovementSale,
+					SourceID:  in.Id,
+					CreatedBy: voidedBy,
+				},
 			}
 
-			if _, err := s.product.AddProduct(productQuantityReq); err != nil {
-				s.log.Error("Error restoring product stock after sale deletion", "productID", item.ProductId, "error", err)
+			if _, err := r.Product.AddProduct(productQuantityReq); err != nil {
+				return fmt.Errorf("error restoring product stock for product %s: %w", item.ProductId, err)
 			}
-		}(*item)
-	}
+			err = r.Serials.SetSerialStatus(item.ProductId, item.Serials, entity.SerialSold, entity.SerialInStock, productQuantityReq.Movement)
+			if err != nil {
+				return fmt.Errorf("error restoring serials of product %s: %w", item.ProductId, err)
+			}
+		}
+
+		if err = r.Sales.VoidSale(in); err != nil {
+			return fmt.Errorf("error voiding sale: %w", err)
+		}
 
-	wg.Wait()
+		// Every income entry of the sale is reversed the way it was received
+		_, err = r.CashFlow.ReverseBySource(entity.CashSourceSale, in.Id, voidedBy, fmt.Sprintf("Sale %s voided", in.Id))
+		if err != nil {
+			return fmt.Errorf("error reversing cash flow: %w", err)
+		}
+		// The debt of a credit sale is taken off in the debts service after the void commits
+		if sale.IsForDebt {
+			if err = r.SaleDebts.VoidSaleDebt(in.Id); err != nil {
+				return fmt.Errorf("error cancelling sale debt: %w", err)
+			}
+		}
 
-	// Delete the sale record from the database
-	res, err := s.repo.DeleteSale(req)
+		res, err = r.Sales.GetSale(saleID)
+		if err != nil {
+			return fmt.Errorf("error fetching voided sale: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		s.log.Error("Error deleting sale", "saleID", req.Id, "error", err)
-		return nil, fmt.Errorf("error deleting sale: %w", err)
+		s.log.Error("Error voiding sale", "saleID", in.Id, "error", err)
+		return nil, err
 	}
 
-	// Now, handle the cash flow for the sale
-	// We assume that the cash flow related to this sale needs to be reversed or deleted.
-	cashFlowRequest := &pb.CashFlowRequest{
-		UserId:        sale.SoldBy,
-		BranchId:      req.BranchId,
-		Amount:        sale.TotalSalePrice,
-		Description:   fmt.Sprintf("Refund for sale ID %s", req.Id),
-		PaymentMethod: sale.PaymentMethod,
-		CompanyId:     req.CompanyId,
+	s.log.Info("Voided sale and reversed its cash flow", "saleID", in.Id)
+	if res.IsForDebt {
+		s.debts.SyncSale(in.Id)
 	}
 
-	// Delete the related cash flow entry
-	a, err := s.cash.CreateExpense(cashFlowRequest) // Assuming you have a Delete method in CashFlowRepo
-	if err != nil {
-		s.log.Error("Error deleting cash flow for the sale", "saleID", req.Id, "error", err)
-		return nil, fmt.Errorf("error deleting cash flow: %w", err)
-	}
+	return res, nil
+}
 
-	log.Println(a.Id)
+// DeleteSales is kept for old clients: the sale is voided instead of being deleted.
+func (s *SalesUseCase) DeleteSales(req *pb.SaleID) (*pb.Message, error) {
+	if req == nil {
+		return nil, errors.New("sale ID request is nil")
+	}
 
-	// Log the successful deletion of sale and cash flow
-	s.log.Info("Successfully deleted sale and corresponding cash flow", "saleID", req.Id)
+	_, err := s.VoidSale(&pb.VoidSaleReq{
+		Id:        req.Id,
+		CompanyId: req.CompanyId,
+		BranchId:  req.BranchId,
+		Reason:    "deleted",
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return res, nil
+	return &pb.Message{Message: "Sale voided successfully"}, nil
 }
 
 // GetMostSoldProductsByDay retrieves the most sold products grouped by day.
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+const maxSerialLength = 64
+
+type SerialsUseCase struct {
+	repo SerialsRepo
+	log  *slog.Logger
+}
+
+func NewSerialsUseCase(repo SerialsRepo, log *slog.Logger) *SerialsUseCase {
+	return &SerialsUseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// normalizeSerials checks the serials of a document line: a serialized product needs exactly one serial
+// per unit, with no duplicates; other products take none. The serials are returned trimmed.
+func normalizeSerials(serialized map[string]bool, productID string, quantity int64, serials []string) ([]string, error) {
+	if !serialized[productID] {
+		if len(serials) > 0 {
+			return nil, fmt.Errorf("product %v is not serialized: serials are not accepted", productID)
+		}
+		return nil, nil
+	}
+
+	if int64(len(serials)) != quantity {
+		return nil, fmt.Errorf("product %v is serialized: %d serials required, %d given", productID, quantity, len(serials))
+	}
+
+	seen := make(map[string]bool, len(serials))
+	res := make([]string, 0, len(serials))
+	for _, serial := range serials {
+		serial = strings.TrimSpace(serial)
+		if serial == "" {
+			return nil, fmt.Errorf("empty serial for product %v", productID)
+		}
+		if len(serial) > maxSerialLength {
+			return nil, fmt.Errorf("invalid serial %q for product %v: longer than %d characters", serial, productID, maxSerialLength)
+		}
+		if seen[serial] {
+			return nil, fmt.Errorf("duplicate serial %s for product %v", serial, productID)
+		}
+		seen[serial] = true
+		res = append(res, serial)
+	}
+
+	return res, nil
+}
+
+// serializedProducts loads which of the products are serialized.
+func serializedProducts(repo SerialsRepo, productIDs []string) (map[string]bool, error) {
+	serialized, err := repo.GetSerializedProducts(productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching serialized products: %w", err)
+	}
+	return serialized, nil
+}
+
+// rejectSerialized refuses an edit of document lines when one of the products is serialized:
+// such a document is voided and entered again with its serials.
+func rejectSerialized(repo SerialsRepo, productIDs []string, document string) error {
+	serialized, err := serializedProducts(repo, productIDs)
+	if err != nil {
+		return err
+	}
+	if len(serialized) > 0 {
+		return fmt.Errorf("%s with serialized products cannot be edited: void it and enter it again", document)
+	}
+	return nil
+}
+
+// subsetOf checks that every serial was on the document line it refers to.
+func subsetOf(serials, lineSerials []string, line string) error {
+	onLine := make(map[string]bool, len(lineSerials))
+	for _, serial := range lineSerials {
+		onLine[serial] = true
+	}
+	for _, serial := range serials {
+		if !onLine[serial] {
+			return fmt.Errorf("serial %s is not on %s", serial, line)
+		}
+	}
+	return nil
+}
+
+// pickLineSerials picks the serials taken off a document line by a return or a transfer receipt. A line of
+// a serialized document names one of its serials per unit taken; without serials the whole line is taken,
+// which works only while nothing of it was taken before.
+func pickLineSerials(productID string, lineSerials []string, line string, quantity, returned int64, serials []string) ([]string, error) {
+	if len(lineSerials) == 0 {
+		if len(serials) > 0 {
+			return nil, fmt.Errorf("%s has no serials", line)
+		}
+		return nil, nil
+	}
+
+	if len(serials) == 0 {
+		if returned > 0 || quantity != int64(len(lineSerials)) {
+			return nil, fmt.Errorf("%s is serialized: list its serials", line)
+		}
+		return lineSerials, nil
+	}
+
+	serials, err := normalizeSerials(map[string]bool{productID: true}, productID, quantity, serials)
+	if err != nil {
+		return nil, err
+	}
+	if err = subsetOf(serials, lineSerials, line); err != nil {
+		return nil, err
+	}
+
+	return serials, nil
+}
+
+// TraceSerial returns a serial with its history from the purchase to the last sale or return.
+func (s *SerialsUseCase) TraceSerial(in *pb.TraceSerialReq) (*pb.SerialTrace, error) {
+	if in == nil {
+		return nil, errors.New("trace serial request is nil")
+	}
+	in.Serial = strings.TrimSpace(in.Serial)
+	if in.Serial == "" || in.CompanyId == "" {
+		return nil, errors.New("serial and company_id are required")
+	}
+
+	res, err := s.repo.TraceSerial(in)
+	if err != nil {
+		s.log.Error("Error tracing serial", "serial", in.Serial, "error", err)
+		return nil, fmt.Errorf("error tracing serial: %w", err)
+	}
+	return res, nil
+}
+
+// GetProductSerials lists the serials of a branch or a product, the ones in stock by default.
+func (s *SerialsUseCase) GetProductSerials(in *pb.ProductSerialsReq) (*pb.ProductSerialList, error) {
+	if in == nil {
+		return nil, errors.New("product serials request is nil")
+	}
+	if in.CompanyId == "" {
+		return nil, errors.New("company_id is required")
+	}
+
+	res, err := s.repo.GetProductSerials(in)
+	if err != nil {
+		s.log.Error("Error fetching product serials", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching product serials: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeSerials(t *testing.T) {
+	serialized := map[string]bool{"phone": true}
+
+	tests := []struct {
+		name      string
+		productID string
+		quantity  int64
+		serials   []string
+		want      []string
+		wantErr   bool
+	}{
+		{
+			name:      "one serial per unit",
+			productID: "phone",
+			quantity:  2,
+			serials:   []string{" SN-1 ", "SN-2"},
+			want:      []string{"SN-1", "SN-2"},
+		},
+		{
+			name:      "product without serials",
+			productID: "case",
+			quantity:  3,
+		},
+		{
+			name:      "serials on a product without serials",
+			productID: "case",
+			quantity:  1,
+			serials:   []string{"SN-1"},
+			wantErr:   true,
+		},
+		{
+			name:      "fewer serials than units",
+			productID: "phone",
+			quantity:  2,
+			serials:   []string{"SN-1"},
+			wantErr:   true,
+		},
+		{
+			name:      "more serials than units",
+			productID: "phone",
+			quantity:  1,
+			serials:   []string{"SN-1", "SN-2"},
+			wantErr:   true,
+		},
+		{
+			name:      "no serials for a serialized product",
+			productID: "phone",
+			quantity:  1,
+			wantErr:   true,
+		},
+		{
+			name:      "duplicate after trimming",
+			productID: "phone",
+			quantity:  2,
+			serials:   []string{"SN-1", "SN-1 "},
+			wantErr:   true,
+		},
+		{
+			name:      "empty serial",
+			productID: "phone",
+			quantity:  1,
+			serials:   []string{"  "},
+			wantErr:   true,
+		},
+		{
+			name:      "serial too long",
+			productID: "phone",
+			quantity:  1,
+			serials:   []string{strings.Repeat("9", maxSerialLength+1)},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeSerials(serialized, tt.productID, tt.quantity, tt.serials)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeSerials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeSerials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickLineSerials(t *testing.T) {
+	lineSerials := []string{"SN-1", "SN-2", "SN-3"}
+
+	tests := []struct {
+		name        string
+		lineSerials []string
+		quantity    int64
+		returned    int64
+		serials     []string
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "line without serials",
+			lineSerials: nil,
+			quantity:    2,
+		},
+		{
+			name:        "serials on a line without serials",
+			lineSerials: nil,
+			quantity:    1,
+			serials:     []string{"SN-1"},
+			wantErr:     true,
+		},
+		{
+			name:        "whole line without naming serials",
+			lineSerials: lineSerials,
+			quantity:    3,
+			want:        lineSerials,
+		},
+		{
+			name:        "part of the line without naming serials",
+			lineSerials: lineSerials,
+			quantity:    2,
+			wantErr:     true,
+		},
+		{
+			name:        "rest of a line already taken from without naming serials",
+			lineSerials: lineSerials,
+			quantity:    3,
+			returned:    1,
+			wantErr:     true,
+		},
+		{
+			name:        "named serials",
+			lineSerials: lineSerials,
+			quantity:    2,
+			returned:    1,
+			serials:     []string{"SN-3", " SN-2"},
+			want:        []string{"SN-3", "SN-2"},
+		},
+		{
+			name:        "serial not on the line",
+			lineSerials: lineSerials,
+			quantity:    1,
+			serials:     []string{"SN-9"},
+			wantErr:     true,
+		},
+		{
+			name:        "serials do not match the quantity",
+			lineSerials: lineSerials,
+			quantity:    2,
+			serials:     []string{"SN-1"},
+			wantErr:     true,
+		},
+		{
+			name:        "duplicate serials",
+			lineSerials: lineSerials,
+			quantity:    2,
+			serials:     []string{"SN-1", "SN-1"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pickLineSerials("phone", tt.lineSerials, "sale line 1", tt.quantity, tt.returned, tt.serials)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("pickLineSerials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pickLineSerials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
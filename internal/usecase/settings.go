@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+type SettingsUseCase struct {
+	repo SettingsRepo
+	log  *slog.Logger
+}
+
+func NewSettingsUseCase(repo SettingsRepo, log *slog.Logger) *SettingsUseCase {
+	return &SettingsUseCase{
+		repo: repo,
+		log:  log,
+	}
+}
+
+// GetCompanySettings retrieves the settings of a company, falling back to the defaults.
+func (s *SettingsUseCase) GetCompanySettings(in *pb.CompanySettingsReq) (*pb.CompanySettings, error) {
+	if in == nil || in.CompanyId == "" {
+		return nil, errors.New("company_id is required")
+	}
+
+	res, err := s.repo.GetCompanySettings(in)
+	if err != nil {
+		s.log.Error("Error fetching company settings", "companyID", in.CompanyId, "error", err)
+		return nil, fmt.Errorf("error fetching company settings: %w", err)
+	}
+	return res, nil
+}
+
+// UpdateCompanySettings changes the settings of a company.
+func (s *SettingsUseCase) UpdateCompanySettings(in *pb.CompanySettings) (*pb.CompanySettings, error) {
+	if in == nil || in.CompanyId == "" {
+		return nil, errors.New("company_id is required")
+	}
+
+	switch in.NegativeStockPolicy {
+	case entity.NegativeStockStrict, entity.NegativeStockWarning:
+	default:
+		return nil, fmt.Errorf("invalid negative stock policy %q: must be %q or %q",
+			in.NegativeStockPolicy, entity.NegativeStockStrict, entity.NegativeStockWarning)
+	}
+	switch in.CostingMethod {
+	case "", entity.CostingAverage, entity.CostingFIFO:
+	default:
+		return nil, fmt.Errorf("invalid costing method %q: must be %q or %q",
+			in.CostingMethod, entity.CostingAverage, entity.CostingFIFO)
+	}
+	if in.BarcodePrefix != "" && !validBarcodePrefix(in.BarcodePrefix) {
+		return nil, fmt.Errorf("invalid barcode prefix %q: must be three digits from 200 to 299", in.BarcodePrefix)
+	}
+
+	res, err := s.repo.UpdateCompanySettings(in)
+	if err != nil {
+		s.log.Error("Error updating company settings", "companyID", in.CompanyId, "error", err)
+		return nil, fmt.Errorf("error updating company settings: %w", err)
+	}
+	return res, nil
+}
+
+// validBarcodePrefix checks that the prefix lies in the 200-299 range GS1 keeps for in-store codes.
+func validBarcodePrefix(prefix string) bool {
+	if len(prefix) != 3 || prefix[0] != '2' {
+		return false
+	}
+	return prefix[1] >= '0' && prefix[1] <= '9' && prefix[2] >= '0' && prefix[2] <= '9'
+}
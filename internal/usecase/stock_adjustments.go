@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+type StockAdjustmentsUseCase struct {
+	repo StockAdjustmentsRepo
+	uow  UnitOfWork
+	log  *slog.Logger
+}
+
+func NewStockAdjustmentsUseCase(repo StockAdjustmentsRepo, log *slog.Logger, uow UnitOfWork) *StockAdjustmentsUseCase {
+	return &StockAdjustmentsUseCase{
+		repo: repo,
+		uow:  uow,
+		log:  log,
+	}
+}
+
+// validateAdjustment checks the reason code and that the signs of the lines fit it:
+// damage, loss and internal use only take goods off the stock, found only adds them, a correction may do both.
+func validateAdjustment(in *pb.StockAdjustmentReq) error {
+	var wantSign int64
+	switch in.Reason {
+	case entity.AdjustmentDamage, entity.AdjustmentLoss, entity.AdjustmentInternalUse:
+		wantSign = -1
+	case entity.AdjustmentFound:
+		wantSign = 1
+	case entity.AdjustmentCorrection:
+	default:
+		return fmt.Errorf("invalid adjustment reason %q: must be one of %s, %s, %s, %s, %s", in.Reason,
+			entity.AdjustmentDamage, entity.AdjustmentLoss, entity.AdjustmentInternalUse, entity.AdjustmentFound, entity.AdjustmentCorrection)
+	}
+
+	if len(in.Items) == 0 {
+		return errors.New("stock adjustment items are empty")
+	}
+	for _, item := range in.Items {
+		if item.Quantity == 0 {
+			return fmt.Errorf("invalid quantity for product %v: must not be zero", item.ProductId)
+		}
+		if wantSign < 0 && item.Quantity > 0 {
+			return fmt.Errorf("invalid quantity for product %v: %s only takes goods off the stock", item.ProductId, in.Reason)
+		}
+		if wantSign > 0 && item.Quantity < 0 {
+			return fmt.Errorf("invalid quantity for product %v: %s only adds goods to the stock", item.ProductId, in.Reason)
+		}
+	}
+
+	return nil
+}
+
+// AdjustStock corrects the stock for one reason code and writes the changes to the movements ledger.
+// Goods taken off the stock must be on hand. The lines are valued at the cost the stock moved at, and with
+// book_expense the cost of the goods taken off is booked as an expense.
+func (s *StockAdjustmentsUseCase) AdjustStock(in *pb.StockAdjustmentReq) (*pb.StockAdjustment, error) {
+	if in == nil {
+		return nil, errors.New("stock adjustment request is nil")
+	}
+	if err := validateAdjustment(in); err != nil {
+		return nil, err
+	}
+
+	req := &entity.StockAdjustmentRequest{
+		Reason:      in.Reason,
+		Description: in.Description,
+		CreatedBy:   in.CreatedBy,
+		CompanyID:   in.CompanyId,
+		BranchID:    in.BranchId,
+	}
+	var removed []entity.SalesItem
+	for _, item := range in.Items {
+		req.Items = append(req.Items, entity.StockAdjustment{ProductID: item.ProductId, Delta: item.Quantity, Serials: item.Serials})
+		if item.Quantity < 0 {
+			removed = append(removed, entity.SalesItem{ProductID: item.ProductId, Quantity: -item.Quantity})
+		}
+	}
+
+	var res *pb.StockAdjustment
+	err := s.uow.Do(func(tx *TxRepos) error {
+		// A serialized product is written off or found by its serials, one per unit
+		productIDs := make([]string, 0, len(req.Items))
+		for _, item := range req.Items {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		serialized, err := serializedProducts(tx.Serials, productIDs)
+		if err != nil {
+			return err
+		}
+		for i, item := range req.Items {
+			req.Items[i].Serials, err = normalizeSerials(serialized, item.ProductID, max(item.Delta, -item.Delta), item.Serials)
+			if err != nil {
+				return err
+			}
+		}
+
+		shortages, err := tx.Product.CheckStock(removed)
+		if err != nil {
+			return fmt.Errorf("error checking product stock: %w", err)
+		}
+		if len(shortages) > 0 {
+			return &entity.ShortageError{Lines: shortages}
+		}
+
+		if res, err = recordAdjustment(tx, req); err != nil {
+			return err
+		}
+
+		if !in.BookExpense || res.WriteOffValue <= 0 {
+			return nil
+		}
+
+		paymentType, err := resolvePaymentTypes(tx.PaymentTypes, in.CompanyId, in.PaymentMethod, nil)
+		if err != nil {
+			return fmt.Errorf("error resolving payment types: %w", err)
+		}
+
+		cashFlow, err := tx.CashFlow.CreateExpense(&pb.CashFlowRequest{
+			UserId:        in.CreatedBy,
+			Amount:        res.WriteOffValue,
+			Description:   "Mahsulot hisobdan chiqarildi",
+			PaymentMethod: paymentType.Name,
+			CompanyId:     in.CompanyId,
+			BranchId:      in.BranchId,
+			SourceType:    entity.CashSourceStockAdjustment,
+			SourceId:      res.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating cash flow: %w", err)
+		}
+		if err = tx.StockAdjustments.SetStockAdjustmentCashFlow(res.Id, cashFlow.Id); err != nil {
+			return fmt.Errorf("error linking expense to stock adjustment: %w", err)
+		}
+		res.CashFlowId = cashFlow.Id
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("Error adjusting stock", "reason", in.Reason, "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// recordAdjustment stores the adjustment document, changes the stock and the serials by its lines and values it.
+// The caller checks that the goods taken off are on hand.
+func recordAdjustment(tx *TxRepos, req *entity.StockAdjustmentRequest) (*pb.StockAdjustment, error) {
+	res, err := tx.StockAdjustments.CreateStockAdjustment(req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating stock adjustment: %w", err)
+	}
+
+	info := entity.MovementInfo{
+		Reason:    entity.MovementAdjustment,
+		SourceID:  res.Id,
+		CreatedBy: req.CreatedBy,
+	}
+	if err = tx.Product.AdjustStock(req.Items, info); err != nil {
+		return nil, fmt.Errorf("error adjusting product stock: %w", err)
+	}
+	if res, err = tx.StockAdjustments.ValueStockAdjustment(res.Id); err != nil {
+		return nil, fmt.Errorf("error valuing stock adjustment: %w", err)
+	}
+
+	for _, item := range req.Items {
+		if item.Delta < 0 {
+			err = tx.Serials.SetSerialStatus(item.ProductID, item.Serials, entity.SerialInStock, entity.SerialWrittenOff, info)
+		} else {
+			err = tx.Serials.ReceiveSerials(item.ProductID, item.Serials, info)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error adjusting serials of product %s: %w", item.ProductID, err)
+		}
+	}
+
+	return res, nil
+}
+
+// GetWriteOffReport sums the adjustments of a period by reason code.
+func (s *StockAdjustmentsUseCase) GetWriteOffReport(in *pb.WriteOffReportReq) (*pb.WriteOffReport, error) {
+	if in == nil {
+		return nil, errors.New("write-off report request is nil")
+	}
+
+	res, err := s.repo.GetWriteOffReport(in)
+	if err != nil {
+		s.log.Error("Error fetching write-off report", "error", err)
+		return nil, fmt.Errorf("error fetching write-off report: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"testing"
+)
+
+func TestValidateAdjustment(t *testing.T) {
+	items := func(quantities ...int64) []*pb.StockAdjustmentItemReq {
+		res := make([]*pb.StockAdjustmentItemReq, 0, len(quantities))
+		for _, q := range quantities {
+			res = append(res, &pb.StockAdjustmentItemReq{ProductId: "p", Quantity: q})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		reason  string
+		items   []*pb.StockAdjustmentItemReq
+		wantErr bool
+	}{
+		{name: "damage takes off", reason: entity.AdjustmentDamage, items: items(-1, -3)},
+		{name: "loss takes off", reason: entity.AdjustmentLoss, items: items(-2)},
+		{name: "internal use takes off", reason: entity.AdjustmentInternalUse, items: items(-1)},
+		{name: "found adds", reason: entity.AdjustmentFound, items: items(4)},
+		{name: "correction does both", reason: entity.AdjustmentCorrection, items: items(5, -2)},
+		{name: "damage cannot add", reason: entity.AdjustmentDamage, items: items(-1, 1), wantErr: true},
+		{name: "loss cannot add", reason: entity.AdjustmentLoss, items: items(2), wantErr: true},
+		{name: "internal use cannot add", reason: entity.AdjustmentInternalUse, items: items(1), wantErr: true},
+		{name: "found cannot take off", reason: entity.AdjustmentFound, items: items(3, -1), wantErr: true},
+		{name: "zero quantity", reason: entity.AdjustmentCorrection, items: items(0), wantErr: true},
+		{name: "no items", reason: entity.AdjustmentCorrection, wantErr: true},
+		{name: "unknown reason", reason: "theft", items: items(-1), wantErr: true},
+		{name: "empty reason", items: items(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAdjustment(&pb.StockAdjustmentReq{Reason: tt.reason, Items: tt.items})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAdjustment() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"crm-admin/internal/entity"
+	"crm-admin/internal/generated/company"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const (
+	stockAlertBatch       = 50
+	stockAlertMaxAttempts = 3
+)
+
+type StockAlertsUseCase struct {
+	repo StockAlertsRepo
+	sms  SMSSender
+	log  *slog.Logger
+}
+
+func NewStockAlertsUseCase(repo StockAlertsRepo, sms SMSSender, log *slog.Logger) *StockAlertsUseCase {
+	return &StockAlertsUseCase{
+		repo: repo,
+		sms:  sms,
+		log:  log,
+	}
+}
+
+// Run checks for new low-stock alerts every interval and delivers them until ctx is cancelled.
+// Alerts are queued by the stock changes themselves, so a sale never waits for the SMS.
+func (s *StockAlertsUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SendPendingAlerts(ctx); err != nil {
+			s.log.Error("Error sending stock alerts", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendPendingAlerts sends every queued alert by SMS to the alert phone of its company.
+// Companies without an alert phone get their alerts skipped; failed sends are retried on the next runs.
+func (s *StockAlertsUseCase) SendPendingAlerts(ctx context.Context) error {
+	for {
+		alerts, err := s.repo.ClaimStockAlerts(stockAlertBatch)
+		if err != nil {
+			return fmt.Errorf("error claiming stock alerts: %w", err)
+		}
+
+		retry := false
+		for _, alert := range alerts {
+			status, errMsg := s.send(ctx, alert)
+			if err = s.repo.SetStockAlertStatus(alert.ID, status, errMsg); err != nil {
+				return fmt.Errorf("error updating stock alert %s: %w", alert.ID, err)
+			}
+			retry = retry || status == entity.AlertPending
+		}
+
+		// Alerts put back for a retry wait for the next run instead of being claimed again right away
+		if len(alerts) < stockAlertBatch || retry || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// send delivers one alert and returns its new status with the error text, if any.
+func (s *StockAlertsUseCase) send(ctx context.Context, alert entity.StockAlert) (string, string) {
+	if alert.Phone == "" {
+		return entity.AlertSkipped, "company has no alert phone"
+	}
+
+	unit := alertUnit(alert.BaseUnit)
+	message := fmt.Sprintf("%s: qoldiq %d %s, minimal %d %s.", alert.ProductName, alert.Balance, unit, alert.MinStock, unit)
+	if alert.ReorderQty > 0 {
+		message += fmt.Sprintf(" Buyurtma qilish kerak: %d %s.", alert.ReorderQty, unit)
+	}
+
+	_, err := s.sms.SendSMS(ctx, &company.SmsRequest{
+		Phone:     alert.Phone,
+		Message:   message,
+		CompanyId: alert.CompanyID,
+	})
+	if err == nil {
+		return entity.AlertSent, ""
+	}
+
+	s.log.Error("Error sending stock alert", "alertID", alert.ID, "productID", alert.ProductID, "attempt", alert.Attempts, "error", err)
+	if alert.Attempts >= stockAlertMaxAttempts {
+		return entity.AlertFailed, err.Error()
+	}
+	return entity.AlertPending, err.Error()
+}
+
+// alertUnit names the base unit the alert quantities are counted in. Pieces keep the usual "ta".
+func alertUnit(baseUnit string) string {
+	switch strings.ToLower(baseUnit) {
+	case "", entity.DefaultBaseUnit, "dona", "шт":
+		return "ta"
+	}
+	return baseUnit
+}
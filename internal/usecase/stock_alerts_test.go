@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"crm-admin/internal/entity"
+	"crm-admin/internal/generated/company"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+// smsRecorder records every SMS it is asked to send and fails them all while err is set.
+type smsRecorder struct {
+	sent []*company.SmsRequest
+	err  error
+}
+
+func (s *smsRecorder) SendSMS(ctx context.Context, in *company.SmsRequest, opts ...grpc.CallOption) (*company.Message, error) {
+	s.sent = append(s.sent, in)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &company.Message{}, nil
+}
+
+// stockAlertsStub keeps the alerts in memory and claims the pending ones the way the repo does.
+type stockAlertsStub struct {
+	alerts []*entity.StockAlert
+	status map[string]string
+	errMsg map[string]string
+}
+
+func newStockAlertsStub(alerts ...entity.StockAlert) *stockAlertsStub {
+	s := &stockAlertsStub{status: make(map[string]string), errMsg: make(map[string]string)}
+	for i := range alerts {
+		s.alerts = append(s.alerts, &alerts[i])
+		s.status[alerts[i].ID] = entity.AlertPending
+	}
+	return s
+}
+
+func (s *stockAlertsStub) ClaimStockAlerts(limit int) ([]entity.StockAlert, error) {
+	var res []entity.StockAlert
+	for _, alert := range s.alerts {
+		if len(res) == limit {
+			break
+		}
+		if s.status[alert.ID] != entity.AlertPending {
+			continue
+		}
+		alert.Attempts++
+		s.status[alert.ID] = "claimed"
+		res = append(res, *alert)
+	}
+	return res, nil
+}
+
+func (s *stockAlertsStub) SetStockAlertStatus(id, status, errMsg string) error {
+	s.status[id] = status
+	s.errMsg[id] = errMsg
+	return nil
+}
+
+func newTestStockAlerts(repo StockAlertsRepo, sms SMSSender) *StockAlertsUseCase {
+	return NewStockAlertsUseCase(repo, sms, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestSendPendingAlerts(t *testing.T) {
+	tests := []struct {
+		name        string
+		alert       entity.StockAlert
+		wantStatus  string
+		wantMessage string
+	}{
+		{
+			name:        "pieces",
+			alert:       entity.StockAlert{ID: "a1", ProductName: "Cola", BaseUnit: "pcs", Balance: 4, MinStock: 5, Phone: "+998901234567", CompanyID: "c1"},
+			wantStatus:  entity.AlertSent,
+			wantMessage: "Cola: qoldiq 4 ta, minimal 5 ta.",
+		},
+		{
+			name:        "grams with reorder quantity",
+			alert:       entity.StockAlert{ID: "a2", ProductName: "Sugar", BaseUnit: "g", Balance: 500, MinStock: 1000, ReorderQty: 5000, Phone: "+998901234567", CompanyID: "c1"},
+			wantStatus:  entity.AlertSent,
+			wantMessage: "Sugar: qoldiq 500 g, minimal 1000 g. Buyurtma qilish kerak: 5000 g.",
+		},
+		{
+			name:        "no catalog unit",
+			alert:       entity.StockAlert{ID: "a3", ProductName: "Bread", Balance: 0, MinStock: 2, Phone: "+998901234567", CompanyID: "c1"},
+			wantStatus:  entity.AlertSent,
+			wantMessage: "Bread: qoldiq 0 ta, minimal 2 ta.",
+		},
+		{
+			name:       "no alert phone",
+			alert:      entity.StockAlert{ID: "a4", ProductName: "Cola", Balance: 4, MinStock: 5, CompanyID: "c1"},
+			wantStatus: entity.AlertSkipped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newStockAlertsStub(tt.alert)
+			sms := &smsRecorder{}
+
+			if err := newTestStockAlerts(repo, sms).SendPendingAlerts(context.Background()); err != nil {
+				t.Fatalf("SendPendingAlerts() error = %v", err)
+			}
+			if got := repo.status[tt.alert.ID]; got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+
+			if tt.wantMessage == "" {
+				if len(sms.sent) != 0 {
+					t.Errorf("sent %d SMS, want none", len(sms.sent))
+				}
+				return
+			}
+			if len(sms.sent) != 1 {
+				t.Fatalf("sent %d SMS, want 1", len(sms.sent))
+			}
+			if got := sms.sent[0]; got.Message != tt.wantMessage || got.Phone != tt.alert.Phone || got.CompanyId != tt.alert.CompanyID {
+				t.Errorf("SMS = %q to %q of %q, want %q to %q of %q",
+					got.Message, got.Phone, got.CompanyId, tt.wantMessage, tt.alert.Phone, tt.alert.CompanyID)
+			}
+		})
+	}
+}
+
+func TestSendPendingAlertsRetries(t *testing.T) {
+	repo := newStockAlertsStub(entity.StockAlert{ID: "a1", ProductName: "Cola", Balance: 1, MinStock: 5, Phone: "+998901234567"})
+	sms := &smsRecorder{err: errors.New("sms gateway is down")}
+	alerts := newTestStockAlerts(repo, sms)
+
+	// A failed send stays pending for the next run until the last attempt fails it for good
+	for attempt := 1; attempt <= stockAlertMaxAttempts; attempt++ {
+		if err := alerts.SendPendingAlerts(context.Background()); err != nil {
+			t.Fatalf("SendPendingAlerts() error = %v", err)
+		}
+
+		want := entity.AlertPending
+		if attempt == stockAlertMaxAttempts {
+			want = entity.AlertFailed
+		}
+		if got := repo.status["a1"]; got != want {
+			t.Fatalf("attempt %d: status = %q, want %q", attempt, got, want)
+		}
+		if got := repo.errMsg["a1"]; got != sms.err.Error() {
+			t.Errorf("attempt %d: error = %q, want %q", attempt, got, sms.err.Error())
+		}
+		if len(sms.sent) != attempt {
+			t.Errorf("attempt %d: sent %d SMS, want %d", attempt, len(sms.sent), attempt)
+		}
+	}
+
+	// A failed alert is not sent again
+	if err := alerts.SendPendingAlerts(context.Background()); err != nil {
+		t.Fatalf("SendPendingAlerts() error = %v", err)
+	}
+	if len(sms.sent) != stockAlertMaxAttempts {
+		t.Errorf("sent %d SMS, want %d", len(sms.sent), stockAlertMaxAttempts)
+	}
+}
+
+func TestSendPendingAlertsBatches(t *testing.T) {
+	count := 2*stockAlertBatch + 1
+	pending := make([]entity.StockAlert, 0, count)
+	for i := 0; i < count; i++ {
+		pending = append(pending, entity.StockAlert{ID: fmt.Sprintf("a%d", i), ProductName: "Cola", Phone: "+998901234567"})
+	}
+	repo := newStockAlertsStub(pending...)
+	sms := &smsRecorder{}
+
+	// Full batches are followed by the next claim in the same run
+	if err := newTestStockAlerts(repo, sms).SendPendingAlerts(context.Background()); err != nil {
+		t.Fatalf("SendPendingAlerts() error = %v", err)
+	}
+	if len(sms.sent) != count {
+		t.Errorf("sent %d SMS, want %d", len(sms.sent), count)
+	}
+	for _, alert := range pending {
+		if got := repo.status[alert.ID]; got != entity.AlertSent {
+			t.Errorf("alert %s: status = %q, want %q", alert.ID, got, entity.AlertSent)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"log/slog"
+)
+
+type StocktakesUseCase struct {
+	repo StocktakesRepo
+	uow  UnitOfWork
+	log  *slog.Logger
+}
+
+func NewStocktakesUseCase(repo StocktakesRepo, log *slog.Logger, uow UnitOfWork) *StocktakesUseCase {
+	return &StocktakesUseCase{
+		repo: repo,
+		uow:  uow,
+		log:  log,
+	}
+}
+
+// StartStocktake opens a count session for the branch, or for one category of it, and freezes the current
+// stock and incoming prices of its products. Only one session can be open in a branch at a time.
+// Serialized products are left out: their stock is corrected by stock adjustments that name the serials.
+func (s *StocktakesUseCase) StartStocktake(in *pb.StocktakeRequest) (*pb.Stocktake, error) {
+	if in == nil {
+		return nil, errors.New("stocktake request is nil")
+	}
+
+	res, err := s.repo.CreateStocktake(in)
+	if err != nil {
+		s.log.Error("Error starting stocktake", "branchID", in.BranchId, "error", err)
+		return nil, fmt.Errorf("error starting stocktake: %w", err)
+	}
+	return res, nil
+}
+
+// SubmitStocktakeCounts records one counting pass. Counts are added to what was counted before,
+// or replace it when the pass is a recount.
+func (s *StocktakesUseCase) SubmitStocktakeCounts(in *pb.SubmitStocktakeCountsReq) (*pb.Stocktake, error) {
+	if in == nil {
+		return nil, errors.New("stocktake counts request is nil")
+	}
+	if len(in.Items) == 0 {
+		return nil, errors.New("stocktake counts are empty")
+	}
+	for _, item := range in.Items {
+		if item.Quantity < 0 {
+			return nil, fmt.Errorf("invalid counted quantity for product %v: must not be negative", item.ProductId)
+		}
+	}
+
+	if err := s.repo.AddStocktakeCounts(in); err != nil {
+		s.log.Error("Error submitting stocktake counts", "stocktakeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error submitting stocktake counts: %w", err)
+	}
+
+	return s.GetStocktake(&pb.StocktakeID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId})
+}
+
+// GetStocktake retrieves a stocktake with its lines and their variances.
+func (s *StocktakesUseCase) GetStocktake(in *pb.StocktakeID) (*pb.Stocktake, error) {
+	if in == nil {
+		return nil, errors.New("stocktake ID request is nil")
+	}
+
+	res, err := s.repo.GetStocktake(in)
+	if err != nil {
+		s.log.Error("Error fetching stocktake", "stocktakeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching stocktake: %w", err)
+	}
+	return res, nil
+}
+
+// GetListStocktakes retrieves stocktakes based on filters.
+func (s *StocktakesUseCase) GetListStocktakes(in *pb.StocktakeFilter) (*pb.StocktakeList, error) {
+	if in == nil {
+		return nil, errors.New("stocktake filter request is nil")
+	}
+
+	res, err := s.repo.GetStocktakeList(in)
+	if err != nil {
+		s.log.Error("Error fetching stocktakes list", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching stocktakes list: %w", err)
+	}
+	return res, nil
+}
+
+// CloseStocktake moves every variance onto the stock as an adjustment and books the shrinkage,
+// valued at the cost the stock moved at, as an expense. Variances are measured against the stock
+// frozen at the start, so sales and purchases made during the count are kept.
+func (s *StocktakesUseCase) CloseStocktake(in *pb.CloseStocktakeReq) (*pb.Stocktake, error) {
+	if in == nil {
+		return nil, errors.New("close stocktake request is nil")
+	}
+
+	id := &pb.StocktakeID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	err := s.uow.Do(func(tx *TxRepos) error {
+		stocktake, err := tx.Stocktakes.GetStocktake(id)
+		if err != nil {
+			return fmt.Errorf("error fetching stocktake: %w", err)
+		}
+		if stocktake.Status != entity.StocktakeOpen {
+			return fmt.Errorf("stocktake is %s: only open stocktakes can be closed", stocktake.Status)
+		}
+
+		var adjustments []entity.StockAdjustment
+		for _, item := range stocktake.Items {
+			counted := item.CountedQuantity
+			if !item.Counted {
+				if !in.ZeroUncounted {
+					continue
+				}
+				counted = 0
+			}
+
+			if variance := counted - item.ExpectedQuantity; variance != 0 {
+				adjustments = append(adjustments, entity.StockAdjustment{ProductID: item.ProductId, Delta: variance})
+			}
+		}
+
+		// A product made serialized during the count cannot be adjusted without its serials
+		productIDs := make([]string, 0, len(adjustments))
+		for _, a := range adjustments {
+			productIDs = append(productIDs, a.ProductID)
+		}
+		serialized, err := serializedProducts(tx.Serials, productIDs)
+		if err != nil {
+			return err
+		}
+		for _, a := range adjustments {
+			if serialized[a.ProductID] {
+				return fmt.Errorf("product %v is serialized: correct its stock with a stock adjustment listing the serials", a.ProductID)
+			}
+		}
+
+		// The count is what is on the shelf, so the adjustment may take the stock below zero
+		err = tx.Product.AdjustStock(adjustments, entity.MovementInfo{
+			Reason:        entity.MovementAdjustment,
+			SourceID:      in.Id,
+			CreatedBy:     in.ClosedBy,
+			AllowNegative: true,
+		})
+		if err != nil {
+			return fmt.Errorf("error adjusting product stock: %w", err)
+		}
+
+		// Variances are valued at the cost the stock moved at, the frozen price covers a product without movements
+		costs, err := tx.Product.GetMovementCosts(in.Id)
+		if err != nil {
+			return fmt.Errorf("error fetching stocktake movement costs: %w", err)
+		}
+		frozen := make(map[string]float64, len(stocktake.Items))
+		for _, item := range stocktake.Items {
+			frozen[item.ProductId] = item.IncomingPrice
+		}
+
+		var shrinkage, surplus decimal.Decimal
+		for _, a := range adjustments {
+			cost, ok := costs[a.ProductID]
+			if !ok {
+				cost = frozen[a.ProductID]
+			}
+			value := decimal.NewFromInt(a.Delta).Mul(decimal.NewFromFloat(cost))
+			if a.Delta < 0 {
+				shrinkage = shrinkage.Sub(value)
+			} else {
+				surplus = surplus.Add(value)
+			}
+		}
+
+		shrinkageValue := shrinkage.Round(2).InexactFloat64()
+		if err = tx.Stocktakes.CloseStocktake(in, shrinkageValue, surplus.Round(2).InexactFloat64()); err != nil {
+			return fmt.Errorf("error closing stocktake: %w", err)
+		}
+
+		if shrinkageValue <= 0 {
+			return nil
+		}
+
+		paymentType, err := resolvePaymentTypes(tx.PaymentTypes, in.CompanyId, in.PaymentMethod, nil)
+		if err != nil {
+			return fmt.Errorf("error resolving payment types: %w", err)
+		}
+
+		cashFlow, err := tx.CashFlow.CreateExpense(&pb.CashFlowRequest{
+			UserId:        in.ClosedBy,
+			Amount:        shrinkageValue,
+			Description:   "Inventarizatsiya kamomadi",
+			PaymentMethod: paymentType.Name,
+			CompanyId:     in.CompanyId,
+			BranchId:      in.BranchId,
+			SourceType:    entity.CashSourceStocktake,
+			SourceId:      in.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating cash flow: %w", err)
+		}
+		if err = tx.Stocktakes.SetStocktakeCashFlow(in.Id, cashFlow.Id); err != nil {
+			return fmt.Errorf("error linking shrinkage expense to stocktake: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("Error closing stocktake", "stocktakeID", in.Id, "error", err)
+		return nil, err
+	}
+
+	return s.GetStocktake(id)
+}
+
+// CancelStocktake drops an open stocktake without touching the stock.
+func (s *StocktakesUseCase) CancelStocktake(in *pb.StocktakeID) (*pb.Stocktake, error) {
+	if in == nil {
+		return nil, errors.New("stocktake ID request is nil")
+	}
+
+	if err := s.repo.SetStocktakeStatus(in, entity.StocktakeCancelled, entity.StocktakeOpen); err != nil {
+		s.log.Error("Error cancelling stocktake", "stocktakeID", in.Id, "error", err)
+		return nil, fmt.Errorf("error cancelling stocktake: %w", err)
+	}
+
+	return s.GetStocktake(in)
+}
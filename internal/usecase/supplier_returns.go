@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"math"
+)
+
+type SupplierReturnsUseCase struct {
+	repo      SupplierReturnsRepo
+	purchases PurchasesRepo
+	uow       UnitOfWork
+	log       *slog.Logger
+}
+
+func NewSupplierReturnsUseCase(repo SupplierReturnsRepo, purchases PurchasesRepo, log *slog.Logger, uow UnitOfWork) *SupplierReturnsUseCase {
+	return &SupplierReturnsUseCase{
+		repo:      repo,
+		purchases: purchases,
+		uow:       uow,
+		log:       log,
+	}
+}
+
+// CalculateSupplierReturn checks the requested lines against the purchase and what was already returned
+// to the supplier, and prices every line at its purchase price. An empty item list returns everything still left on the purchase.
+func (s *SupplierReturnsUseCase) CalculateSupplierReturn(in *pb.SupplierReturnRequest) (*entity.SupplierReturnRequest, error) {
+	if in == nil {
+		return nil, errors.New("input supplier return request is nil")
+	}
+	return calculateSupplierReturn(s.purchases, s.repo, in)
+}
+
+// calculateSupplierReturn prices a supplier return from the purchase and the returns read through the given repositories.
+func calculateSupplierReturn(purchases PurchasesRepo, returns SupplierReturnsRepo, in *pb.SupplierReturnRequest) (*entity.SupplierReturnRequest, error) {
+
+	settlement := in.Settlement
+	if settlement == "" {
+		settlement = entity.SupplierRefund
+	}
+	if settlement != entity.SupplierRefund && settlement != entity.SupplierCredit {
+		return nil, fmt.Errorf("invalid settlement %q: must be %q or %q", in.Settlement, entity.SupplierRefund, entity.SupplierCredit)
+	}
+
+	purchase, err := purchases.GetPurchase(&pb.PurchaseID{Id: in.PurchaseId, CompanyId: in.CompanyId, BranchId: in.BranchId})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching purchase: %w", err)
+	}
+	if purchase.Id == "" {
+		return nil, fmt.Errorf("purchase %v not found", in.PurchaseId)
+	}
+	if purchase.Status == entity.DocumentVoided {
+		return nil, fmt.Errorf("purchase %v is voided", in.PurchaseId)
+	}
+
+	returned, err := returns.GetSupplierReturnedQuantities(in.PurchaseId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching returned quantities: %w", err)
+	}
+
+	purchaseItems := make(map[string]*pb.PurchaseItemResponse, len(purchase.Items))
+	for _, item := range purchase.Items {
+		purchaseItems[item.Id] = item
+	}
+
+	requested := make(map[string]int64)
+	serials := make(map[string][]string)
+	var order []string
+	if len(in.Items) == 0 {
+		for _, item := range purchase.Items {
+			if left := int64(item.Quantity) - returned[item.Id]; left > 0 {
+				requested[item.Id] = left
+				order = append(order, item.Id)
+			}
+		}
+	}
+	for _, item := range in.Items {
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("invalid return quantity for purchase item %v: must be positive", item.PurchaseItemId)
+		}
+		if _, ok := requested[item.PurchaseItemId]; !ok {
+			order = append(order, item.PurchaseItemId)
+		}
+		requested[item.PurchaseItemId] += int64(item.Quantity)
+		serials[item.PurchaseItemId] = append(serials[item.PurchaseItemId], item.Serials...)
+	}
+
+	if len(order) == 0 {
+		return nil, errors.New("nothing left to return for this purchase")
+	}
+
+	var totalRefund decimal.Decimal
+	var items []entity.SupplierReturnItem
+
+	for _, id := range order {
+		purchaseItem, ok := purchaseItems[id]
+		if !ok {
+			return nil, fmt.Errorf("purchase item %v does not belong to purchase %v", id, in.PurchaseId)
+		}
+
+		quantity := requested[id]
+		if left := int64(purchaseItem.Quantity) - returned[id]; quantity > left {
+			return nil, fmt.Errorf("cannot return %d of purchase item %v: only %d left", quantity, id, left)
+		}
+
+		lineSerials, err := pickLineSerials(purchaseItem.ProductId, purchaseItem.Serials, "purchase item "+id, quantity, returned[id], serials[id])
+		if err != nil {
+			return nil, err
+		}
+
+		totalItemPrice := decimal.NewFromInt(quantity).Mul(decimal.NewFromFloat(purchaseItem.PurchasePrice))
+		totalRefund = totalRefund.Add(totalItemPrice)
+
+		items = append(items, entity.SupplierReturnItem{
+			PurchaseItemID: id,
+			ProductID:      purchaseItem.ProductId,
+			Quantity:       quantity,
+			PurchasePrice:  purchaseItem.PurchasePrice,
+			TotalPrice:     math.Round(totalItemPrice.InexactFloat64()*100) / 100,
+			Serials:        lineSerials,
+		})
+	}
+
+	paymentMethod := in.PaymentMethod
+	if paymentMethod == "" {
+		paymentMethod = purchase.PaymentMethod
+	}
+
+	return &entity.SupplierReturnRequest{
+		PurchaseID:    in.PurchaseId,
+		SupplierID:    purchase.SupplierId,
+		ReturnedBy:    in.ReturnedBy,
+		TotalRefund:   math.Round(totalRefund.InexactFloat64()*100) / 100,
+		Settlement:    settlement,
+		PaymentMethod: paymentMethod,
+		Reason:        in.Reason,
+		CompanyID:     in.CompanyId,
+		BranchID:      in.BranchId,
+		Items:         items,
+	}, nil
+}
+
+// CreateSupplierReturn records goods sent back to the supplier and takes them off the stock.
+// A refund is booked as income; a credit only stays on the return and lowers what the supplier is owed in statistics.
+func (s *SupplierReturnsUseCase) CreateSupplierReturn(in *pb.SupplierReturnRequest) (*pb.SupplierReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("input supplier return request is nil")
+	}
+
+	var res *pb.SupplierReturnResponse
+	err := s.uow.Do(func(tx *TxRepos) error {
+		// The purchase stays locked until the return commits, so two returns cannot both take what is left on it
+		if err := tx.Purchases.LockPurchase(in.PurchaseId); err != nil {
+			return err
+		}
+
+		req, err := calculateSupplierReturn(tx.Purchases, tx.SupplierReturns, in)
+		if err != nil {
+			return fmt.Errorf("error calculating supplier return: %w", err)
+		}
+
+		paymentType, err := resolvePaymentTypes(tx.PaymentTypes, req.CompanyID, req.PaymentMethod, nil)
+		if err != nil {
+			return fmt.Errorf("error resolving payment types: %w", err)
+		}
+		req.PaymentMethod = paymentType.Name
+
+		// The goods have to be on hand to be sent back
+		var lines []entity.SalesItem
+		var stockItems []*pb.PurchaseItemResponse
+		for _, item := range req.Items {
+			lines = append(lines, entity.SalesItem{ProductID: item.ProductID, Quantity: item.Quantity})
+			stockItems = append(stockItems, &pb.PurchaseItemResponse{ProductId: item.ProductID, Quantity: int32(item.Quantity)})
+		}
+		shortages, err := tx.Product.CheckStock(lines)
+		if err != nil {
+			return fmt.Errorf("error checking product stock: %w", err)
+		}
+		if len(shortages) > 0 {
+			return &entity.ShortageError{Lines: shortages}
+		}
+
+		res, err = tx.SupplierReturns.CreateSupplierReturn(req)
+		if err != nil {
+			return fmt.Errorf("error creating supplier return: %w", err)
+		}
+
+		info := entity.MovementInfo{
+			Reason:      entity.MovementSupplierReturn,
+			SourceID:    res.Id,
+			CreatedBy:   req.ReturnedBy,
+			LotSourceID: req.PurchaseID,
+		}
+		if err = tx.Product.RemoveProductsPurchase(stockItems, info); err != nil {
+			return fmt.Errorf("error removing returned products from stock: %w", err)
+		}
+
+		// Only serials still in stock of the purchased product go back to the supplier
+		for _, item := range req.Items {
+			if err = tx.Serials.SetSerialStatus(item.ProductID, item.Serials, entity.SerialInStock, entity.SerialReturned, info); err != nil {
+				return fmt.Errorf("error returning serials to supplier: %w", err)
+			}
+		}
+
+		if req.Settlement != entity.SupplierRefund {
+			return nil
+		}
+
+		cashFlow, err := tx.CashFlow.CreateIncome(&pb.CashFlowRequest{
+			UserId:        req.ReturnedBy,
+			Amount:        req.TotalRefund,
+			Description:   "Mahsulot yetkazib beruvchiga qaytarildi",
+			PaymentMethod: req.PaymentMethod,
+			CompanyId:     req.CompanyID,
+			BranchId:      req.BranchID,
+			SourceType:    entity.CashSourceSupplierReturn,
+			SourceId:      res.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating cash flow: %w", err)
+		}
+		if err = tx.SupplierReturns.SetSupplierReturnCashFlow(res.Id, cashFlow.Id); err != nil {
+			return fmt.Errorf("error linking refund to supplier return: %w", err)
+		}
+		res.CashFlowId = cashFlow.Id
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("Error creating supplier return", "purchaseID", in.PurchaseId, "error", err)
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSupplierReturn retrieves a specific supplier return by ID.
+func (s *SupplierReturnsUseCase) GetSupplierReturn(in *pb.SupplierReturnID) (*pb.SupplierReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("supplier return ID request is nil")
+	}
+
+	res, err := s.repo.GetSupplierReturn(in)
+	if err != nil {
+		s.log.Error("Error fetching supplier return", "returnID", in.Id, "error", err)
+		return nil, fmt.Errorf("error fetching supplier return: %w", err)
+	}
+	return res, nil
+}
+
+// GetListSupplierReturns retrieves a list of supplier returns based on filters.
+func (s *SupplierReturnsUseCase) GetListSupplierReturns(in *pb.SupplierReturnFilter) (*pb.SupplierReturnList, error) {
+	if in == nil {
+		return nil, errors.New("supplier return filter request is nil")
+	}
+
+	res, err := s.repo.GetSupplierReturnList(in)
+	if err != nil {
+		s.log.Error("Error fetching supplier returns list", "filter", in, "error", err)
+		return nil, fmt.Errorf("error fetching supplier returns list: %w", err)
+	}
+	return res, nil
+}
+
+// VoidSupplierReturn cancels a supplier return in one transaction: the goods come back on stock, a refund is reversed
+// and the return rows are kept with the voided status for the audit history.
+func (s *SupplierReturnsUseCase) VoidSupplierReturn(in *pb.VoidSupplierReturnReq) (*pb.SupplierReturnResponse, error) {
+	if in == nil {
+		return nil, errors.New("void supplier return request is nil")
+	}
+
+	returnID := &pb.SupplierReturnID{Id: in.Id, CompanyId: in.CompanyId, BranchId: in.BranchId}
+
+	var res *pb.SupplierReturnResponse
+	err := s.uow.Do(func(tx *TxRepos) error {
+		ret, err := tx.SupplierReturns.GetSupplierReturn(returnID)
+		if err != nil {
+			return fmt.Errorf("error fetching supplier return: %w", err)
+		}
+		if ret.Status == entity.DocumentVoided {
+			return errors.New("supplier return is already voided")
+		}
+		// What is left to return on the purchase grows back, so new returns of the purchase wait for the void
+		if err = tx.Purchases.LockPurchase(ret.PurchaseId); err != nil {
+			return err
+		}
+
+		voidedBy := in.VoidedBy
+		if voidedBy == "" {
+			voidedBy = ret.ReturnedBy
+		}
+
+		for _, item := range ret.Items {
+			info := entity.MovementInfo{
+				Reason:      entity.MovementSupplierReturn,
+				SourceID:    in.Id,
+				CreatedBy:   voidedBy,
+				LotSourceID: ret.PurchaseId,
+			}
+			_, err := tx.Product.AddProduct(&entity.CountProductReq{
+				ID:       item.ProductId,
+				Count:    int(item.Quantity),
+				Movement: info,
+			})
+			if err != nil {
+				return fmt.Errorf("error restoring product stock: %w", err)
+			}
+			if err = tx.Serials.SetSerialStatus(item.ProductId, item.Serials, entity.SerialReturned, entity.SerialInStock, info); err != nil {
+				return fmt.Errorf("error restoring serials returned to supplier: %w", err)
+			}
+		}
+
+		if err = tx.SupplierReturns.VoidSupplierReturn(in); err != nil {
+			return fmt.Errorf("error voiding supplier return: %w", err)
+		}
+
+		_, err = tx.CashFlow.ReverseBySource(entity.CashSourceSupplierReturn, in.Id, voidedBy, fmt.Sprintf("Supplier return %s voided", in.Id))
+		if err != nil {
+			return fmt.Errorf("error reversing cash flow: %w", err)
+		}
+
+		res, err = tx.SupplierReturns.GetSupplierReturn(returnID)
+		if err != nil {
+			return fmt.Errorf("error fetching voided supplier return: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Error("Error voiding supplier return", "returnID", in.Id, "error", err)
+		return nil, err
+	}
+
+	s.log.Info("Voided supplier return and reversed its cash flow", "returnID", in.Id)
+	return res, nil
+}
+
+// DeleteSupplierReturn is kept for old clients: the supplier return is voided instead of being deleted.
+func (s *SupplierReturnsUseCase) DeleteSupplierReturn(in *pb.SupplierReturnID) (*pb.Message, error) {
+	if in == nil {
+		return nil, errors.New("supplier return ID request is nil")
+	}
+
+	_, err := s.VoidSupplierReturn(&pb.VoidSupplierReturnReq{
+		Id:        in.Id,
+		CompanyId: in.CompanyId,
+		BranchId:  in.BranchId,
+		Reason:    "deleted",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Message{Message: "Supplier return voided successfully"}, nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"crm-admin/internal/entity"
+	pb "crm-admin/internal/generated/products"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+const maxUnitNameLength = 16
+
+// wholeUnits are the units sold in fractions. Stock is counted in whole base units,
+// so they are only purchase and sale units over the smaller base unit named here.
+var wholeUnits = map[string]string{
+	"kg": "g", "l": "ml", "m": "cm",
+	"кг": "г", "л": "мл", "м": "см",
+}
+
+// normalizeUnits trims the base unit and the purchase and sale units of a product and checks them:
+// unit names are unique and differ from the base unit, every unit holds a whole number of base units.
+func normalizeUnits(baseUnit string, units []*pb.ProductUnit) (string, error) {
+	baseUnit = strings.TrimSpace(baseUnit)
+	if len(baseUnit) > maxUnitNameLength {
+		return "", fmt.Errorf("invalid base unit %q: longer than %d characters", baseUnit, maxUnitNameLength)
+	}
+
+	base := strings.ToLower(baseUnit)
+	if base == "" {
+		base = entity.DefaultBaseUnit
+	}
+	if smaller, ok := wholeUnits[base]; ok {
+		return "", fmt.Errorf("invalid base unit %s: count the stock in %s and add %s as a unit", baseUnit, smaller, baseUnit)
+	}
+	seen := map[string]bool{base: true}
+	for _, unit := range units {
+		if unit == nil {
+			return "", errors.New("product unit is nil")
+		}
+		unit.Name = strings.TrimSpace(unit.Name)
+		if unit.Name == "" {
+			return "", errors.New("product unit name must not be empty")
+		}
+		if len(unit.Name) > maxUnitNameLength {
+			return "", fmt.Errorf("invalid unit %q: longer than %d characters", unit.Name, maxUnitNameLength)
+		}
+		if unit.Factor <= 0 {
+			return "", fmt.Errorf("invalid factor for unit %s: must be a positive number of base units", unit.Name)
+		}
+		if seen[strings.ToLower(unit.Name)] {
+			return "", fmt.Errorf("duplicate unit %s", unit.Name)
+		}
+		seen[strings.ToLower(unit.Name)] = true
+	}
+
+	return baseUnit, nil
+}
+
+// unitFactors loads the unit factors of the products whose lines are entered in a unit.
+func unitFactors(repo ProductQuantity, productIDs []string) (map[string]map[string]int64, error) {
+	if len(productIDs) == 0 {
+		return map[string]map[string]int64{}, nil
+	}
+	factors, err := repo.GetUnitFactors(productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching product units: %w", err)
+	}
+	return factors, nil
+}
+
+// toBaseUnits converts a line entered in a product unit to the base unit: 2 boxes of 24 at 120 000 become
+// 48 pieces at 5 000, 1.25 kg of a product counted in grams becomes 1250 g. The quantity must come out
+// as a whole number of base units; the price is returned per base unit.
+func toBaseUnits(factors map[string]map[string]int64, productID, unit string, quantity, price float64) (int64, float64, error) {
+	if quantity <= 0 {
+		return 0, 0, fmt.Errorf("invalid quantity for product %v: must be positive", productID)
+	}
+
+	factor, ok := factors[productID][strings.ToLower(strings.TrimSpace(unit))]
+	if !ok {
+		return 0, 0, fmt.Errorf("product %v has no unit %q", productID, unit)
+	}
+
+	base := decimal.NewFromFloat(quantity).Mul(decimal.NewFromInt(factor))
+	if !base.IsInteger() {
+		return 0, 0, fmt.Errorf("invalid quantity %v %s for product %v: must be a whole number of base units", quantity, unit, productID)
+	}
+
+	basePrice := decimal.NewFromFloat(price).Div(decimal.NewFromInt(factor)).Round(6)
+	return base.IntPart(), basePrice.InexactFloat64(), nil
+}
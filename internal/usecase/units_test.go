@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	pb "crm-admin/internal/generated/products"
+	"testing"
+)
+
+func TestNormalizeUnits(t *testing.T) {
+	tests := []struct {
+		name      string
+		baseUnit  string
+		units     []*pb.ProductUnit
+		wantBase  string
+		wantUnits []string
+		wantErr   bool
+	}{
+		{
+			name:     "no units",
+			baseUnit: " pcs ",
+			wantBase: "pcs",
+		},
+		{
+			name:      "names are trimmed",
+			baseUnit:  "g",
+			units:     []*pb.ProductUnit{{Name: " kg ", Factor: 1000}, {Name: "pack", Factor: 250}},
+			wantBase:  "g",
+			wantUnits: []string{"kg", "pack"},
+		},
+		{
+			name:      "empty base unit keeps its default name free",
+			units:     []*pb.ProductUnit{{Name: "box", Factor: 24}},
+			wantUnits: []string{"box"},
+		},
+		{
+			name:    "unit named as the default base unit",
+			units:   []*pb.ProductUnit{{Name: "PCS", Factor: 10}},
+			wantErr: true,
+		},
+		{
+			name:     "unit named as the base unit",
+			baseUnit: "Box",
+			units:    []*pb.ProductUnit{{Name: "box", Factor: 1}},
+			wantErr:  true,
+		},
+		{
+			name:     "kilogram base unit",
+			baseUnit: "Kg",
+			wantErr:  true,
+		},
+		{
+			name:     "litre base unit",
+			baseUnit: "л",
+			wantErr:  true,
+		},
+		{
+			name:     "duplicate units differing in case",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{{Name: "Box", Factor: 12}, {Name: "box ", Factor: 24}},
+			wantErr:  true,
+		},
+		{
+			name:     "empty unit name",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{{Name: "  ", Factor: 12}},
+			wantErr:  true,
+		},
+		{
+			name:     "zero factor",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{{Name: "box", Factor: 0}},
+			wantErr:  true,
+		},
+		{
+			name:     "negative factor",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{{Name: "box", Factor: -6}},
+			wantErr:  true,
+		},
+		{
+			name:     "nil unit",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{nil},
+			wantErr:  true,
+		},
+		{
+			name:     "base unit too long",
+			baseUnit: "a very long base unit",
+			wantErr:  true,
+		},
+		{
+			name:     "unit name too long",
+			baseUnit: "pcs",
+			units:    []*pb.ProductUnit{{Name: "a very long unit name", Factor: 2}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeUnits(tt.baseUnit, tt.units)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeUnits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != tt.wantBase {
+				t.Errorf("normalizeUnits() base = %q, want %q", got, tt.wantBase)
+			}
+			for i, unit := range tt.units {
+				if unit.Name != tt.wantUnits[i] {
+					t.Errorf("normalizeUnits() unit %d = %q, want %q", i, unit.Name, tt.wantUnits[i])
+				}
+			}
+		})
+	}
+}
+
+func TestToBaseUnits(t *testing.T) {
+	factors := map[string]map[string]int64{
+		"water": {"pcs": 1, "box": 24},
+		"flour": {"g": 1, "kg": 1000},
+	}
+
+	tests := []struct {
+		name         string
+		productID    string
+		unit         string
+		quantity     float64
+		price        float64
+		wantQuantity int64
+		wantPrice    float64
+		wantErr      bool
+	}{
+		{
+			name:         "base unit",
+			productID:    "water",
+			unit:         "pcs",
+			quantity:     5,
+			price:        5000,
+			wantQuantity: 5,
+			wantPrice:    5000,
+		},
+		{
+			name:         "boxes become pieces",
+			productID:    "water",
+			unit:         "box",
+			quantity:     2,
+			price:        120000,
+			wantQuantity: 48,
+			wantPrice:    5000,
+		},
+		{
+			name:         "unit name is matched ignoring case and spaces",
+			productID:    "water",
+			unit:         " Box ",
+			quantity:     1,
+			price:        120000,
+			wantQuantity: 24,
+			wantPrice:    5000,
+		},
+		{
+			name:         "fractional quantity of a larger unit",
+			productID:    "flour",
+			unit:         "kg",
+			quantity:     1.25,
+			price:        9000,
+			wantQuantity: 1250,
+			wantPrice:    9,
+		},
+		{
+			name:         "base price is rounded to six places",
+			productID:    "water",
+			unit:         "box",
+			quantity:     1,
+			price:        100,
+			wantQuantity: 24,
+			wantPrice:    4.166667,
+		},
+		{
+			name:      "not a whole number of base units",
+			productID: "water",
+			unit:      "box",
+			quantity:  0.5001,
+			price:     120000,
+			wantErr:   true,
+		},
+		{
+			name:      "fraction of the base unit",
+			productID: "water",
+			unit:      "pcs",
+			quantity:  1.5,
+			price:     5000,
+			wantErr:   true,
+		},
+		{
+			name:      "unknown unit",
+			productID: "water",
+			unit:      "kg",
+			quantity:  1,
+			price:     5000,
+			wantErr:   true,
+		},
+		{
+			name:      "unknown product",
+			productID: "sugar",
+			unit:      "pcs",
+			quantity:  1,
+			price:     5000,
+			wantErr:   true,
+		},
+		{
+			name:      "zero quantity",
+			productID: "water",
+			unit:      "box",
+			quantity:  0,
+			price:     120000,
+			wantErr:   true,
+		},
+		{
+			name:      "negative quantity",
+			productID: "water",
+			unit:      "box",
+			quantity:  -1,
+			price:     120000,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quantity, price, err := toBaseUnits(factors, tt.productID, tt.unit, tt.quantity, tt.price)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toBaseUnits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if quantity != tt.wantQuantity || price != tt.wantPrice {
+				t.Errorf("toBaseUnits() = %v, %v, want %v, %v", quantity, price, tt.wantQuantity, tt.wantPrice)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package webapi
+
+import (
+	"context"
+	"crm-admin/internal/generated/debts"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// NewDebtsStub returns a stand-in for the debts service, used when DEBTS_SERVICE is not configured.
+func NewDebtsStub(log *slog.Logger) *DebtsStub {
+	return &DebtsStub{log: log, debts: make(map[string]*debts.Debts)}
+}
+
+// DebtsStub answers like the debts service for local runs, keeping the debts in memory.
+type DebtsStub struct {
+	log *slog.Logger
+
+	mu    sync.Mutex
+	debts map[string]*debts.Debts
+}
+
+func (d *DebtsStub) AddClient(ctx context.Context, in *debts.CreateClients, opts ...grpc.CallOption) (*debts.Client, error) {
+	d.log.Info("Debts stub: client added", "fullName", in.FullName, "phone", in.PhoneNumber)
+
+	return &debts.Client{
+		Id:          uuid.NewString(),
+		FullName:    in.FullName,
+		PhoneNumber: in.PhoneNumber,
+		Address:     in.Address,
+		Notes:       in.Notes,
+		CreatedAt:   time.Now().Format(time.DateTime),
+	}, nil
+}
+
+func (d *DebtsStub) CreateDebts(ctx context.Context, in *debts.DebtsRequest, opts ...grpc.CallOption) (*debts.Debts, error) {
+	d.log.Info("Debts stub: debt created", "clientID", in.ClientId, "saleID", in.SaleId, "amount", in.TotalAmount)
+
+	debt := &debts.Debts{
+		Id:            uuid.NewString(),
+		ClientId:      in.ClientId,
+		SaleId:        in.SaleId,
+		TotalAmount:   in.TotalAmount,
+		BalanceOfDebt: in.TotalAmount,
+		CurrencyCode:  in.CurrencyCode,
+		DebtType:      in.DebtType,
+		ShouldPayAt:   in.ShouldPayAt,
+		CompanyId:     in.CompanyId,
+		CreatedAt:     time.Now().Format(time.DateTime),
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.debts[debt.Id] = debt
+
+	return proto.Clone(debt).(*debts.Debts), nil
+}
+
+func (d *DebtsStub) GetDebts(ctx context.Context, in *debts.DebtsID, opts ...grpc.CallOption) (*debts.Debts, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	debt, ok := d.debts[in.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "debt %s not found", in.Id)
+	}
+	return proto.Clone(debt).(*debts.Debts), nil
+}
+
+func (d *DebtsStub) PayDebts(ctx context.Context, in *debts.PayDebtsReq, opts ...grpc.CallOption) (*debts.Debts, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	debt, ok := d.debts[in.DebtId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "debt %s not found", in.DebtId)
+	}
+	if in.PaidAmount > debt.BalanceOfDebt {
+		return nil, status.Errorf(codes.InvalidArgument, "payment %.2f exceeds debt balance %.2f", in.PaidAmount, debt.BalanceOfDebt)
+	}
+
+	debt.AmountPaid += in.PaidAmount
+	debt.BalanceOfDebt -= in.PaidAmount
+	debt.IsFullyPaid = debt.BalanceOfDebt <= 0
+	debt.LastPaymentDate = time.Now().Format(time.DateTime)
+	d.log.Info("Debts stub: debt paid", "debtID", in.DebtId, "payType", in.PayType, "amount", in.PaidAmount)
+
+	return proto.Clone(debt).(*debts.Debts), nil
+}
@@ -0,0 +1,24 @@
+package webapi
+
+import (
+	"context"
+	"crm-admin/internal/generated/company"
+	"google.golang.org/grpc"
+	"log/slog"
+)
+
+// NewSMSStub returns a stand-in for the SMS sending of the company service, used when COMPANY_SERVICE is not configured.
+func NewSMSStub(log *slog.Logger) *SMSStub {
+	return &SMSStub{log: log}
+}
+
+// SMSStub logs messages instead of sending them, for local runs.
+type SMSStub struct {
+	log *slog.Logger
+}
+
+func (s *SMSStub) SendSMS(ctx context.Context, in *company.SmsRequest, opts ...grpc.CallOption) (*company.Message, error) {
+	s.log.Info("SMS stub: message sent", "phone", in.Phone, "companyID", in.CompanyId, "message", in.Message)
+
+	return &company.Message{Message: "sent"}, nil
+}
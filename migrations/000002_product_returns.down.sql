@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_return_items;
+DROP TABLE IF EXISTS product_returns;
@@ -0,0 +1,38 @@
+-- Таблица возвратов товаров от клиентов
+CREATE TABLE product_returns
+(
+    id             UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    sale_id        UUID REFERENCES sales (id)     NOT NULL, -- Продажа, по которой оформлен возврат
+    client_id      UUID                           NOT NULL,
+    returned_by    UUID                           NOT NULL, -- Кто оформил возврат
+    total_refund   DECIMAL(15, 2)                 NOT NULL, -- Сумма, возвращённая клиенту
+    payment_method payment_method DEFAULT 'uzs'   NOT NULL,
+    reason         TEXT           DEFAULT ''      NOT NULL,
+    cash_flow_id   UUID REFERENCES cash_flow (id),          -- Расход, которым проведён возврат денег
+    branch_id      UUID                           NOT NULL,
+    company_id     UUID                           NOT NULL,
+    created_at     TIMESTAMP      DEFAULT NOW()
+);
+
+-- Таблица возвращённых позиций продажи
+CREATE TABLE product_return_items
+(
+    id           UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    return_id    UUID REFERENCES product_returns (id) NOT NULL,
+    sale_item_id UUID REFERENCES sales_items (id)     NOT NULL,
+    product_id   UUID REFERENCES products (id)        NOT NULL,
+    quantity     INT                                  NOT NULL CHECK (quantity > 0),
+    refund_price DECIMAL(15, 2)                       NOT NULL, -- Цена возврата за единицу товара
+    total_price  DECIMAL(15, 2)                       NOT NULL,
+    branch_id    UUID                                 NOT NULL,
+    company_id   UUID                                 NOT NULL
+);
+
+-- Индексы для таблицы product_returns
+CREATE INDEX idx_product_returns_company_branch ON product_returns (company_id, branch_id);
+CREATE INDEX idx_product_returns_sale_id ON product_returns (sale_id);
+CREATE INDEX idx_product_returns_created_at ON product_returns (created_at);
+
+-- Индексы для таблицы product_return_items
+CREATE INDEX idx_product_return_items_return_id ON product_return_items (return_id);
+CREATE INDEX idx_product_return_items_sale_item_id ON product_return_items (sale_item_id);
@@ -0,0 +1,34 @@
+-- Журнал движения товаров: каждая запись фиксирует одно изменение products.total_count
+CREATE TABLE inventory_movements
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    product_id UUID                          NOT NULL, -- Без внешнего ключа: история сохраняется после удаления товара
+    branch_id  UUID                          NOT NULL,
+    company_id UUID                          NOT NULL,
+    delta      INT                           NOT NULL, -- Изменение количества (+ приход, - расход)
+    balance    INT                           NOT NULL, -- Остаток после изменения
+    reason     VARCHAR(20)                   NOT NULL, -- sale, purchase, transfer_in, transfer_out, adjustment, return
+    source_id  UUID,                                   -- Документ, вызвавший изменение (продажа, закупка, перемещение, возврат)
+    created_by UUID,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Журнал только дополняется: изменять и удалять записи нельзя
+CREATE FUNCTION inventory_movements_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'inventory_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_inventory_movements_append_only
+    BEFORE UPDATE OR DELETE
+    ON inventory_movements
+    FOR EACH ROW
+EXECUTE FUNCTION inventory_movements_append_only();
+
+-- Индексы для таблицы inventory_movements
+CREATE INDEX idx_inventory_movements_company_branch ON inventory_movements (company_id, branch_id);
+CREATE INDEX idx_inventory_movements_product_id ON inventory_movements (product_id, created_at);
+CREATE INDEX idx_inventory_movements_source_id ON inventory_movements (source_id);
+CREATE INDEX idx_inventory_movements_created_at ON inventory_movements (created_at);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS stock_shortages;
+
+-- Проверка возвращается только для новых изменений: уже ушедшие в минус остатки не трогаются
+ALTER TABLE products
+    ADD CONSTRAINT products_total_count_check CHECK (total_count >= 0) NOT VALID;
+
+DROP TABLE IF EXISTS company_settings;
@@ -0,0 +1,31 @@
+-- Настройки компании. Отсутствие строки означает настройки по умолчанию
+CREATE TABLE company_settings
+(
+    company_id            UUID PRIMARY KEY,
+    negative_stock_policy VARCHAR(20) DEFAULT 'strict' NOT NULL
+        CHECK (negative_stock_policy IN ('strict', 'warning')), -- strict: продажа сверх остатка запрещена, warning: разрешена с записью нехватки
+    updated_at            TIMESTAMP   DEFAULT NOW()
+);
+
+-- Остаток может уйти в минус в режиме warning, поэтому проверка переносится в приложение
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_total_count_check;
+
+-- Нехватка товара, зафиксированная при продаже сверх остатка
+CREATE TABLE stock_shortages
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    product_id UUID NOT NULL,
+    sale_id    UUID,
+    requested  INT  NOT NULL, -- Сколько продано
+    available  INT  NOT NULL, -- Сколько было на складе
+    shortage   INT  NOT NULL, -- Сколько не хватило
+    created_by UUID,
+    branch_id  UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Индексы для таблицы stock_shortages
+CREATE INDEX idx_stock_shortages_company_branch ON stock_shortages (company_id, branch_id);
+CREATE INDEX idx_stock_shortages_product_id ON stock_shortages (product_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS purchase_payments;
+DROP TABLE IF EXISTS sale_payments;
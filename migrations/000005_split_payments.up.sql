@@ -0,0 +1,44 @@
+-- Оплаты продажи: покупатель может платить частями разными способами
+CREATE TABLE sale_payments
+(
+    id             UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    sale_id        UUID REFERENCES sales (id) ON DELETE CASCADE NOT NULL,
+    amount         DECIMAL(15, 2)                               NOT NULL CHECK (amount > 0),
+    payment_method payment_method                               NOT NULL,
+    currency       VARCHAR(3)     DEFAULT 'uzs'                 NOT NULL,
+    cash_flow_id   UUID REFERENCES cash_flow (id),
+    branch_id      UUID                                         NOT NULL,
+    company_id     UUID                                         NOT NULL,
+    created_at     TIMESTAMP      DEFAULT NOW()
+);
+
+-- Оплаты закупки
+CREATE TABLE purchase_payments
+(
+    id             UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    purchase_id    UUID REFERENCES purchases (id) ON DELETE CASCADE NOT NULL,
+    amount         DECIMAL(15, 2)                                   NOT NULL CHECK (amount > 0),
+    payment_method payment_method                                   NOT NULL,
+    currency       VARCHAR(3)     DEFAULT 'uzs'                     NOT NULL,
+    cash_flow_id   UUID REFERENCES cash_flow (id),
+    branch_id      UUID                                             NOT NULL,
+    company_id     UUID                                             NOT NULL,
+    created_at     TIMESTAMP      DEFAULT NOW()
+);
+
+-- Существующие документы получают одну оплату на всю сумму
+INSERT INTO sale_payments (sale_id, amount, payment_method, currency, branch_id, company_id, created_at)
+SELECT id, paid_amount, COALESCE(payment_method, 'uzs'), CASE WHEN payment_method = 'usd' THEN 'usd' ELSE 'uzs' END,
+       branch_id, company_id, created_at
+FROM sales
+WHERE paid_amount > 0;
+
+INSERT INTO purchase_payments (purchase_id, amount, payment_method, currency, branch_id, company_id, created_at)
+SELECT id, total_cost, COALESCE(payment_method, 'uzs'), CASE WHEN payment_method = 'usd' THEN 'usd' ELSE 'uzs' END,
+       branch_id, company_id, created_at
+FROM purchases
+WHERE total_cost > 0;
+
+-- Индексы для таблиц оплат
+CREATE INDEX idx_sale_payments_sale_id ON sale_payments (sale_id);
+CREATE INDEX idx_purchase_payments_purchase_id ON purchase_payments (purchase_id);
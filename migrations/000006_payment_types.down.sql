@@ -0,0 +1,31 @@
+CREATE TYPE payment_method AS ENUM ('uzs', 'usd', 'card');
+
+ALTER TABLE sales ADD COLUMN payment_method payment_method DEFAULT 'uzs';
+ALTER TABLE purchases ADD COLUMN payment_method payment_method DEFAULT 'uzs' NOT NULL;
+ALTER TABLE cash_flow ADD COLUMN payment_method payment_method DEFAULT 'uzs';
+ALTER TABLE product_returns ADD COLUMN payment_method payment_method DEFAULT 'uzs' NOT NULL;
+ALTER TABLE sale_payments ADD COLUMN payment_method payment_method DEFAULT 'uzs' NOT NULL;
+ALTER TABLE purchase_payments ADD COLUMN payment_method payment_method DEFAULT 'uzs' NOT NULL;
+
+-- Способы, которых нет в перечислении, становятся 'card' или 'uzs' в зависимости от наличности
+UPDATE sales t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+UPDATE purchases t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+UPDATE cash_flow t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+UPDATE product_returns t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+UPDATE sale_payments t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+UPDATE purchase_payments t SET payment_method = CASE WHEN pt.name IN ('uzs', 'usd', 'card') THEN pt.name::payment_method
+    WHEN pt.is_cash THEN 'uzs' ELSE 'card' END FROM payment_types pt WHERE pt.id = t.payment_type_id;
+
+ALTER TABLE sales DROP COLUMN payment_type_id;
+ALTER TABLE purchases DROP COLUMN payment_type_id;
+ALTER TABLE cash_flow DROP COLUMN payment_type_id;
+ALTER TABLE product_returns DROP COLUMN payment_type_id;
+ALTER TABLE sale_payments DROP COLUMN payment_type_id;
+ALTER TABLE purchase_payments DROP COLUMN payment_type_id;
+
+DROP TABLE IF EXISTS payment_types;
@@ -0,0 +1,53 @@
+-- Способы оплаты компании (наличные, карта, Click, Payme, перечисление и т.д.)
+CREATE TABLE payment_types
+(
+    id         UUID        DEFAULT gen_random_uuid() PRIMARY KEY,
+    name       VARCHAR(50)                    NOT NULL,
+    currency   VARCHAR(3)  DEFAULT 'uzs'      NOT NULL, -- Валюта, в которой принимается оплата
+    is_cash    BOOLEAN     DEFAULT FALSE      NOT NULL, -- Наличные попадают в кассу
+    active     BOOLEAN     DEFAULT TRUE       NOT NULL, -- Неактивный способ нельзя выбрать в новых документах
+    company_id UUID                           NOT NULL,
+    created_at TIMESTAMP   DEFAULT NOW(),
+    UNIQUE (company_id, name)
+);
+
+CREATE INDEX idx_payment_types_company_id ON payment_types (company_id);
+
+-- Каждая существующая компания получает три способа оплаты вместо значений перечисления
+INSERT INTO payment_types (name, currency, is_cash, company_id)
+SELECT t.name, t.currency, t.is_cash, c.company_id
+FROM (SELECT company_id FROM sales
+      UNION SELECT company_id FROM purchases
+      UNION SELECT company_id FROM cash_flow
+      UNION SELECT company_id FROM products) c
+CROSS JOIN (VALUES ('uzs', 'uzs', TRUE), ('usd', 'usd', TRUE), ('card', 'uzs', FALSE)) AS t (name, currency, is_cash);
+
+-- Документы ссылаются на способ оплаты вместо перечисления
+ALTER TABLE sales ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+ALTER TABLE purchases ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+ALTER TABLE cash_flow ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+ALTER TABLE product_returns ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+ALTER TABLE sale_payments ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+ALTER TABLE purchase_payments ADD COLUMN payment_type_id UUID REFERENCES payment_types (id);
+
+UPDATE sales t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = COALESCE(t.payment_method::TEXT, 'uzs');
+UPDATE purchases t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = COALESCE(t.payment_method::TEXT, 'uzs');
+UPDATE cash_flow t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = COALESCE(t.payment_method::TEXT, 'uzs');
+UPDATE product_returns t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = COALESCE(t.payment_method::TEXT, 'uzs');
+UPDATE sale_payments t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = t.payment_method::TEXT;
+UPDATE purchase_payments t SET payment_type_id = pt.id FROM payment_types pt
+WHERE pt.company_id = t.company_id AND pt.name = t.payment_method::TEXT;
+
+ALTER TABLE sales ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+ALTER TABLE purchases ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+ALTER TABLE cash_flow ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+ALTER TABLE product_returns ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+ALTER TABLE sale_payments ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+ALTER TABLE purchase_payments ALTER COLUMN payment_type_id SET NOT NULL, DROP COLUMN payment_method;
+
+DROP TYPE IF EXISTS payment_method;
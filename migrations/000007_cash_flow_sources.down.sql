@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_cash_flow_source;
+
+ALTER TABLE cash_flow
+    DROP COLUMN IF EXISTS reversal_of,
+    DROP COLUMN IF EXISTS source_id,
+    DROP COLUMN IF EXISTS source_type;
@@ -0,0 +1,17 @@
+-- Документ, которым создана запись денежного потока
+ALTER TABLE cash_flow
+    ADD COLUMN source_type VARCHAR(20) CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer')),
+    ADD COLUMN source_id   UUID,
+    ADD COLUMN reversal_of UUID UNIQUE REFERENCES cash_flow (id); -- Сторно: запись с отрицательной суммой, отменяющая указанную
+
+-- Существующие записи связываются с документами через их оплаты
+UPDATE cash_flow cf SET source_type = 'sale', source_id = sp.sale_id
+FROM sale_payments sp WHERE sp.cash_flow_id = cf.id;
+
+UPDATE cash_flow cf SET source_type = 'purchase', source_id = pp.purchase_id
+FROM purchase_payments pp WHERE pp.cash_flow_id = cf.id;
+
+UPDATE cash_flow cf SET source_type = 'return', source_id = r.id
+FROM product_returns r WHERE r.cash_flow_id = cf.id;
+
+CREATE INDEX idx_cash_flow_source ON cash_flow (source_type, source_id);
@@ -0,0 +1,20 @@
+DROP INDEX IF EXISTS idx_purchases_status;
+DROP INDEX IF EXISTS idx_sales_status;
+
+-- Аннулированные документы удаляются, как это делалось до введения статусов
+DELETE FROM sales_items WHERE sale_id IN (SELECT id FROM sales WHERE status = 'voided');
+DELETE FROM sales WHERE status = 'voided';
+DELETE FROM purchase_items WHERE purchase_id IN (SELECT id FROM purchases WHERE status = 'voided');
+DELETE FROM purchases WHERE status = 'voided';
+
+ALTER TABLE sales
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS status;
+
+ALTER TABLE purchases
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,15 @@
+-- Продажи и закупки не удаляются, а аннулируются: строки остаются для истории и статистики прошлых периодов
+ALTER TABLE sales
+    ADD COLUMN status      VARCHAR(20) DEFAULT 'completed' NOT NULL CHECK (status IN ('completed', 'voided')),
+    ADD COLUMN voided_at   TIMESTAMP,
+    ADD COLUMN voided_by   UUID,
+    ADD COLUMN void_reason TEXT DEFAULT '' NOT NULL;
+
+ALTER TABLE purchases
+    ADD COLUMN status      VARCHAR(20) DEFAULT 'completed' NOT NULL CHECK (status IN ('completed', 'voided')),
+    ADD COLUMN voided_at   TIMESTAMP,
+    ADD COLUMN voided_by   UUID,
+    ADD COLUMN void_reason TEXT DEFAULT '' NOT NULL;
+
+CREATE INDEX idx_sales_status ON sales (company_id, branch_id, status);
+CREATE INDEX idx_purchases_status ON purchases (company_id, branch_id, status);
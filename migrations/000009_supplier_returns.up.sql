@@ -0,0 +1,45 @@
+-- Таблица возвратов закупленных товаров поставщику
+CREATE TABLE supplier_returns
+(
+    id              UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    purchase_id     UUID REFERENCES purchases (id)     NOT NULL, -- Закупка, по которой оформлен возврат
+    supplier_id     UUID                               NOT NULL,
+    returned_by     UUID                               NOT NULL, -- Кто оформил возврат
+    total_refund    DECIMAL(15, 2)                     NOT NULL, -- Сумма, которую должен вернуть поставщик
+    settlement      VARCHAR(20)    DEFAULT 'refund'    NOT NULL CHECK (settlement IN ('refund', 'credit')), -- refund: поставщик вернул деньги, credit: сумма остаётся в счёт будущих закупок
+    payment_type_id UUID REFERENCES payment_types (id) NOT NULL,
+    reason          TEXT           DEFAULT ''          NOT NULL,
+    cash_flow_id    UUID REFERENCES cash_flow (id),              -- Приход, которым проведён возврат денег
+    branch_id       UUID                               NOT NULL,
+    company_id      UUID                               NOT NULL,
+    created_at      TIMESTAMP      DEFAULT NOW()
+);
+
+-- Таблица возвращённых позиций закупки
+CREATE TABLE supplier_return_items
+(
+    id               UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    return_id        UUID REFERENCES supplier_returns (id) NOT NULL,
+    purchase_item_id UUID REFERENCES purchase_items (id)   NOT NULL,
+    product_id       UUID REFERENCES products (id)         NOT NULL,
+    quantity         INT                                   NOT NULL CHECK (quantity > 0),
+    purchase_price   DECIMAL(15, 2)                        NOT NULL, -- Цена закупки за единицу товара
+    total_price      DECIMAL(15, 2)                        NOT NULL,
+    branch_id        UUID                                  NOT NULL,
+    company_id       UUID                                  NOT NULL
+);
+
+-- Приход денег от поставщика ссылается на возврат поставщику
+ALTER TABLE cash_flow DROP CONSTRAINT cash_flow_source_type_check;
+ALTER TABLE cash_flow ADD CONSTRAINT cash_flow_source_type_check
+    CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer', 'supplier_return'));
+
+-- Индексы для таблицы supplier_returns
+CREATE INDEX idx_supplier_returns_company_branch ON supplier_returns (company_id, branch_id);
+CREATE INDEX idx_supplier_returns_purchase_id ON supplier_returns (purchase_id);
+CREATE INDEX idx_supplier_returns_supplier_id ON supplier_returns (supplier_id);
+CREATE INDEX idx_supplier_returns_created_at ON supplier_returns (created_at);
+
+-- Индексы для таблицы supplier_return_items
+CREATE INDEX idx_supplier_return_items_return_id ON supplier_return_items (return_id);
+CREATE INDEX idx_supplier_return_items_purchase_item_id ON supplier_return_items (purchase_item_id);
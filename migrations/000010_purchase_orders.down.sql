@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_purchases_purchase_order_id;
+ALTER TABLE purchases DROP COLUMN IF EXISTS purchase_order_id;
+
+DROP TABLE IF EXISTS purchase_order_items;
+DROP TABLE IF EXISTS purchase_orders;
@@ -0,0 +1,43 @@
+-- Заказы поставщикам: товар заказывается сейчас, а приходуется закупками по мере поступления
+CREATE TABLE purchase_orders
+(
+    id          UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    supplier_id UUID                          NOT NULL,
+    ordered_by  UUID                          NOT NULL, -- Кто оформил заказ
+    status      VARCHAR(20)    DEFAULT 'draft' NOT NULL
+        CHECK (status IN ('draft', 'sent', 'partially_received', 'received', 'cancelled')),
+    expected_at DATE,                                   -- Ожидаемая дата поставки
+    description TEXT           DEFAULT ''     NOT NULL,
+    total_cost  DECIMAL(15, 2)                NOT NULL, -- Сумма заказа по ценам заказа
+    branch_id   UUID                          NOT NULL,
+    company_id  UUID                          NOT NULL,
+    created_at  TIMESTAMP      DEFAULT NOW(),
+    updated_at  TIMESTAMP      DEFAULT NOW()
+);
+
+-- Позиции заказа поставщику
+CREATE TABLE purchase_order_items
+(
+    id                UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    order_id          UUID REFERENCES purchase_orders (id) NOT NULL,
+    product_id        UUID REFERENCES products (id)        NOT NULL,
+    quantity          INT                                  NOT NULL CHECK (quantity > 0), -- Заказанное количество
+    received_quantity INT  DEFAULT 0                       NOT NULL,                      -- Уже оприходованное количество
+    purchase_price    DECIMAL(15, 2)                       NOT NULL,
+    total_price       DECIMAL(15, 2)                       NOT NULL,
+    branch_id         UUID                                 NOT NULL,
+    company_id        UUID                                 NOT NULL,
+    CHECK (received_quantity BETWEEN 0 AND quantity)
+);
+
+-- Закупка, созданная приёмкой заказа, ссылается на заказ
+ALTER TABLE purchases ADD COLUMN purchase_order_id UUID REFERENCES purchase_orders (id);
+
+-- Индексы для таблицы purchase_orders
+CREATE INDEX idx_purchase_orders_company_branch ON purchase_orders (company_id, branch_id, status);
+CREATE INDEX idx_purchase_orders_supplier_id ON purchase_orders (supplier_id);
+CREATE INDEX idx_purchase_orders_created_at ON purchase_orders (created_at);
+
+-- Индексы для таблицы purchase_order_items
+CREATE INDEX idx_purchase_order_items_order_id ON purchase_order_items (order_id);
+CREATE INDEX idx_purchases_purchase_order_id ON purchases (purchase_order_id);
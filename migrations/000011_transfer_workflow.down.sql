@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_transfers_status;
+
+ALTER TABLE transfer_products
+    DROP COLUMN IF EXISTS discrepancy_note,
+    DROP COLUMN IF EXISTS received_quantity;
+
+ALTER TABLE transfers
+    DROP COLUMN IF EXISTS cancel_reason,
+    DROP COLUMN IF EXISTS cancelled_at,
+    DROP COLUMN IF EXISTS received_by,
+    DROP COLUMN IF EXISTS received_at,
+    DROP COLUMN IF EXISTS dispatched_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,22 @@
+-- Перемещение проходит этапы: товар списывается с исходного филиала при отправке и приходуется в целевом при приёмке
+ALTER TABLE transfers
+    ADD COLUMN status        VARCHAR(20) DEFAULT 'received' NOT NULL
+        CHECK (status IN ('draft', 'dispatched', 'in_transit', 'received', 'partially_received', 'cancelled')),
+    ADD COLUMN dispatched_at TIMESTAMP,
+    ADD COLUMN received_at   TIMESTAMP,
+    ADD COLUMN received_by   UUID,
+    ADD COLUMN cancelled_at  TIMESTAMP,
+    ADD COLUMN cancel_reason TEXT DEFAULT '' NOT NULL;
+
+-- Существующие перемещения выполнялись мгновенно, поэтому считаются полученными
+UPDATE transfers SET dispatched_at = created_at, received_at = created_at, received_by = transferred_by;
+ALTER TABLE transfers ALTER COLUMN status SET DEFAULT 'draft';
+
+-- Принятое количество и расхождение по каждой позиции
+ALTER TABLE transfer_products
+    ADD COLUMN received_quantity INT CHECK (received_quantity BETWEEN 0 AND quantity), -- NULL, пока позиция не принята
+    ADD COLUMN discrepancy_note  TEXT DEFAULT '' NOT NULL;
+
+UPDATE transfer_products SET received_quantity = quantity;
+
+CREATE INDEX idx_transfers_status ON transfers (company_id, status);
@@ -0,0 +1,12 @@
+-- Слитые дубликаты товаров не восстанавливаются: их остатки и документы остаются на объединённой строке
+DROP INDEX IF EXISTS idx_transfer_products_catalog_id;
+DROP INDEX IF EXISTS idx_purchase_items_catalog_id;
+DROP INDEX IF EXISTS idx_sales_items_catalog_id;
+DROP INDEX IF EXISTS idx_products_catalog_branch;
+
+ALTER TABLE transfer_products DROP COLUMN IF EXISTS catalog_id;
+ALTER TABLE purchase_items DROP COLUMN IF EXISTS catalog_id;
+ALTER TABLE sales_items DROP COLUMN IF EXISTS catalog_id;
+ALTER TABLE products DROP COLUMN IF EXISTS catalog_id;
+
+DROP TABLE IF EXISTS catalog_items;
@@ -0,0 +1,108 @@
+-- Карточка товара на уровне компании. Строка products остаётся остатком и ценой товара в конкретном филиале
+CREATE TABLE catalog_items
+(
+    id          UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    name        VARCHAR(150)                 NOT NULL,
+    image_url   VARCHAR   DEFAULT 'no image' NOT NULL,
+    bill_format VARCHAR(5)                   NOT NULL,
+    company_id  UUID                         NOT NULL,
+    created_by  UUID                         NOT NULL,
+    created_at  TIMESTAMP DEFAULT NOW()
+);
+
+ALTER TABLE products
+    ADD COLUMN catalog_id UUID REFERENCES catalog_items (id);
+
+-- Один и тот же товар определяется по названию и цене продажи внутри компании.
+-- Карточкой группы становится самый ранний товар, его id переходит в catalog_items
+CREATE TEMPORARY TABLE product_catalog_map AS
+SELECT id AS product_id,
+       branch_id,
+       company_id,
+       total_count,
+       created_at,
+       FIRST_VALUE(id) OVER (PARTITION BY company_id, LOWER(TRIM(name)), standard_price ORDER BY created_at, id) AS catalog_id
+FROM products;
+
+INSERT INTO catalog_items (id, name, image_url, bill_format, company_id, created_by, created_at)
+SELECT p.id, p.name, p.image_url, p.bill_format, p.company_id, p.created_by, COALESCE(p.created_at, NOW())
+FROM products p
+WHERE p.id IN (SELECT DISTINCT catalog_id FROM product_catalog_map);
+
+UPDATE products p
+SET catalog_id = m.catalog_id
+FROM product_catalog_map m
+WHERE m.product_id = p.id;
+
+-- Дубликаты внутри одного филиала сливаются в самую раннюю строку
+CREATE TEMPORARY TABLE product_merge_map AS
+SELECT product_id, kept_id, branch_id, company_id, total_count
+FROM (SELECT product_id,
+             branch_id,
+             company_id,
+             total_count,
+             FIRST_VALUE(product_id) OVER (PARTITION BY catalog_id, branch_id ORDER BY created_at, product_id) AS kept_id
+      FROM product_catalog_map) m
+WHERE product_id <> kept_id;
+
+-- Остаток дубликата переносится на оставшуюся строку, журнал движения фиксирует обе стороны
+INSERT INTO inventory_movements (product_id, branch_id, company_id, delta, balance, reason)
+SELECT product_id, branch_id, company_id, -total_count, 0, 'adjustment'
+FROM product_merge_map
+WHERE total_count <> 0;
+
+UPDATE products p
+SET total_count = p.total_count + s.moved
+FROM (SELECT kept_id, SUM(total_count) AS moved FROM product_merge_map GROUP BY kept_id) s
+WHERE p.id = s.kept_id;
+
+INSERT INTO inventory_movements (product_id, branch_id, company_id, delta, balance, reason)
+SELECT p.id, p.branch_id, p.company_id, s.moved, p.total_count, 'adjustment'
+FROM products p
+         JOIN (SELECT kept_id, SUM(total_count) AS moved FROM product_merge_map GROUP BY kept_id) s ON s.kept_id = p.id
+WHERE s.moved <> 0;
+
+-- Документы дубликатов переходят на оставшуюся строку
+UPDATE sales_items t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE purchase_items t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE transfer_products t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE product_return_items t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE supplier_return_items t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE purchase_order_items t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+UPDATE stock_shortages t SET product_id = m.kept_id FROM product_merge_map m WHERE t.product_id = m.product_id;
+
+DELETE FROM products WHERE id IN (SELECT product_id FROM product_merge_map);
+
+DROP TABLE product_merge_map;
+DROP TABLE product_catalog_map;
+
+-- В каждом филиале у карточки не больше одной строки остатка
+ALTER TABLE products
+    ALTER COLUMN catalog_id SET NOT NULL;
+CREATE UNIQUE INDEX idx_products_catalog_branch ON products (catalog_id, branch_id);
+
+-- Позиции продаж, закупок и перемещений ссылаются на карточку, чтобы отчёты сводили товар по всем филиалам
+ALTER TABLE sales_items
+    ADD COLUMN catalog_id UUID REFERENCES catalog_items (id);
+ALTER TABLE purchase_items
+    ADD COLUMN catalog_id UUID REFERENCES catalog_items (id);
+ALTER TABLE transfer_products
+    ADD COLUMN catalog_id UUID REFERENCES catalog_items (id);
+
+UPDATE sales_items t SET catalog_id = p.catalog_id FROM products p WHERE p.id = t.product_id;
+UPDATE purchase_items t SET catalog_id = p.catalog_id FROM products p WHERE p.id = t.product_id;
+UPDATE transfer_products t SET catalog_id = p.catalog_id FROM products p WHERE p.id = t.product_id;
+
+ALTER TABLE sales_items
+    ALTER COLUMN catalog_id SET NOT NULL;
+ALTER TABLE purchase_items
+    ALTER COLUMN catalog_id SET NOT NULL;
+ALTER TABLE transfer_products
+    ALTER COLUMN catalog_id SET NOT NULL;
+
+-- Индексы для каталога
+CREATE INDEX idx_catalog_items_company ON catalog_items (company_id);
+CREATE INDEX idx_catalog_items_name ON catalog_items (name);
+CREATE INDEX idx_sales_items_catalog_id ON sales_items (catalog_id);
+CREATE INDEX idx_purchase_items_catalog_id ON purchase_items (catalog_id);
+CREATE INDEX idx_transfer_products_catalog_id ON transfer_products (catalog_id);
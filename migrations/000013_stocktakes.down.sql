@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS stocktake_counts;
+DROP TABLE IF EXISTS stocktake_items;
+DROP TABLE IF EXISTS stocktakes;
+
+-- Записи движения денег по удаляемым документам остаются, проверка типа действует только для новых записей
+ALTER TABLE cash_flow DROP CONSTRAINT cash_flow_source_type_check;
+ALTER TABLE cash_flow ADD CONSTRAINT cash_flow_source_type_check
+    CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer', 'supplier_return')) NOT VALID;
@@ -0,0 +1,59 @@
+-- Инвентаризация филиала: остатки фиксируются при открытии, подсчёт сверяется с ними при закрытии
+CREATE TABLE stocktakes
+(
+    id              UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    status          VARCHAR(20)    DEFAULT 'open' NOT NULL
+        CHECK (status IN ('open', 'closed', 'cancelled')),
+    category_id     UUID REFERENCES product_categories (id), -- NULL: считается весь филиал
+    description     TEXT           DEFAULT ''     NOT NULL,
+    started_by      UUID                          NOT NULL,
+    closed_by       UUID,
+    closed_at       TIMESTAMP,
+    shrinkage_value DECIMAL(15, 2) DEFAULT 0      NOT NULL, -- Недостача по цене закупки
+    surplus_value   DECIMAL(15, 2) DEFAULT 0      NOT NULL, -- Излишки по цене закупки
+    cash_flow_id    UUID REFERENCES cash_flow (id),          -- Расход на сумму недостачи
+    branch_id       UUID                          NOT NULL,
+    company_id      UUID                          NOT NULL,
+    created_at      TIMESTAMP      DEFAULT NOW()
+);
+
+-- Позиции инвентаризации с остатком на момент открытия
+CREATE TABLE stocktake_items
+(
+    id                UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    stocktake_id      UUID REFERENCES stocktakes (id) NOT NULL,
+    product_id        UUID REFERENCES products (id)   NOT NULL,
+    expected_quantity INT                             NOT NULL, -- Остаток при открытии
+    counted_quantity  INT CHECK (counted_quantity >= 0),        -- NULL, пока товар не посчитан
+    incoming_price    DECIMAL(15, 2)                  NOT NULL, -- Цена закупки при открытии
+    counted_at        TIMESTAMP,
+    branch_id         UUID                            NOT NULL,
+    company_id        UUID                            NOT NULL,
+    UNIQUE (stocktake_id, product_id)
+);
+
+-- Каждый проход подсчёта сохраняется: кто, когда и сколько насчитал
+CREATE TABLE stocktake_counts
+(
+    id           UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    stocktake_id UUID REFERENCES stocktakes (id) NOT NULL,
+    product_id   UUID                            NOT NULL,
+    quantity     INT                             NOT NULL CHECK (quantity >= 0),
+    replaced     BOOLEAN   DEFAULT FALSE         NOT NULL, -- true: перезаписал прежний подсчёт, false: добавлен к нему
+    counted_by   UUID                            NOT NULL,
+    created_at   TIMESTAMP DEFAULT NOW()
+);
+
+-- В филиале одновременно открыта только одна инвентаризация
+CREATE UNIQUE INDEX idx_stocktakes_open_branch ON stocktakes (branch_id) WHERE status = 'open';
+
+-- Расход на недостачу ссылается на инвентаризацию
+ALTER TABLE cash_flow DROP CONSTRAINT cash_flow_source_type_check;
+ALTER TABLE cash_flow ADD CONSTRAINT cash_flow_source_type_check
+    CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer', 'supplier_return', 'stocktake'));
+
+-- Индексы для инвентаризации
+CREATE INDEX idx_stocktakes_company_branch ON stocktakes (company_id, branch_id, status);
+CREATE INDEX idx_stocktakes_created_at ON stocktakes (created_at);
+CREATE INDEX idx_stocktake_items_stocktake_id ON stocktake_items (stocktake_id);
+CREATE INDEX idx_stocktake_counts_stocktake_id ON stocktake_counts (stocktake_id, product_id);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS stock_adjustment_items;
+DROP TABLE IF EXISTS stock_adjustments;
+
+-- Записи движения денег по удаляемым документам остаются, проверка типа действует только для новых записей
+ALTER TABLE cash_flow DROP CONSTRAINT cash_flow_source_type_check;
+ALTER TABLE cash_flow ADD CONSTRAINT cash_flow_source_type_check
+    CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer', 'supplier_return', 'stocktake')) NOT VALID;
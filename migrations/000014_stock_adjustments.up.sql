@@ -0,0 +1,37 @@
+-- Ручная корректировка остатков: списание порчи, потерь, внутреннего расхода и оприходование найденного товара
+CREATE TABLE stock_adjustments
+(
+    id              UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    reason          VARCHAR(20)                  NOT NULL
+        CHECK (reason IN ('damage', 'loss', 'internal_use', 'found', 'correction')),
+    description     TEXT           DEFAULT ''    NOT NULL,
+    write_off_value DECIMAL(15, 2) DEFAULT 0     NOT NULL, -- Стоимость списанного товара по цене закупки
+    cash_flow_id    UUID REFERENCES cash_flow (id),        -- Расход на стоимость списания, если он проведён
+    created_by      UUID                         NOT NULL,
+    branch_id       UUID                         NOT NULL,
+    company_id      UUID                         NOT NULL,
+    created_at      TIMESTAMP      DEFAULT NOW()
+);
+
+-- Позиции корректировки: количество со знаком (- списание, + оприходование)
+CREATE TABLE stock_adjustment_items
+(
+    id             UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    adjustment_id  UUID REFERENCES stock_adjustments (id) NOT NULL,
+    product_id     UUID REFERENCES products (id)          NOT NULL,
+    quantity       INT                                    NOT NULL CHECK (quantity <> 0),
+    incoming_price DECIMAL(15, 2)                         NOT NULL, -- Цена закупки на момент корректировки
+    total_value    DECIMAL(15, 2)                         NOT NULL, -- quantity * incoming_price
+    branch_id      UUID                                   NOT NULL,
+    company_id     UUID                                   NOT NULL
+);
+
+-- Расход на списание ссылается на корректировку
+ALTER TABLE cash_flow DROP CONSTRAINT cash_flow_source_type_check;
+ALTER TABLE cash_flow ADD CONSTRAINT cash_flow_source_type_check
+    CHECK (source_type IN ('sale', 'purchase', 'return', 'transfer', 'supplier_return', 'stocktake', 'stock_adjustment'));
+
+-- Индексы для корректировок
+CREATE INDEX idx_stock_adjustments_company_branch ON stock_adjustments (company_id, branch_id, reason);
+CREATE INDEX idx_stock_adjustments_created_at ON stock_adjustments (created_at);
+CREATE INDEX idx_stock_adjustment_items_adjustment_id ON stock_adjustment_items (adjustment_id);
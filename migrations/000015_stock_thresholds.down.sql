@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS stock_alerts;
+
+ALTER TABLE company_settings DROP COLUMN IF EXISTS alert_phone;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS reorder_qty,
+    DROP COLUMN IF EXISTS min_stock;
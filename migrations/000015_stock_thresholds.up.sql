@@ -0,0 +1,32 @@
+-- Порог низкого остатка и количество для дозаказа у каждой строки товара филиала
+ALTER TABLE products
+    ADD COLUMN min_stock   INT DEFAULT 0 NOT NULL CHECK (min_stock >= 0),
+    ADD COLUMN reorder_qty INT DEFAULT 0 NOT NULL CHECK (reorder_qty >= 0);
+
+-- Номер, на который уходят SMS о низком остатке
+ALTER TABLE company_settings
+    ADD COLUMN alert_phone VARCHAR(20) DEFAULT '' NOT NULL;
+
+-- Оповещения о низком остатке: создаются вместе со списанием и отправляются фоновой проверкой
+CREATE TABLE stock_alerts
+(
+    id          UUID        DEFAULT gen_random_uuid() PRIMARY KEY,
+    product_id  UUID REFERENCES products (id) ON DELETE CASCADE NOT NULL,
+    balance     INT                                        NOT NULL, -- Остаток после списания
+    min_stock   INT                                        NOT NULL,
+    reorder_qty INT                                        NOT NULL,
+    reason      VARCHAR(20)                                NOT NULL, -- Причина движения, опустившего остаток
+    source_id   UUID,
+    status      VARCHAR(20) DEFAULT 'pending'              NOT NULL
+        CHECK (status IN ('pending', 'sent', 'failed', 'skipped')),
+    attempts    INT         DEFAULT 0                      NOT NULL,
+    error       TEXT        DEFAULT ''                     NOT NULL,
+    branch_id   UUID                                       NOT NULL,
+    company_id  UUID                                       NOT NULL,
+    created_at  TIMESTAMP   DEFAULT NOW(),
+    sent_at     TIMESTAMP
+);
+
+-- Индексы для оповещений
+CREATE INDEX idx_stock_alerts_pending ON stock_alerts (created_at) WHERE status = 'pending';
+CREATE INDEX idx_stock_alerts_company_branch ON stock_alerts (company_id, branch_id);
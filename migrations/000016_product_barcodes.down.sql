@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS catalog_barcodes;
+
+DROP INDEX IF EXISTS idx_catalog_items_company_sku;
+ALTER TABLE catalog_items DROP COLUMN IF EXISTS sku;
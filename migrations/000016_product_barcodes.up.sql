@@ -0,0 +1,19 @@
+-- Артикул карточки товара, уникальный внутри компании
+ALTER TABLE catalog_items
+    ADD COLUMN sku VARCHAR(64);
+
+CREATE UNIQUE INDEX idx_catalog_items_company_sku ON catalog_items (company_id, sku) WHERE sku IS NOT NULL;
+
+-- Штрихкоды карточки: EAN-13, UPC или внутренние коды. Один штрихкод ведёт к одной карточке компании
+CREATE TABLE catalog_barcodes
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    catalog_id UUID REFERENCES catalog_items (id) ON DELETE CASCADE NOT NULL,
+    barcode    VARCHAR(64)                                          NOT NULL,
+    company_id UUID                                                 NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Индексы для штрихкодов
+CREATE UNIQUE INDEX idx_catalog_barcodes_company_barcode ON catalog_barcodes (company_id, barcode);
+CREATE INDEX idx_catalog_barcodes_catalog_id ON catalog_barcodes (catalog_id);
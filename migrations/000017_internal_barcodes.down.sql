@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS barcode_sequences;
+
+ALTER TABLE company_settings DROP COLUMN IF EXISTS barcode_prefix;
@@ -0,0 +1,10 @@
+-- Префикс внутренних штрихкодов компании из диапазона 200-299, зарезервированного GS1 для внутреннего использования
+ALTER TABLE company_settings
+    ADD COLUMN barcode_prefix CHAR(3) DEFAULT '200' NOT NULL CHECK (barcode_prefix ~ '^2[0-9]{2}$');
+
+-- Счётчик внутренних штрихкодов компании
+CREATE TABLE barcode_sequences
+(
+    company_id  UUID PRIMARY KEY,
+    last_number BIGINT DEFAULT 0 NOT NULL
+);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_products_parent_id;
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_parent_stock_check,
+    DROP COLUMN IF EXISTS price_override,
+    DROP COLUMN IF EXISTS is_parent,
+    DROP COLUMN IF EXISTS parent_id;
+
+DROP INDEX IF EXISTS idx_catalog_items_parent_values;
+ALTER TABLE catalog_items
+    DROP COLUMN IF EXISTS variant_values,
+    DROP COLUMN IF EXISTS variant_axes,
+    DROP COLUMN IF EXISTS parent_id;
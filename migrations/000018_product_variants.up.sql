@@ -0,0 +1,17 @@
+-- Варианты товара (размер, цвет и т.д.): карточка-родитель задаёт оси, карточка варианта - значения по этим осям
+ALTER TABLE catalog_items
+    ADD COLUMN parent_id      UUID REFERENCES catalog_items (id),
+    ADD COLUMN variant_axes   TEXT[] DEFAULT '{}' NOT NULL,
+    ADD COLUMN variant_values TEXT[] DEFAULT '{}' NOT NULL;
+
+CREATE UNIQUE INDEX idx_catalog_items_parent_values ON catalog_items (parent_id, variant_values) WHERE parent_id IS NOT NULL;
+
+-- Строка родителя в филиале только группирует варианты и не хранит остаток.
+-- price_override: вариант продаётся по своей цене, а не по цене родителя
+ALTER TABLE products
+    ADD COLUMN parent_id      UUID REFERENCES products (id),
+    ADD COLUMN is_parent      BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD COLUMN price_override BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD CONSTRAINT products_parent_stock_check CHECK (NOT is_parent OR total_count = 0);
+
+CREATE INDEX idx_products_parent_id ON products (parent_id);
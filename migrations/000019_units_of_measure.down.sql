@@ -0,0 +1,21 @@
+ALTER TABLE purchase_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE sales_items
+    ALTER COLUMN sale_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE transfer_products
+    DROP COLUMN IF EXISTS unit_quantity,
+    DROP COLUMN IF EXISTS unit;
+
+ALTER TABLE purchase_items
+    DROP COLUMN IF EXISTS unit_quantity,
+    DROP COLUMN IF EXISTS unit;
+
+ALTER TABLE sales_items
+    DROP COLUMN IF EXISTS unit_quantity,
+    DROP COLUMN IF EXISTS unit;
+
+DROP TABLE IF EXISTS catalog_units;
+
+ALTER TABLE catalog_items DROP COLUMN IF EXISTS base_unit;
@@ -0,0 +1,42 @@
+-- Базовая единица карточки: в ней считается остаток, это самый мелкий шаг товара (шт, г, мл, см).
+-- bill_format остаётся форматом чека, единица больше не хранится в нём
+ALTER TABLE catalog_items
+    ADD COLUMN base_unit VARCHAR(16) DEFAULT 'pcs' NOT NULL;
+
+-- Карточки, у которых в bill_format записана единица, получают её как базовую
+UPDATE catalog_items
+SET base_unit = LOWER(bill_format)
+WHERE LOWER(bill_format) IN ('pcs', 'dona', 'kg', 'g', 'gr', 'l', 'ml', 'm', 'cm', 'sm', 'шт', 'кг', 'г', 'л', 'мл', 'м', 'см');
+
+-- Единицы закупки и продажи карточки: коробка = 24 шт, кг = 1000 г
+CREATE TABLE catalog_units
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    catalog_id UUID REFERENCES catalog_items (id) ON DELETE CASCADE NOT NULL,
+    name       VARCHAR(16)                                          NOT NULL,
+    factor     BIGINT                                               NOT NULL CHECK (factor > 0),
+    company_id UUID                                                 NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_catalog_units_catalog_name ON catalog_units (catalog_id, LOWER(name));
+
+-- Единица и количество, в которых позиция была введена. quantity остаётся в базовой единице
+ALTER TABLE sales_items
+    ADD COLUMN unit          VARCHAR(16),
+    ADD COLUMN unit_quantity NUMERIC(15, 3);
+
+ALTER TABLE purchase_items
+    ADD COLUMN unit          VARCHAR(16),
+    ADD COLUMN unit_quantity NUMERIC(15, 3);
+
+ALTER TABLE transfer_products
+    ADD COLUMN unit          VARCHAR(16),
+    ADD COLUMN unit_quantity NUMERIC(15, 3);
+
+-- Цена за базовую единицу получается делением цены единицы на коэффициент, двух знаков для неё мало
+ALTER TABLE sales_items
+    ALTER COLUMN sale_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE purchase_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(19, 6);
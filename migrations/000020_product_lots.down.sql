@@ -0,0 +1,7 @@
+ALTER TABLE purchase_items
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS lot_number;
+
+DROP TABLE IF EXISTS lot_movements;
+
+DROP TABLE IF EXISTS product_lots;
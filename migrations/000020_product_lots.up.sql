@@ -0,0 +1,42 @@
+-- Партии товара: приход закупки или перемещения со своим номером, сроком годности и себестоимостью.
+-- Остаток товара без партии (приход до учёта партий, излишки инвентаризации) в партиях не числится
+CREATE TABLE product_lots
+(
+    id          UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    product_id  UUID REFERENCES products (id) ON DELETE CASCADE NOT NULL,
+    lot_number  VARCHAR(64)    DEFAULT ''                      NOT NULL,
+    expires_at  DATE,                                                    -- NULL: срок годности не ограничен
+    cost        DECIMAL(19, 6) DEFAULT 0                       NOT NULL, -- Себестоимость базовой единицы
+    quantity    BIGINT                                         NOT NULL CHECK (quantity > 0),
+    remaining   BIGINT                                         NOT NULL CHECK (remaining >= 0),
+    source_type VARCHAR(20)                                    NOT NULL, -- purchase, transfer_in
+    source_id   UUID,
+    company_id  UUID                                           NOT NULL,
+    branch_id   UUID                                           NOT NULL,
+    created_at  TIMESTAMP      DEFAULT NOW()
+);
+
+-- Движение по партиям: из каких партий списан и в какие вернулся товар документа
+CREATE TABLE lot_movements
+(
+    id            UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    lot_id        UUID REFERENCES product_lots (id) ON DELETE CASCADE NOT NULL,
+    delta         BIGINT                                              NOT NULL, -- + приход в партию, - расход из партии
+    reason        VARCHAR(20)                                         NOT NULL,
+    source_id     UUID,                                                         -- Документ, вызвавший изменение
+    lot_source_id UUID,                                                         -- Документ, по партиям которого идёт изменение: продажа для возврата, закупка для возврата поставщику
+    created_by    UUID,
+    created_at    TIMESTAMP DEFAULT NOW()
+);
+
+-- Позиция закупки приходует партию
+ALTER TABLE purchase_items
+    ADD COLUMN lot_number VARCHAR(64),
+    ADD COLUMN expires_at DATE;
+
+-- Индексы для партий: подбор FEFO и отчёт по срокам годности смотрят только партии с остатком
+CREATE INDEX idx_product_lots_product_expiry ON product_lots (product_id, expires_at) WHERE remaining > 0;
+CREATE INDEX idx_product_lots_branch_expiry ON product_lots (company_id, branch_id, expires_at) WHERE remaining > 0;
+CREATE INDEX idx_lot_movements_lot_id ON lot_movements (lot_id);
+CREATE INDEX idx_lot_movements_source_id ON lot_movements (source_id);
+CREATE INDEX idx_lot_movements_lot_source_id ON lot_movements (lot_source_id);
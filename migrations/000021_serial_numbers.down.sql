@@ -0,0 +1,19 @@
+ALTER TABLE stock_adjustment_items DROP COLUMN IF EXISTS serials;
+
+ALTER TABLE supplier_return_items DROP COLUMN IF EXISTS serials;
+
+ALTER TABLE product_return_items DROP COLUMN IF EXISTS serials;
+
+ALTER TABLE transfer_products
+    DROP COLUMN IF EXISTS received_serials,
+    DROP COLUMN IF EXISTS serials;
+
+ALTER TABLE purchase_items DROP COLUMN IF EXISTS serials;
+
+ALTER TABLE sales_items DROP COLUMN IF EXISTS serials;
+
+DROP TABLE IF EXISTS serial_movements;
+
+DROP TABLE IF EXISTS product_serials;
+
+ALTER TABLE catalog_items DROP COLUMN IF EXISTS is_serialized;
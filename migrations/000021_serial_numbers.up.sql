@@ -0,0 +1,57 @@
+-- Серийный учёт карточки: у каждой единицы свой серийный номер (IMEI)
+ALTER TABLE catalog_items
+    ADD COLUMN is_serialized BOOLEAN DEFAULT FALSE NOT NULL;
+
+-- Серийные номера товаров: где единица сейчас и в каком она состоянии.
+-- Серийный номер уникален внутри компании, повторная закупка проданной единицы возвращает ту же строку на склад
+CREATE TABLE product_serials
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    serial     VARCHAR(64)                                          NOT NULL,
+    catalog_id UUID REFERENCES catalog_items (id) ON DELETE CASCADE NOT NULL,
+    product_id UUID                                                 NOT NULL, -- Строка товара филиала, где единица сейчас
+    status     VARCHAR(20)                                          NOT NULL, -- in_stock, in_transit, sold, returned, written_off, voided
+    company_id UUID                                                 NOT NULL,
+    branch_id  UUID                                                 NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- История серийного номера: закупка, перемещения, продажа, возвраты
+CREATE TABLE serial_movements
+(
+    id         UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    serial_id  UUID REFERENCES product_serials (id) ON DELETE CASCADE NOT NULL,
+    reason     VARCHAR(20)                                            NOT NULL, -- Причина из журнала движения товаров
+    status     VARCHAR(20)                                            NOT NULL, -- Состояние после события
+    source_id  UUID,                                                            -- Документ события
+    product_id UUID                                                   NOT NULL,
+    branch_id  UUID                                                   NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Серийные номера, прошедшие по позициям документов
+ALTER TABLE sales_items
+    ADD COLUMN serials TEXT[];
+
+ALTER TABLE purchase_items
+    ADD COLUMN serials TEXT[];
+
+ALTER TABLE transfer_products
+    ADD COLUMN serials          TEXT[],
+    ADD COLUMN received_serials TEXT[];
+
+ALTER TABLE product_return_items
+    ADD COLUMN serials TEXT[];
+
+ALTER TABLE supplier_return_items
+    ADD COLUMN serials TEXT[];
+
+ALTER TABLE stock_adjustment_items
+    ADD COLUMN serials TEXT[];
+
+-- Индексы для серийных номеров
+CREATE UNIQUE INDEX idx_product_serials_company_serial ON product_serials (company_id, serial);
+CREATE INDEX idx_product_serials_product_status ON product_serials (product_id, status);
+CREATE INDEX idx_serial_movements_serial_id ON serial_movements (serial_id, created_at);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS cost_layers;
+
+ALTER TABLE sales_items DROP COLUMN IF EXISTS cost_price;
+
+ALTER TABLE inventory_movements DROP COLUMN IF EXISTS unit_cost;
+
+ALTER TABLE stock_adjustment_items
+    ALTER COLUMN incoming_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE products
+    ALTER COLUMN incoming_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE company_settings DROP COLUMN IF EXISTS costing_method;
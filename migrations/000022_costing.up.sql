@@ -0,0 +1,43 @@
+-- Метод оценки себестоимости компании: скользящая средняя (average) или FIFO (fifo)
+ALTER TABLE company_settings
+    ADD COLUMN costing_method VARCHAR(10) DEFAULT 'average' NOT NULL CHECK (costing_method IN ('average', 'fifo'));
+
+-- Себестоимость товара пересчитывается при каждом движении, поэтому хранится с запасом точности
+ALTER TABLE products
+    ALTER COLUMN incoming_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE stock_adjustment_items
+    ALTER COLUMN incoming_price TYPE DECIMAL(19, 6);
+
+-- Себестоимость единицы, по которой прошло движение товара
+ALTER TABLE inventory_movements
+    ADD COLUMN unit_cost DECIMAL(19, 6);
+
+-- Себестоимость базовой единицы проданного товара на момент продажи
+ALTER TABLE sales_items
+    ADD COLUMN cost_price DECIMAL(19, 6);
+
+-- Слои себестоимости: каждый приход кладёт слой со своей ценой, расход забирает слои от старых к новым.
+-- Слои ведутся при любом методе, чтобы компания могла перейти на FIFO без потери истории
+CREATE TABLE cost_layers
+(
+    id          UUID      DEFAULT gen_random_uuid() PRIMARY KEY,
+    product_id  UUID REFERENCES products (id) ON DELETE CASCADE NOT NULL,
+    unit_cost   DECIMAL(19, 6)                                 NOT NULL,
+    quantity    BIGINT                                         NOT NULL CHECK (quantity > 0),
+    remaining   BIGINT                                         NOT NULL CHECK (remaining >= 0),
+    source_type VARCHAR(20)                                    NOT NULL, -- Причина движения, принёсшего слой; opening - остаток до учёта слоёв
+    source_id   UUID,
+    company_id  UUID                                           NOT NULL,
+    branch_id   UUID                                           NOT NULL,
+    created_at  TIMESTAMP DEFAULT NOW()
+);
+
+-- Остаток на момент миграции становится начальным слоем по текущей цене закупки
+INSERT INTO cost_layers (product_id, unit_cost, quantity, remaining, source_type, company_id, branch_id, created_at)
+SELECT id, incoming_price, total_count, total_count, 'opening', company_id, branch_id, COALESCE(created_at, NOW())
+FROM products
+WHERE total_count > 0 AND NOT is_parent;
+
+-- Индекс для слоёв: расход смотрит только слои с остатком
+CREATE INDEX idx_cost_layers_product ON cost_layers (product_id, created_at) WHERE remaining > 0;
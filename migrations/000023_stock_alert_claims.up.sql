@@ -0,0 +1,3 @@
+-- Аренда оповещения на время отправки: пока она не истекла, другой экземпляр сервиса его не заберёт
+ALTER TABLE stock_alerts
+    ADD COLUMN claimed_until TIMESTAMP;
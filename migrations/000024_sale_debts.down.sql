@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS sale_debts;
+
+ALTER TABLE product_returns
+    ALTER COLUMN client_id SET NOT NULL;
+
+ALTER TABLE sales
+    ALTER COLUMN client_id SET NOT NULL;
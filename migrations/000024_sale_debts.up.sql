@@ -0,0 +1,35 @@
+-- Клиент новой продажи в долг заводится в сервисе долгов уже после сохранения продажи
+ALTER TABLE sales
+    ALTER COLUMN client_id DROP NOT NULL;
+
+ALTER TABLE product_returns
+    ALTER COLUMN client_id DROP NOT NULL;
+
+-- Запросы к сервису долгов по продажам. Запрос пишется в транзакции документа и отправляется после её фиксации,
+-- поэтому долг не появляется без продажи, а продажа не остаётся без долга: неотправленный запрос повторяется
+CREATE TABLE sale_debts
+(
+    id            UUID           DEFAULT gen_random_uuid() PRIMARY KEY,
+    sale_id       UUID REFERENCES sales (id) ON DELETE CASCADE NOT NULL,
+    kind          VARCHAR(10)                                  NOT NULL CHECK (kind IN ('create', 'reduce')), -- create: клиент и долг продажи, reduce: уменьшение долга
+    source_id     UUID,                                                                                      -- Документ уменьшения: возврат или аннулированная продажа
+    client_id     VARCHAR(64)    DEFAULT ''                    NOT NULL,                                     -- Пусто: клиент заводится по имени и телефону
+    client_name   VARCHAR(255)   DEFAULT ''                    NOT NULL,
+    client_phone  VARCHAR(20)    DEFAULT ''                    NOT NULL,
+    amount        DECIMAL(15, 2)                               NOT NULL CHECK (amount >= 0),
+    currency_code VARCHAR(10)    DEFAULT ''                    NOT NULL,
+    pay_type      VARCHAR(20)    DEFAULT ''                    NOT NULL,                                     -- Основание уменьшения долга: return, void
+    debt_id       VARCHAR(64)    DEFAULT ''                    NOT NULL,
+    status        VARCHAR(20)    DEFAULT 'pending'             NOT NULL
+        CHECK (status IN ('pending', 'done', 'failed', 'cancelled')),
+    attempts      INT            DEFAULT 0                     NOT NULL,
+    error         TEXT           DEFAULT ''                    NOT NULL,
+    claimed_until TIMESTAMP,
+    company_id    UUID                                         NOT NULL,
+    created_at    TIMESTAMP      DEFAULT NOW(),
+    processed_at  TIMESTAMP
+);
+
+-- Индексы для запросов к сервису долгов
+CREATE INDEX idx_sale_debts_pending ON sale_debts (created_at) WHERE status = 'pending';
+CREATE INDEX idx_sale_debts_sale_id ON sale_debts (sale_id);
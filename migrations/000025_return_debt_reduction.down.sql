@@ -0,0 +1 @@
+ALTER TABLE product_returns DROP COLUMN IF EXISTS debt_reduction;
@@ -0,0 +1,3 @@
+-- Часть возврата по продаже в долг, которая не выплачивается, а списывается с долга клиента
+ALTER TABLE product_returns
+    ADD COLUMN debt_reduction DECIMAL(15, 2) DEFAULT 0 NOT NULL CHECK (debt_reduction >= 0);
@@ -0,0 +1,3 @@
+-- Недостача частично принятого перемещения списывается на исходном филиале документом потерь
+ALTER TABLE transfers
+    ADD COLUMN shortfall_adjustment_id UUID REFERENCES stock_adjustments (id);
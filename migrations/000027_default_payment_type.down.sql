@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_payment_types_default;
+
+ALTER TABLE payment_types
+    DROP COLUMN IF EXISTS is_default;
@@ -0,0 +1,20 @@
+-- Способ оплаты по умолчанию отмечается флагом, а не названием 'uzs', чтобы его можно было переименовать
+ALTER TABLE payment_types
+    ADD COLUMN is_default BOOLEAN DEFAULT FALSE NOT NULL;
+
+CREATE UNIQUE INDEX idx_payment_types_default ON payment_types (company_id) WHERE is_default;
+
+-- Компании с настройками, у которых ещё нет способов оплаты, получают способы по умолчанию
+INSERT INTO payment_types (name, currency, is_cash, company_id)
+SELECT t.name, t.currency, t.is_cash, s.company_id
+FROM company_settings s
+CROSS JOIN (VALUES ('uzs', 'uzs', TRUE), ('usd', 'usd', TRUE), ('card', 'uzs', FALSE)) AS t (name, currency, is_cash)
+WHERE NOT EXISTS (SELECT 1 FROM payment_types pt WHERE pt.company_id = s.company_id);
+
+-- По умолчанию становится активный 'uzs', а если его переименовали - самый старый активный наличный способ, затем самый старый способ
+UPDATE payment_types SET is_default = TRUE
+WHERE id IN (
+    SELECT DISTINCT ON (company_id) id
+    FROM payment_types
+    ORDER BY company_id, active DESC, name = 'uzs' DESC, is_cash DESC, created_at, id
+);
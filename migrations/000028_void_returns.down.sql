@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_product_returns_sale_status;
+
+-- Аннулированные возвраты удаляются, как это делалось до введения статусов: их товар и деньги уже возвращены обратно
+DELETE FROM product_return_items WHERE return_id IN (SELECT id FROM product_returns WHERE status = 'voided');
+DELETE FROM product_returns WHERE status = 'voided';
+
+ALTER TABLE product_returns
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS status;
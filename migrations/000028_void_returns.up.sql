@@ -0,0 +1,8 @@
+-- Возвраты не удаляются, а аннулируются, как продажи и закупки: строки остаются для истории движения товара и денег
+ALTER TABLE product_returns
+    ADD COLUMN status      VARCHAR(20) DEFAULT 'completed' NOT NULL CHECK (status IN ('completed', 'voided')),
+    ADD COLUMN voided_at   TIMESTAMP,
+    ADD COLUMN voided_by   UUID,
+    ADD COLUMN void_reason TEXT DEFAULT '' NOT NULL;
+
+CREATE INDEX idx_product_returns_sale_status ON product_returns (sale_id, status);
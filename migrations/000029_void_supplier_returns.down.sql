@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_supplier_returns_purchase_status;
+
+-- Аннулированные возвраты поставщику удаляются, как это делалось до введения статусов: их товар и деньги уже возвращены обратно
+DELETE FROM supplier_return_items WHERE return_id IN (SELECT id FROM supplier_returns WHERE status = 'voided');
+DELETE FROM supplier_returns WHERE status = 'voided';
+
+ALTER TABLE supplier_returns
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS status;
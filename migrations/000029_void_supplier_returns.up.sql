@@ -0,0 +1,8 @@
+-- Возвраты поставщику тоже аннулируются, а не удаляются: строки остаются для истории движения товара и денег
+ALTER TABLE supplier_returns
+    ADD COLUMN status      VARCHAR(20) DEFAULT 'completed' NOT NULL CHECK (status IN ('completed', 'voided')),
+    ADD COLUMN voided_at   TIMESTAMP,
+    ADD COLUMN voided_by   UUID,
+    ADD COLUMN void_reason TEXT DEFAULT '' NOT NULL;
+
+CREATE INDEX idx_supplier_returns_purchase_status ON supplier_returns (purchase_id, status);
@@ -0,0 +1,166 @@
+-- Карточки возвращаются к прежней базовой единице. Количество, введённое в долях прежней единицы, округляется,
+-- позиция документа при этом не обнуляется
+CREATE TEMP TABLE rescaled_products AS
+SELECT p.id AS product_id, r.factor, r.old_unit
+FROM products p
+         JOIN catalog_unit_rescales r ON r.catalog_id = p.catalog_id;
+
+UPDATE stock_alerts a
+SET balance     = ROUND(a.balance::NUMERIC / r.factor),
+    min_stock   = ROUND(a.min_stock::NUMERIC / r.factor),
+    reorder_qty = ROUND(a.reorder_qty::NUMERIC / r.factor)
+FROM rescaled_products r
+WHERE r.product_id = a.product_id;
+
+UPDATE stock_shortages s
+SET requested = ROUND(s.requested::NUMERIC / r.factor),
+    available = ROUND(s.available::NUMERIC / r.factor),
+    shortage  = ROUND(s.shortage::NUMERIC / r.factor)
+FROM rescaled_products r
+WHERE r.product_id = s.product_id;
+
+UPDATE stock_adjustment_items i
+SET quantity       = COALESCE(NULLIF(ROUND(i.quantity::NUMERIC / r.factor), 0), SIGN(i.quantity)),
+    incoming_price = i.incoming_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stocktake_counts i
+SET quantity = ROUND(i.quantity::NUMERIC / r.factor)
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stocktake_items i
+SET expected_quantity = ROUND(i.expected_quantity::NUMERIC / r.factor),
+    counted_quantity  = ROUND(i.counted_quantity::NUMERIC / r.factor),
+    incoming_price    = i.incoming_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE purchase_order_items i
+SET quantity          = GREATEST(ROUND(i.quantity::NUMERIC / r.factor), 1),
+    received_quantity = LEAST(ROUND(i.received_quantity::NUMERIC / r.factor), GREATEST(ROUND(i.quantity::NUMERIC / r.factor), 1)),
+    purchase_price    = i.purchase_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE supplier_return_items i
+SET quantity       = GREATEST(ROUND(i.quantity::NUMERIC / r.factor), 1),
+    purchase_price = i.purchase_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE product_return_items i
+SET quantity     = GREATEST(ROUND(i.quantity::NUMERIC / r.factor), 1),
+    refund_price = i.refund_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE transfer_products i
+SET quantity          = ROUND(i.quantity::NUMERIC / r.factor),
+    received_quantity = ROUND(i.received_quantity::NUMERIC / r.factor),
+    unit              = NULLIF(i.unit, r.old_unit),
+    unit_quantity     = CASE WHEN i.unit = r.old_unit THEN NULL ELSE i.unit_quantity END
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE purchase_items i
+SET quantity       = ROUND(i.quantity::NUMERIC / r.factor),
+    purchase_price = i.purchase_price * r.factor,
+    unit           = NULLIF(i.unit, r.old_unit),
+    unit_quantity  = CASE WHEN i.unit = r.old_unit THEN NULL ELSE i.unit_quantity END
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE sales_items i
+SET quantity      = ROUND(i.quantity::NUMERIC / r.factor),
+    sale_price    = i.sale_price * r.factor,
+    cost_price    = i.cost_price * r.factor,
+    unit          = NULLIF(i.unit, r.old_unit),
+    unit_quantity = CASE WHEN i.unit = r.old_unit THEN NULL ELSE i.unit_quantity END
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+ALTER TABLE inventory_movements
+    DISABLE TRIGGER trg_inventory_movements_append_only;
+
+UPDATE inventory_movements m
+SET delta     = ROUND(m.delta::NUMERIC / r.factor),
+    balance   = ROUND(m.balance::NUMERIC / r.factor),
+    unit_cost = m.unit_cost * r.factor
+FROM rescaled_products r
+WHERE r.product_id = m.product_id;
+
+ALTER TABLE inventory_movements
+    ENABLE TRIGGER trg_inventory_movements_append_only;
+
+UPDATE cost_layers l
+SET quantity  = GREATEST(ROUND(l.quantity::NUMERIC / r.factor), 1),
+    remaining = ROUND(l.remaining::NUMERIC / r.factor),
+    unit_cost = l.unit_cost * r.factor
+FROM rescaled_products r
+WHERE r.product_id = l.product_id;
+
+UPDATE lot_movements m
+SET delta = ROUND(m.delta::NUMERIC / r.factor)
+FROM product_lots l
+         JOIN rescaled_products r ON r.product_id = l.product_id
+WHERE l.id = m.lot_id;
+
+UPDATE product_lots l
+SET quantity  = GREATEST(ROUND(l.quantity::NUMERIC / r.factor), 1),
+    remaining = ROUND(l.remaining::NUMERIC / r.factor),
+    cost      = l.cost * r.factor
+FROM rescaled_products r
+WHERE r.product_id = l.product_id;
+
+UPDATE products p
+SET total_count    = ROUND(p.total_count::NUMERIC / r.factor),
+    min_stock      = ROUND(p.min_stock::NUMERIC / r.factor),
+    reorder_qty    = ROUND(p.reorder_qty::NUMERIC / r.factor),
+    incoming_price = p.incoming_price * r.factor,
+    standard_price = p.standard_price * r.factor
+FROM rescaled_products r
+WHERE r.product_id = p.id;
+
+UPDATE catalog_items c
+SET base_unit = r.old_unit
+FROM catalog_unit_rescales r
+WHERE r.catalog_id = c.id;
+
+DELETE
+FROM catalog_units u
+    USING catalog_unit_rescales r
+WHERE r.catalog_id = u.catalog_id
+  AND LOWER(u.name) = LOWER(r.old_unit);
+
+-- Единицы, которые не делятся на прежнюю базовую единицу нацело, теряют смысл и удаляются
+DELETE
+FROM catalog_units u
+    USING catalog_unit_rescales r
+WHERE r.catalog_id = u.catalog_id
+  AND u.factor % r.factor <> 0;
+
+UPDATE catalog_units u
+SET factor = u.factor / r.factor
+FROM catalog_unit_rescales r
+WHERE r.catalog_id = u.catalog_id;
+
+ALTER TABLE stocktake_items
+    ALTER COLUMN incoming_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE purchase_order_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE supplier_return_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE product_return_items
+    ALTER COLUMN refund_price TYPE DECIMAL(15, 2);
+
+ALTER TABLE products
+    ALTER COLUMN standard_price TYPE DECIMAL(15, 2);
+
+DROP TABLE rescaled_products;
+
+DROP TABLE IF EXISTS catalog_unit_rescales;
@@ -0,0 +1,188 @@
+-- Остаток считается в целых базовых единицах, поэтому кг, л и м не могут быть базовой единицей:
+-- полкило сахара в них не записать. Такие карточки переводятся на г, мл и см, а прежняя единица
+-- становится единицей продажи и закупки: 1 кг = 1000 г. Количества умножаются на коэффициент,
+-- цены за базовую единицу делятся на него, суммы документов не меняются.
+
+-- Переведённые карточки запоминаются, чтобы миграцию можно было откатить
+CREATE TABLE catalog_unit_rescales
+(
+    catalog_id UUID REFERENCES catalog_items (id) ON DELETE CASCADE PRIMARY KEY,
+    old_unit   VARCHAR(16) NOT NULL,
+    new_unit   VARCHAR(16) NOT NULL,
+    factor     BIGINT      NOT NULL
+);
+
+INSERT INTO catalog_unit_rescales (catalog_id, old_unit, new_unit, factor)
+SELECT c.id, c.base_unit, u.new_unit, u.factor
+FROM catalog_items c
+         JOIN (VALUES ('kg', 'g', 1000), ('l', 'ml', 1000), ('m', 'cm', 100),
+                      ('кг', 'г', 1000), ('л', 'мл', 1000), ('м', 'см', 100)) AS u (old_unit, new_unit, factor)
+              ON u.old_unit = LOWER(c.base_unit);
+
+CREATE TEMP TABLE rescaled_products AS
+SELECT p.id AS product_id, r.factor
+FROM products p
+         JOIN catalog_unit_rescales r ON r.catalog_id = p.catalog_id;
+
+-- Цена за грамм не укладывается в два знака
+ALTER TABLE products
+    ALTER COLUMN standard_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE product_return_items
+    ALTER COLUMN refund_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE supplier_return_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE purchase_order_items
+    ALTER COLUMN purchase_price TYPE DECIMAL(19, 6);
+
+ALTER TABLE stocktake_items
+    ALTER COLUMN incoming_price TYPE DECIMAL(19, 6);
+
+-- Карточка: новая базовая единица, прежние единицы пересчитываются в неё, прежняя базовая добавляется к ним
+UPDATE catalog_units u
+SET factor = u.factor * r.factor
+FROM catalog_unit_rescales r
+WHERE r.catalog_id = u.catalog_id;
+
+INSERT INTO catalog_units (catalog_id, name, factor, company_id)
+SELECT c.id, r.old_unit, r.factor, c.company_id
+FROM catalog_unit_rescales r
+         JOIN catalog_items c ON c.id = r.catalog_id
+ON CONFLICT DO NOTHING;
+
+UPDATE catalog_items c
+SET base_unit = r.new_unit
+FROM catalog_unit_rescales r
+WHERE r.catalog_id = c.id;
+
+-- Остатки, пороги, партии и слои себестоимости
+UPDATE products p
+SET total_count    = p.total_count * r.factor,
+    min_stock      = p.min_stock * r.factor,
+    reorder_qty    = p.reorder_qty * r.factor,
+    incoming_price = p.incoming_price / r.factor,
+    standard_price = p.standard_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = p.id;
+
+UPDATE product_lots l
+SET quantity  = l.quantity * r.factor,
+    remaining = l.remaining * r.factor,
+    cost      = l.cost / r.factor
+FROM rescaled_products r
+WHERE r.product_id = l.product_id;
+
+UPDATE lot_movements m
+SET delta = m.delta * r.factor
+FROM product_lots l
+         JOIN rescaled_products r ON r.product_id = l.product_id
+WHERE l.id = m.lot_id;
+
+UPDATE cost_layers l
+SET quantity  = l.quantity * r.factor,
+    remaining = l.remaining * r.factor,
+    unit_cost = l.unit_cost / r.factor
+FROM rescaled_products r
+WHERE r.product_id = l.product_id;
+
+-- Журнал движения только дополняется, но его записи должны остаться в одной единице с остатком,
+-- поэтому на время перевода защита снимается
+ALTER TABLE inventory_movements
+    DISABLE TRIGGER trg_inventory_movements_append_only;
+
+UPDATE inventory_movements m
+SET delta     = m.delta * r.factor,
+    balance   = m.balance * r.factor,
+    unit_cost = m.unit_cost / r.factor
+FROM rescaled_products r
+WHERE r.product_id = m.product_id;
+
+ALTER TABLE inventory_movements
+    ENABLE TRIGGER trg_inventory_movements_append_only;
+
+-- Документы: позиция, введённая без единицы, запоминает прежнюю базовую единицу, в которой её вводили
+UPDATE sales_items i
+SET unit          = COALESCE(i.unit, c.old_unit),
+    unit_quantity = COALESCE(i.unit_quantity, i.quantity),
+    quantity      = i.quantity * r.factor,
+    sale_price    = i.sale_price / r.factor,
+    cost_price    = i.cost_price / r.factor
+FROM rescaled_products r
+         JOIN products p ON p.id = r.product_id
+         JOIN catalog_unit_rescales c ON c.catalog_id = p.catalog_id
+WHERE r.product_id = i.product_id;
+
+UPDATE purchase_items i
+SET unit           = COALESCE(i.unit, c.old_unit),
+    unit_quantity  = COALESCE(i.unit_quantity, i.quantity),
+    quantity       = i.quantity * r.factor,
+    purchase_price = i.purchase_price / r.factor
+FROM rescaled_products r
+         JOIN products p ON p.id = r.product_id
+         JOIN catalog_unit_rescales c ON c.catalog_id = p.catalog_id
+WHERE r.product_id = i.product_id;
+
+UPDATE transfer_products i
+SET unit              = COALESCE(i.unit, c.old_unit),
+    unit_quantity     = COALESCE(i.unit_quantity, i.quantity),
+    quantity          = i.quantity * r.factor,
+    received_quantity = i.received_quantity * r.factor
+FROM rescaled_products r
+         JOIN products p ON p.id = r.product_id
+         JOIN catalog_unit_rescales c ON c.catalog_id = p.catalog_id
+WHERE r.product_id = i.product_id;
+
+UPDATE product_return_items i
+SET quantity     = i.quantity * r.factor,
+    refund_price = i.refund_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE supplier_return_items i
+SET quantity       = i.quantity * r.factor,
+    purchase_price = i.purchase_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE purchase_order_items i
+SET quantity          = i.quantity * r.factor,
+    received_quantity = i.received_quantity * r.factor,
+    purchase_price    = i.purchase_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stocktake_items i
+SET expected_quantity = i.expected_quantity * r.factor,
+    counted_quantity  = i.counted_quantity * r.factor,
+    incoming_price    = i.incoming_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stocktake_counts i
+SET quantity = i.quantity * r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stock_adjustment_items i
+SET quantity       = i.quantity * r.factor,
+    incoming_price = i.incoming_price / r.factor
+FROM rescaled_products r
+WHERE r.product_id = i.product_id;
+
+UPDATE stock_shortages s
+SET requested = s.requested * r.factor,
+    available = s.available * r.factor,
+    shortage  = s.shortage * r.factor
+FROM rescaled_products r
+WHERE r.product_id = s.product_id;
+
+UPDATE stock_alerts a
+SET balance     = a.balance * r.factor,
+    min_stock   = a.min_stock * r.factor,
+    reorder_qty = a.reorder_qty * r.factor
+FROM rescaled_products r
+WHERE r.product_id = a.product_id;
+
+DROP TABLE rescaled_products;
@@ -0,0 +1,52 @@
+package label
+
+import "fmt"
+
+// Bar patterns of the digits: L and G codes on the left half, R codes on the right
+var (
+	codesL = [10]string{"0001101", "0011001", "0010011", "0111101", "0100011", "0110001", "0101111", "0111011", "0110111", "0001011"}
+	codesG = [10]string{"0100111", "0110011", "0011011", "0100001", "0011101", "0111001", "0000101", "0010001", "0001001", "0010111"}
+	codesR = [10]string{"1110010", "1100110", "1101100", "1000010", "1011100", "1001110", "1010000", "1000100", "1001000", "1110100"}
+
+	// The first digit is not drawn, it picks the L/G mix of the left half
+	parity = [10]string{"LLLLLL", "LLGLGG", "LLGGLG", "LLGGGL", "LGLLGG", "LGGLLG", "LGGGLL", "LGLGLG", "LGLGGL", "LGGLGL"}
+)
+
+// encodeEAN13 turns a 13-digit code into its 95 modules, true for a dark bar.
+func encodeEAN13(code string) ([]bool, error) {
+	if len(code) != 13 {
+		return nil, fmt.Errorf("invalid EAN-13 barcode %q: must have 13 digits", code)
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return nil, fmt.Errorf("invalid EAN-13 barcode %q: must have 13 digits", code)
+		}
+	}
+
+	pattern := "101"
+	mix := parity[code[0]-'0']
+	for i := 1; i <= 6; i++ {
+		d := code[i] - '0'
+		if mix[i-1] == 'L' {
+			pattern += codesL[d]
+		} else {
+			pattern += codesG[d]
+		}
+	}
+	pattern += "01010"
+	for i := 7; i <= 12; i++ {
+		pattern += codesR[code[i]-'0']
+	}
+	pattern += "101"
+
+	modules := make([]bool, len(pattern))
+	for i := range pattern {
+		modules[i] = pattern[i] == '1'
+	}
+	return modules, nil
+}
+
+// isGuard tells whether the module belongs to the start, middle or end guard, which are drawn longer.
+func isGuard(module int) bool {
+	return module < 3 || (module >= 45 && module < 50) || module >= 92
+}
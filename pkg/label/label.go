@@ -0,0 +1,143 @@
+// Package label draws printable price labels with an EAN-13 barcode as PNG, SVG or PDF.
+package label
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Formats supported by Render
+const (
+	FormatPNG = "png"
+	FormatSVG = "svg"
+	FormatPDF = "pdf"
+)
+
+// Label size in points: 58 x 40 mm, the common thermal label
+const (
+	Width  = 164.0
+	Height = 113.0
+)
+
+const (
+	margin      = 8.0
+	moduleWidth = 1.3
+	barsTop     = 46.0
+	barsHeight  = 44.0
+	guardExtra  = 5.0
+	maxNameLen  = 30
+)
+
+// Label is the printed content of one label.
+type Label struct {
+	Name    string
+	Price   string
+	Barcode string // 13 digits
+}
+
+// shape is a filled bar or a line of text in label coordinates, measured from the top-left corner.
+// For text Y is the baseline.
+type shape struct {
+	X, Y, W, H float64
+	Text       string
+	Size       float64
+	Bold       bool
+}
+
+// Render draws the labels one after another: pages of a PDF, or a single column in PNG and SVG.
+// It returns the content with its MIME type.
+func Render(format string, labels []Label) ([]byte, string, error) {
+	if len(labels) == 0 {
+		return nil, "", errors.New("no labels to render")
+	}
+
+	pages := make([][]shape, 0, len(labels))
+	for _, l := range labels {
+		shapes, err := layout(l)
+		if err != nil {
+			return nil, "", err
+		}
+		pages = append(pages, shapes)
+	}
+
+	switch format {
+	case FormatPNG:
+		content, err := renderPNG(pages)
+		return content, "image/png", err
+	case FormatSVG:
+		return renderSVG(pages), "image/svg+xml", nil
+	case FormatPDF:
+		return renderPDF(pages), "application/pdf", nil
+	default:
+		return nil, "", fmt.Errorf("unsupported label format %q: must be %s, %s or %s", format, FormatPNG, FormatSVG, FormatPDF)
+	}
+}
+
+// layout places the name, the price and the barcode with its digits on one label.
+func layout(l Label) ([]shape, error) {
+	modules, err := encodeEAN13(l.Barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	shapes := []shape{
+		{X: margin, Y: 18, Text: truncate(l.Name, maxNameLen), Size: 9},
+		{X: margin, Y: 38, Text: l.Price, Size: 14, Bold: true},
+	}
+
+	x0 := (Width - float64(len(modules))*moduleWidth) / 2
+	for i := 0; i < len(modules); {
+		if !modules[i] {
+			i++
+			continue
+		}
+		start := i
+		for i < len(modules) && modules[i] {
+			i++
+		}
+		h := barsHeight
+		if isGuard(start) {
+			h += guardExtra
+		}
+		shapes = append(shapes, shape{X: x0 + float64(start)*moduleWidth, Y: barsTop, W: float64(i-start) * moduleWidth, H: h})
+	}
+
+	// Digits go under their bars: the first one before the start guard, then two groups of six
+	digitsY := barsTop + barsHeight + guardExtra + 8
+	shapes = append(shapes, shape{X: x0 - 8, Y: digitsY, Text: l.Barcode[:1], Size: 8})
+	for i := 0; i < 6; i++ {
+		shapes = append(shapes,
+			shape{X: x0 + float64(3+7*i)*moduleWidth + 2, Y: digitsY, Text: l.Barcode[1+i : 2+i], Size: 8},
+			shape{X: x0 + float64(50+7*i)*moduleWidth + 2, Y: digitsY, Text: l.Barcode[7+i : 8+i], Size: 8},
+		)
+	}
+
+	return shapes, nil
+}
+
+// truncate shortens s to n runes, marking the cut with dots.
+func truncate(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-3]) + "..."
+}
+
+// asciiText replaces what the built-in fonts of PNG and PDF cannot draw.
+func asciiText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r == 'ʻ' || r == 'ʼ' || r == '‘' || r == '’':
+			b.WriteByte('\'')
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
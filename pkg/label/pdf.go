@@ -0,0 +1,70 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// renderPDF puts every label on its own page. Text uses the standard Helvetica fonts, which need no embedding.
+func renderPDF(pages [][]shape) []byte {
+	var b bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	b.WriteString("%PDF-1.4\n")
+
+	// Objects 1-4 are fixed, every page adds a page object and its content stream
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+i*2)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, shapes := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>", Width, Height, 6+i*2))
+
+		content := pdfContent(shapes)
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return b.Bytes()
+}
+
+// pdfContent draws one label. PDF measures y from the bottom of the page.
+func pdfContent(shapes []shape) string {
+	var b strings.Builder
+	for _, s := range shapes {
+		if s.Text == "" {
+			fmt.Fprintf(&b, "%.2f %.2f %.2f %.2f re f\n", s.X, Height-s.Y-s.H, s.W, s.H)
+			continue
+		}
+
+		font := "F1"
+		if s.Bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&b, "BT /%s %.2f Tf %.2f %.2f Td (%s) Tj ET\n", font, s.Size, s.X, Height-s.Y, pdfEscape(asciiText(s.Text)))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// pdfEscape escapes the characters that end or break a PDF string.
+func pdfEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
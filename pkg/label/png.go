@@ -0,0 +1,75 @@
+package label
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+)
+
+// pixels per point: 216 dpi, enough for thermal printers
+const pngScale = 3.0
+
+// renderPNG stacks the labels in one image. Text uses the built-in 5x7 font.
+func renderPNG(pages [][]shape) ([]byte, error) {
+	width := int(math.Ceil(Width * pngScale))
+	pageHeight := int(math.Ceil(Height * pngScale))
+
+	img := image.NewGray(image.Rect(0, 0, width, pageHeight*len(pages)))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	for i, shapes := range pages {
+		top := i * pageHeight
+		for _, s := range shapes {
+			if s.Text == "" {
+				fill(img, px(s.X), top+px(s.Y), px(s.X+s.W), top+px(s.Y+s.H))
+				continue
+			}
+			drawText(img, s, top)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// drawText draws the text scaled so its capitals are about as tall as in the vector formats.
+func drawText(img *image.Gray, s shape, top int) {
+	scale := int(math.Max(1, math.Floor(s.Size*pngScale/10)))
+	x := px(s.X)
+	baseline := top + px(s.Y)
+
+	for _, r := range asciiText(s.Text) {
+		glyph := font5x7[r-0x20]
+		for col, bits := range glyph {
+			for row := 0; row < 8; row++ {
+				if bits&(1<<row) == 0 {
+					continue
+				}
+				x0 := x + col*scale
+				y0 := baseline - 7*scale + row*scale
+				fill(img, x0, y0, x0+scale, y0+scale)
+				if s.Bold {
+					fill(img, x0+scale/2+1, y0, x0+scale+scale/2+1, y0+scale)
+				}
+			}
+		}
+		x += 6 * scale
+		if s.Bold {
+			x += scale / 2
+		}
+	}
+}
+
+func fill(img *image.Gray, x0, y0, x1, y1 int) {
+	draw.Draw(img, image.Rect(x0, y0, x1, y1), image.NewUniform(color.Black), image.Point{}, draw.Src)
+}
+
+func px(v float64) int {
+	return int(math.Round(v * pngScale))
+}
@@ -0,0 +1,39 @@
+package label
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// renderSVG stacks the labels in one SVG document.
+func renderSVG(pages [][]shape) []byte {
+	var b bytes.Buffer
+	height := Height * float64(len(pages))
+
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%.2fpt" height="%.2fpt" viewBox="0 0 %.2f %.2f">`, Width, height, Width, height)
+	fmt.Fprintf(&b, `<rect width="%.2f" height="%.2f" fill="#fff"/>`, Width, height)
+
+	for i, shapes := range pages {
+		fmt.Fprintf(&b, `<g transform="translate(0 %.2f)">`, Height*float64(i))
+		for _, s := range shapes {
+			if s.Text == "" {
+				fmt.Fprintf(&b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f"/>`, s.X, s.Y, s.W, s.H)
+				continue
+			}
+
+			weight := "normal"
+			if s.Bold {
+				weight = "bold"
+			}
+			fmt.Fprintf(&b, `<text x="%.2f" y="%.2f" font-family="Helvetica, Arial, sans-serif" font-size="%.2f" font-weight="%s">`,
+				s.X, s.Y, s.Size, weight)
+			_ = xml.EscapeText(&b, []byte(s.Text))
+			b.WriteString(`</text>`)
+		}
+		b.WriteString(`</g>`)
+	}
+
+	b.WriteString(`</svg>`)
+	return b.Bytes()
+}